@@ -11,6 +11,8 @@ package ast
 
 import (
 	"encoding/json"
+
+	"github.com/brimsec/zq/pkg/nano"
 )
 
 // A Node is shared by all the AST type nodes.  Currently, this contains the
@@ -164,6 +166,14 @@ type (
 		Node
 		Procs []Proc `json:"procs"`
 	}
+	// A SwitchProc node represents a proc that routes each record to the
+	// proc of the first case whose filter matches the record.  Unlike a
+	// ParallelProc, each record is sent to at most one proc.  Records that
+	// match no case are dropped.
+	SwitchProc struct {
+		Node
+		Cases []SwitchCase `json:"cases"`
+	}
 	// A SortProc node represents a proc that sorts records.
 	SortProc struct {
 		Node
@@ -175,21 +185,30 @@ type (
 	// A CutProc node represents a proc that removes fields from each
 	// input record where each removed field matches one of the named fields
 	// sending each such modified record to its output in the order received.
+	// A record lacking any of the named fields is dropped unless Partial is
+	// true, in which case it is output with those of the fields it has.
 	CutProc struct {
 		Node
-		Fields []FieldExpr `json:"fields"`
+		Fields  []FieldExpr `json:"fields"`
+		Partial bool        `json:"partial,omitempty"`
 	}
 	// A HeadProc node represents a proc that forwards the indicated number
-	// of records then terminates.
+	// of records then terminates.  If keys are present, the proc instead
+	// forwards the indicated number of records for each group of records
+	// sharing the same values of the keys.
 	HeadProc struct {
 		Node
-		Count int `json:"count"`
+		Count int         `json:"count"`
+		Keys  []FieldExpr `json:"keys,omitempty"`
 	}
 	// A TailProc node represents a proc that reads all its records from its
 	// input transmits the final number of records indicated by the count.
+	// If keys are present, the proc instead transmits the final number of
+	// records for each group of records sharing the same values of the keys.
 	TailProc struct {
 		Node
-		Count int `json:"count"`
+		Count int         `json:"count"`
+		Keys  []FieldExpr `json:"keys,omitempty"`
 	}
 	// A FilterProc node represents a proc that discards all records that do
 	// not match the indicfated filter and forwards all that match to its output.
@@ -206,9 +225,14 @@ type (
 	// the previous record transmitted.  The Cflag causes the output records
 	// to contain a new field called count that contains the number of matched
 	// records in that set, similar to the unix shell command uniq.
+	// If keys are present, the proc instead discards any record whose
+	// key values match those of any recently transmitted record, where
+	// the limit parameter bounds the number of recent keys remembered.
 	UniqProc struct {
 		Node
-		Cflag bool `json:"cflag"`
+		Cflag bool        `json:"cflag"`
+		Keys  []FieldExpr `json:"keys,omitempty"`
+		Limit int         `json:"limit,omitempty"`
 	}
 	// A ReducerProc node represents a proc that consumes all the records
 	// in its input and processes each record with one or more reducers.
@@ -222,16 +246,18 @@ type (
 	// A GroupByProc node represents a proc that consumes all the records
 	// in its input, partitions the records into groups based on the values
 	// of the fields specified in the keys paramater, and applies one or
-	// more reducers to each group.  If the duration parameter is non-zero,
-	// then the groups further partioned by time according to the time interval
-	// equal to the duration.  In this case, the proc transmits to its output
-	// the reducer results from each time interval as they complete so that
-	// large time ranges are processed and streamed efficiently.
+	// more reducers to each group.  If the duration parameter is non-zero
+	// or the window parameter describes calendar windows, then the groups
+	// are further partioned by time according to the window.  In this case,
+	// the proc transmits to its output the reducer results from each time
+	// window as it completes so that large time ranges are processed and
+	// streamed efficiently.
 	// The limit parameter specifies the number of different groups that can
 	// be aggregated over. When absent, the runtime defaults to an appropriate value.
 	GroupByProc struct {
 		Node
 		Duration       Duration    `json:"duration"`
+		Window         TimeWindow  `json:"window"`
 		UpdateInterval Duration    `json:"update_interval"`
 		Limit          int         `json:"limit,omitempty"`
 		Keys           []FieldExpr `json:"keys"`
@@ -254,16 +280,144 @@ type (
 		Target string     `json:"target"`
 		Expr   Expression `json:"expression"`
 	}
+	// A WindowProc node represents a proc that transmits each input record
+	// in the order received, augmented with the fields computed by each
+	// assignment.  The assignment expressions may call window functions
+	// (e.g., lag, lead, delta, or a reducer computing a running value),
+	// which are evaluated over the sequence of records sharing the same
+	// values of the keys.
+	WindowProc struct {
+		Node
+		Assignments []Assignment `json:"assignments"`
+		Keys        []FieldExpr  `json:"keys,omitempty"`
+	}
+	// A SessionProc node represents a proc that partitions the records
+	// sharing the same values of the keys into sessions, where a session
+	// is a run of records in which no two successive records are further
+	// apart in time than the gap.  For each session, the proc transmits a
+	// record holding the session's start and end times, its duration, its
+	// record count, and each reducer's result.  Sessions are transmitted
+	// as they expire so that time-ordered streams are processed
+	// efficiently.
+	SessionProc struct {
+		Node
+		Gap      Duration    `json:"gap"`
+		Keys     []FieldExpr `json:"keys,omitempty"`
+		Reducers []Reducer   `json:"reducers,omitempty"`
+	}
+	// A SampleProc node represents a proc that transmits a random subset
+	// of its input.  If the rate parameter is non-zero, each record is
+	// transmitted as it arrives with probability equal to the rate.
+	// Otherwise, the proc collects a uniform random sample of size records
+	// and transmits it, in input order, once its input is exhausted.
+	// If keys are present, the records of each group sharing the same
+	// values of the keys are sampled separately and, when sampling by rate,
+	// the first record of each group is always transmitted.  The seed
+	// parameter seeds the random number generator so that samples are
+	// reproducible.
+	SampleProc struct {
+		Node
+		Size int         `json:"size,omitempty"`
+		Rate float64     `json:"rate,omitempty"`
+		Keys []FieldExpr `json:"keys,omitempty"`
+		Seed int64       `json:"seed,omitempty"`
+	}
+	// A HistogramProc node represents a proc that consumes all the records
+	// in its input and counts the values of the field that fall into each
+	// of a set of buckets.  The buckets lie between successive boundaries
+	// if the bounds parameter is present, span successive powers of ten if
+	// log is true, and otherwise divide the range of values into the number
+	// of bins of equal width.  The proc transmits a record for each bucket
+	// holding its lower and upper bounds and its count.  If keys are present,
+	// the values of each group of records sharing the same values of the keys
+	// are counted separately.
+	HistogramProc struct {
+		Node
+		Field  FieldExpr   `json:"field"`
+		Bins   int         `json:"bins,omitempty"`
+		Log    bool        `json:"log,omitempty"`
+		Bounds []float64   `json:"bounds,omitempty"`
+		Keys   []FieldExpr `json:"keys,omitempty"`
+	}
+	// A LookupProc node represents a proc that appends to each record the
+	// named fields of the matching row of a table loaded from a file.  A row
+	// matches a record if the value of the row's table key column equals
+	// the value of the record's key field or if the table key column holds
+	// a subnet containing the record's key value, with the most specific
+	// subnet taking precedence.  If fields is empty, all the table's columns
+	// except the table key are appended.  Records that match no row are
+	// given unset values for the appended fields.
+	LookupProc struct {
+		Node
+		File     string    `json:"file"`
+		Key      FieldExpr `json:"key"`
+		TableKey string    `json:"table_key"`
+		Fields   []string  `json:"fields,omitempty"`
+	}
+	// An IntelProc node represents a proc that matches the values of each
+	// record against the indicators of compromise listed in the files and
+	// appends a record field named intel describing the first match.
+	IntelProc struct {
+		Node
+		Files []string `json:"files"`
+	}
+	// A ParseProc node represents a proc that matches the string value of
+	// a field against a grok pattern and appends the fields captured by the
+	// pattern.  PatternFile names a file of patterns that extends the
+	// built-in pattern library.
+	ParseProc struct {
+		Node
+		Field       FieldExpr `json:"field"`
+		Pattern     string    `json:"pattern"`
+		PatternFile string    `json:"pattern_file,omitempty"`
+		Warn        bool      `json:"warn,omitempty"`
+	}
+	// An UnnestProc node represents a proc that replaces the JSON string
+	// value of a field with the value it encodes, as if by
+	// "put field = parseJSON(field)".
+	UnnestProc struct {
+		Node
+		Field string `json:"field"`
+	}
 )
 
-//XXX TBD: chance to nano.Duration
+// A SwitchCase is a case of a SwitchProc that routes the records matching
+// the filter to the proc.
+type SwitchCase struct {
+	Filter BooleanExpr `json:"filter"`
+	Proc   Proc        `json:"proc"`
+}
+
+// An Assignment is an expression whose value is stored in the field
+// named by target.
+type Assignment struct {
+	Target string     `json:"target"`
+	Expr   Expression `json:"expression"`
+}
+
+// A Duration is a time interval in nanoseconds.  For compatibility with
+// clients that predate nanosecond precision, a duration of whole seconds
+// is marshaled in seconds and a duration expressed in seconds is also
+// accepted when unmarshaling.
 type Duration struct {
-	Seconds int `json:"seconds"`
+	Nanoseconds int64 `json:"nanoseconds"`
+}
+
+func (d Duration) MarshalJSON() ([]byte, error) {
+	if d.Nanoseconds%1000000000 == 0 {
+		return json.Marshal(struct {
+			Seconds int64 `json:"seconds"`
+		}{d.Nanoseconds / 1000000000})
+	}
+	return json.Marshal(struct {
+		Nanoseconds int64 `json:"nanoseconds"`
+	}{d.Nanoseconds})
 }
 
 type DurationNode struct {
-	Type    string `json:"type"`
-	Seconds int    `json:"seconds"`
+	Type        string `json:"type"`
+	Seconds     int64  `json:"seconds"`
+	Nanoseconds int64  `json:"nanoseconds"`
 }
 
 func (d *Duration) UnmarshalJSON(b []byte) error {
@@ -272,12 +426,35 @@ func (d *Duration) UnmarshalJSON(b []byte) error {
 	if err != nil {
 		return err
 	}
-	d.Seconds = v.Seconds
+	d.Nanoseconds = nano.Duration(v.Seconds, v.Nanoseconds)
 	return err
 }
 
+// The kinds of time windows of a time-binned group-by.
+const (
+	WindowTumbling = "tumbling"
+	WindowSliding  = "sliding"
+	WindowCalendar = "calendar"
+)
+
+// A TimeWindow describes how a time-binned group-by assigns records to
+// time bins.  Tumbling windows (the default when kind is empty) partition
+// time into consecutive bins equal to the group-by duration.  Sliding
+// windows also span the duration but a new window starts every slide
+// interval so that each record belongs to several overlapping windows.
+// Calendar windows span count calendar units (day, week, month, or year)
+// aligned to the calendar in the named time zone, which defaults to UTC.
+type TimeWindow struct {
+	Kind     string   `json:"kind,omitempty"`
+	Slide    Duration `json:"slide"`
+	Unit     string   `json:"unit,omitempty"`
+	Count    int      `json:"count,omitempty"`
+	TimeZone string   `json:"tz,omitempty"`
+}
+
 func (*SequentialProc) ProcNode() {}
 func (*ParallelProc) ProcNode()   {}
+func (*SwitchProc) ProcNode()     {}
 func (*SortProc) ProcNode()       {}
 func (*CutProc) ProcNode()        {}
 func (*HeadProc) ProcNode()       {}
@@ -289,6 +466,14 @@ func (*ReducerProc) ProcNode()    {}
 func (*GroupByProc) ProcNode()    {}
 func (*TopProc) ProcNode()        {}
 func (*PutProc) ProcNode()        {}
+func (*WindowProc) ProcNode()     {}
+func (*SessionProc) ProcNode()    {}
+func (*SampleProc) ProcNode()     {}
+func (*HistogramProc) ProcNode()  {}
+func (*LookupProc) ProcNode()     {}
+func (*IntelProc) ProcNode()      {}
+func (*ParseProc) ProcNode()      {}
+func (*UnnestProc) ProcNode()     {}
 
 // A Reducer is an AST node that represents any of the boom reducers.  The Op
 // parameter indicates the specific reducer while the Field parameter indicates
@@ -60,6 +60,12 @@ func unpackProc(custom Unpacker, node joe.JSON) (Proc, error) {
 			return nil, err
 		}
 		return &ParallelProc{Procs: procs}, nil
+	case "SwitchProc":
+		cases, err := unpackSwitchCases(custom, node.Get("cases"))
+		if err != nil {
+			return nil, err
+		}
+		return &SwitchProc{Cases: cases}, nil
 	case "SortProc":
 		fields, err := unpackFieldExprArray(node.Get("fields"))
 		if err != nil {
@@ -73,9 +79,25 @@ func unpackProc(custom Unpacker, node joe.JSON) (Proc, error) {
 		}
 		return &CutProc{Fields: fields}, nil
 	case "HeadProc":
-		return &HeadProc{}, nil
+		keys, err := unpackFieldExprArray(node.Get("keys"))
+		if err != nil {
+			return nil, err
+		}
+		return &HeadProc{Keys: keys}, nil
 	case "TailProc":
-		return &TailProc{}, nil
+		keys, err := unpackFieldExprArray(node.Get("keys"))
+		if err != nil {
+			return nil, err
+		}
+		return &TailProc{Keys: keys}, nil
+	case "PassProc":
+		return &PassProc{}, nil
+	case "PutProc":
+		expr, err := unpackExpression(node.Get("expression"))
+		if err != nil {
+			return nil, err
+		}
+		return &PutProc{Expr: expr}, nil
 	case "FilterProc":
 		filter, err := UnpackChild(node, "filter")
 		if err != nil {
@@ -83,7 +105,11 @@ func unpackProc(custom Unpacker, node joe.JSON) (Proc, error) {
 		}
 		return &FilterProc{Filter: filter}, nil
 	case "UniqProc":
-		return &UniqProc{}, nil
+		keys, err := unpackFieldExprArray(node.Get("keys"))
+		if err != nil {
+			return nil, err
+		}
+		return &UniqProc{Keys: keys}, nil
 	case "ReducerProc":
 		reducers, err := unpackReducers(node.Get("reducers"))
 		if err != nil {
@@ -106,6 +132,58 @@ func unpackProc(custom Unpacker, node joe.JSON) (Proc, error) {
 			return nil, err
 		}
 		return &TopProc{Fields: fields}, nil
+	case "WindowProc":
+		keys, err := unpackFieldExprArray(node.Get("keys"))
+		if err != nil {
+			return nil, err
+		}
+		assignments, err := unpackAssignments(node.Get("assignments"))
+		if err != nil {
+			return nil, err
+		}
+		return &WindowProc{Assignments: assignments, Keys: keys}, nil
+	case "SessionProc":
+		keys, err := unpackFieldExprArray(node.Get("keys"))
+		if err != nil {
+			return nil, err
+		}
+		reducers, err := unpackReducers(node.Get("reducers"))
+		if err != nil {
+			return nil, err
+		}
+		return &SessionProc{Keys: keys, Reducers: reducers}, nil
+	case "SampleProc":
+		keys, err := unpackFieldExprArray(node.Get("keys"))
+		if err != nil {
+			return nil, err
+		}
+		return &SampleProc{Keys: keys}, nil
+	case "HistogramProc":
+		field, err := unpackFieldExpr(node.Get("field"))
+		if err != nil {
+			return nil, err
+		}
+		keys, err := unpackFieldExprArray(node.Get("keys"))
+		if err != nil {
+			return nil, err
+		}
+		return &HistogramProc{Field: field, Keys: keys}, nil
+	case "LookupProc":
+		key, err := unpackFieldExpr(node.Get("key"))
+		if err != nil {
+			return nil, err
+		}
+		return &LookupProc{Key: key}, nil
+	case "IntelProc":
+		return &IntelProc{}, nil
+	case "ParseProc":
+		field, err := unpackFieldExpr(node.Get("field"))
+		if err != nil {
+			return nil, err
+		}
+		return &ParseProc{Field: field}, nil
+	case "UnnestProc":
+		return &UnnestProc{}, nil
 	default:
 		return nil, fmt.Errorf("unknown proc op: %s", op)
 	}
@@ -217,6 +295,99 @@ func unpackFieldExpr(node joe.JSON) (FieldExpr, error) {
 	}
 }
 
+func unpackExpression(node joe.JSON) (Expression, error) {
+	op, ok := node.Get("op").String()
+	if !ok {
+		return nil, errors.New("AST is missing op field")
+	}
+	switch op {
+	case "BinaryExpr":
+		lhs, err := unpackExpression(node.Get("lhs"))
+		if err != nil {
+			return nil, err
+		}
+		rhs, err := unpackExpression(node.Get("rhs"))
+		if err != nil {
+			return nil, err
+		}
+		return &BinaryExpression{LHS: lhs, RHS: rhs}, nil
+	case "FunctionCall":
+		argList := node.Get("args")
+		if argList == joe.Undefined {
+			return nil, errors.New("FunctionCall missing args property")
+		}
+		if !argList.IsArray() {
+			return nil, errors.New("args property should be an array")
+		}
+		n := argList.Len()
+		args := make([]Expression, n)
+		for k := 0; k < n; k++ {
+			var err error
+			args[k], err = unpackExpression(argList.Index(k))
+			if err != nil {
+				return nil, err
+			}
+		}
+		return &FunctionCall{Args: args}, nil
+	case "Literal":
+		return &Literal{}, nil
+	case "FieldRead":
+		return &FieldRead{}, nil
+	default:
+		return nil, fmt.Errorf("unknown op: %s", op)
+	}
+}
+
+func unpackAssignments(node joe.JSON) ([]Assignment, error) {
+	if node == joe.Undefined {
+		return nil, nil
+	}
+	if !node.IsArray() {
+		return nil, errors.New("assignments property should be an array")
+	}
+	n := node.Len()
+	assignments := make([]Assignment, n)
+	for k := 0; k < n; k++ {
+		e := node.Index(k).Get("expression")
+		if e == joe.Undefined {
+			return nil, errors.New("assignment missing expression property")
+		}
+		var err error
+		assignments[k].Expr, err = unpackExpression(e)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return assignments, nil
+}
+
+func unpackSwitchCases(custom Unpacker, node joe.JSON) ([]SwitchCase, error) {
+	if node == joe.Undefined {
+		return nil, errors.New("cases field is missing")
+	}
+	if !node.IsArray() {
+		return nil, errors.New("cases field is not an array")
+	}
+	n := node.Len()
+	cases := make([]SwitchCase, n)
+	for k := 0; k < n; k++ {
+		var err error
+		cases[k].Filter, err = UnpackChild(node.Index(k), "filter")
+		if err != nil {
+			return nil, err
+		}
+		p := node.Index(k).Get("proc")
+		if p == joe.Undefined {
+			return nil, errors.New("switch case missing proc property")
+		}
+		cases[k].Proc, err = unpackProc(custom, p)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return cases, nil
+}
+
 func unpackReducers(node joe.JSON) ([]Reducer, error) {
 	if node == joe.Undefined {
 		return nil, nil
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/brimsec/zq/zio/bzngio"
+	"github.com/mccanne/charm"
+)
+
+var Index = &charm.Spec{
+	Name:  "index",
+	Usage: "index [-n limit] [-x file] file",
+	Short: "creates a time and search index for a bzng file",
+	Long: `
+The index command creates a time and search index for a bzng file.  The bzng
+file is not modified or copied.
+
+As with the pcap index command, the index is a list of slots each of which
+holds a seek offset and the time range covered by the values from that offset
+up to the offset of the next slot.  It also holds the location of each typedef
+in the file so that a reader of any part of the file knows every type used by
+the values it reads.  The number of slots is bounded by the -n argument.
+
+The index also divides the file into blocks of 1000 values and holds a summary
+of each block: a Bloom filter of the strings, addresses, ports, and whole numbers
+in the block, and the least and greatest value of each numeric and time field.
+A search that compares a field or any value with a string, address, port, or
+number by equality, or a field with a number by order, skips the blocks whose
+summaries rule out a match.  A search for a bare value, which also looks for it
+within strings, does not.
+
+The index is written in json format to the file named by the bzng file name
+with the suffix ".idx.json" appended or, if -x is specified, to the indicated
+file or to standard output if the -x argument is "-".  When zqd searches a
+space whose all.bzng has an index in all.bzng.idx.json over a time span or
+with such a search, it reads only the parts of all.bzng that the index finds
+for the span and search.  zqd creates the index whenever it ingests a pcap.
+An index is ignored if the size or the fingerprint of the bzng file, a hash of
+its first and last 4096 bytes, has changed since the index was created.
+`,
+	New: func(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
+		c := &IndexCommand{}
+		f.StringVar(&c.outputFile, "x", "", "name of output file for the index or - for stdout")
+		f.IntVar(&c.limit, "n", 10000, "limit on index size")
+		return c, nil
+	},
+}
+
+func init() {
+	Zq.Add(Index)
+}
+
+type IndexCommand struct {
+	limit      int
+	outputFile string
+}
+
+func (c *IndexCommand) Run(args []string) error {
+	if len(args) != 1 {
+		return errors.New("zq index: must be provided a single bzng file")
+	}
+	f, err := os.Open(args[0])
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	index, err := bzngio.CreateIndex(f, c.limit)
+	if err != nil {
+		return err
+	}
+	b, err := json.Marshal(index)
+	if err != nil {
+		return err
+	}
+	switch c.outputFile {
+	case "-":
+		fmt.Println(string(b))
+		return nil
+	case "":
+		return ioutil.WriteFile(bzngio.IndexPath(args[0]), b, 0644)
+	}
+	return ioutil.WriteFile(c.outputFile, b, 0644)
+}
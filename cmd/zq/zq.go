@@ -1,26 +1,35 @@
 package main
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"flag"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"os"
+	"runtime"
+	"strings"
+	"time"
 
 	"github.com/brimsec/zq/ast"
 	"github.com/brimsec/zq/driver"
 	"github.com/brimsec/zq/emitter"
-	"github.com/brimsec/zq/filter"
+	"github.com/brimsec/zq/optimizer"
+	"github.com/brimsec/zq/pkg/nano"
 	"github.com/brimsec/zq/proc"
 	"github.com/brimsec/zq/scanner"
 	"github.com/brimsec/zq/zbuf"
 	"github.com/brimsec/zq/zio"
 	"github.com/brimsec/zq/zio/detector"
 	"github.com/brimsec/zq/zio/ndjsonio"
+	"github.com/brimsec/zq/zio/zstio"
+	"github.com/brimsec/zq/zng"
 	"github.com/brimsec/zq/zng/resolver"
 	"github.com/brimsec/zq/zql"
 	"github.com/mccanne/charm"
+	"go.uber.org/zap"
 )
 
 // Version is set via the Go linker.
@@ -45,6 +54,13 @@ Supported input formats include zng (.zng), NDJSON (.ndjson), and
 the Zeek log format (.log).  Supported output formats include
 all the input formats along with text and tabular formats.
 
+The zst format (.zst) stores the values of each field of the records of
+each type together in a column.  When a query reads only some fields of its
+input, as with "sum(orig_bytes) by proto", zq reads only the columns of
+those fields from a zst file.  This is so for a query that runs filters,
+sorts, heads, and tails on fields that it names and then ends with a
+groupby, a reducer, or a cut.
+
 The input file format is inferred from the data.  If multiple files are
 specified, each file format is determined independently so you can mix and
 match input types.  If multiple files are concatenated into a stream and
@@ -61,6 +77,37 @@ then the ZQL expression is assumed to be "*", i.e., match and output all
 of the input.  If the first argument is both valid ZQL and an existing file,
 then the file overrides.
 
+A query may begin with macro definitions of the form "def name = pipeline;"
+and then use each name in place of a processor.  Definitions may also be
+loaded from files of definitions with one or more -I options.
+
+A placeholder of the form $name may appear in the query wherever a value
+may appear and is bound with -P name=value to a string or with
+-P name:type=value to a value of the given zng type, e.g., -P host:ip=10.0.0.1.
+Bound values are never parsed as ZQL and so need not be quoted or escaped.
+
+With -fmt, zq prints the query in canonical form, with macros expanded and
+parameters replaced by their values, and exits without reading any input.
+
+With -explain, zq prints the query as optimized, the flowgraph of procs
+compiled from it, and the text of each numbered proc, and exits.  With
+-analyze, zq runs the query and, in place of its results, outputs a record
+for each proc holding the number of records it received and sent, the
+number of batches it sent, the time spent in it, and the most records it
+held at once.
+
+Procs that buffer records, such as sort, tail, top, groupby, and histogram, may
+together hold no more than the number of bytes given by -memlimit, and the
+query fails if they would hold more.
+
+With -timeout, zq stops reading input once the given duration, e.g., 10s,
+has passed and outputs the results of the query for the records read so
+far, such as the counts of a groupby, with a warning that they are partial.
+
+Input records are decoded and filtered on as many goroutines as given by
+-workers, which defaults to the number of CPUs.  Records reach the rest of
+the query in the order they were read regardless of the number of workers.
+
 See the zq source repository for more information:
 
 https://github.com/brimsec/zq
@@ -86,14 +133,75 @@ type Command struct {
 	stats        bool
 	quiet        bool
 	showVersion  bool
+	format       bool
+	explain      bool
+	analyze      bool
+	workers      int
+	memLimit     int64
+	timeout      time.Duration
+	includes     includes
+	params       params
 	zio.Flags
 }
 
+// includes is a flag.Value for the list of files given by repeated -I flags.
+type includes []string
+
+func (i includes) String() string {
+	return strings.Join(i, ",")
+}
+
+func (i *includes) Set(value string) error {
+	*i = append(*i, value)
+	return nil
+}
+
+// read returns the contents of each included file.
+func (i includes) read() ([]string, error) {
+	var libs []string
+	for _, path := range i {
+		b, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		libs = append(libs, string(b))
+	}
+	return libs, nil
+}
+
+// params is a flag.Value for the query parameters given by repeated -P
+// flags of the form name=value, which binds a string, or name:type=value.
+type params map[string]ast.Literal
+
+func (p params) String() string {
+	var s []string
+	for name, lit := range p {
+		s = append(s, fmt.Sprintf("%s:%s=%s", name, lit.Type, lit.Value))
+	}
+	return strings.Join(s, ",")
+}
+
+func (p *params) Set(value string) error {
+	k := strings.IndexByte(value, '=')
+	if k <= 0 {
+		return fmt.Errorf("parameter must be of the form name=value or name:type=value: %s", value)
+	}
+	name, typ := value[:k], "string"
+	if j := strings.IndexByte(name, ':'); j >= 0 {
+		name, typ = name[:j], name[j+1:]
+	}
+	if *p == nil {
+		*p = make(params)
+	}
+	(*p)[name] = ast.Literal{Node: ast.Node{Op: "Literal"}, Type: typ, Value: value[k+1:]}
+	return nil
+}
+
 func New(f *flag.FlagSet) (charm.Command, error) {
 	cwd, _ := os.Getwd()
 	c := &Command{zctx: resolver.NewContext()}
-	f.StringVar(&c.ifmt, "i", "auto", "format of input data [auto,bzng,ndjson,zeek,zjson,zng]")
-	f.StringVar(&c.ofmt, "f", "zng", "format for output data [bzng,ndjson,table,text,types,zeek,zjson,zng]")
+	f.StringVar(&c.ifmt, "i", "auto", "format of input data [auto,bzng,ndjson,zeek,zjson,zng,zst]")
+	f.StringVar(&c.ofmt, "f", "zng", "format for output data [bzng,ndjson,table,text,types,zeek,zjson,zng,zst]")
 	f.StringVar(&c.path, "p", cwd, "path for input")
 	f.StringVar(&c.dir, "d", "", "directory for output data files")
 	f.StringVar(&c.outputFile, "o", "", "write data to output file")
@@ -106,49 +214,17 @@ func New(f *flag.FlagSet) (charm.Command, error) {
 	f.BoolVar(&c.EpochDates, "E", false, "display epoch timestamps in text output")
 	f.BoolVar(&c.UTF8, "U", false, "display zeek strings as UTF-8")
 	f.BoolVar(&c.showVersion, "version", false, "print version and exit")
+	f.BoolVar(&c.format, "fmt", false, "print the query in canonical form and exit")
+	f.BoolVar(&c.explain, "explain", false, "print the optimized query and its flowgraph and exit")
+	f.BoolVar(&c.analyze, "analyze", false, "run the query and output statistics about each proc instead of its results")
+	f.IntVar(&c.workers, "workers", runtime.GOMAXPROCS(0), "number of goroutines that decode and filter input records")
+	f.Int64Var(&c.memLimit, "memlimit", 0, "limit in bytes on the memory the query uses to buffer records (0 for no limit)")
+	f.DurationVar(&c.timeout, "timeout", 0, "stop reading input after this duration and output partial results (0 for no timeout)")
+	f.Var(&c.includes, "I", "source file containing ZQL macro definitions (may be repeated)")
+	f.Var(&c.params, "P", "bind ZQL query parameter $name with name=value or name:type=value (may be repeated)")
 	return c, nil
 }
 
-func liftFilter(p ast.Proc) (*ast.FilterProc, ast.Proc) {
-	if fp, ok := p.(*ast.FilterProc); ok {
-		pass := &ast.PassProc{
-			Node: ast.Node{"PassProc"},
-		}
-		return fp, pass
-	}
-	seq, ok := p.(*ast.SequentialProc)
-	if ok && len(seq.Procs) > 0 {
-		if fp, ok := seq.Procs[0].(*ast.FilterProc); ok {
-			rest := &ast.SequentialProc{
-				Node:  ast.Node{"SequentialProc"},
-				Procs: seq.Procs[1:],
-			}
-			return fp, rest
-		}
-	}
-	return nil, nil
-}
-
-func (c *Command) compile(program ast.Proc, reader zbuf.Reader) (*proc.MuxOutput, error) {
-	// Try to move the filter into the scanner so we can throw
-	// out unmatched records without copying their contents in the
-	// case of readers (like zio raw.Reader) that create volatile
-	// records that are kepted by the scanner only if matched.
-	// For other readers, it certainly doesn't hurt to do this.
-	var f filter.Filter
-	filterProc, rest := liftFilter(program)
-	if filterProc != nil {
-		var err error
-		f, err = filter.Compile(filterProc.Filter)
-		if err != nil {
-			return nil, err
-		}
-		program = rest
-	}
-	input := scanner.NewScanner(reader, f)
-	return driver.Compile(program, input)
-}
-
 func fileExists(path string) bool {
 	if path == "-" {
 		return true
@@ -165,6 +241,23 @@ func (c *Command) printVersion() error {
 	return nil
 }
 
+func (c *Command) formatQuery(src string) error {
+	libs, err := c.includes.read()
+	if err != nil {
+		return err
+	}
+	query, err := zql.ParseProcWithParams(src, c.params, libs...)
+	if err != nil {
+		return fmt.Errorf("parse error: %s", err)
+	}
+	s, err := zql.FormatProc(query)
+	if err != nil {
+		return err
+	}
+	fmt.Println(s)
+	return nil
+}
+
 func (c *Command) loadJsonTypes() (*ndjsonio.TypeConfig, error) {
 	data, err := ioutil.ReadFile(c.jsonTypePath)
 	if err != nil {
@@ -185,6 +278,9 @@ func (c *Command) Run(args []string) error {
 	if len(args) == 0 {
 		return Zq.Exec(c, []string{"help"})
 	}
+	if c.format {
+		return c.formatQuery(args[0])
+	}
 	paths := args
 	var query ast.Proc
 	var err error
@@ -198,7 +294,11 @@ func (c *Command) Run(args []string) error {
 		if len(paths) == 0 {
 			return fmt.Errorf("file not found: %s", args[0])
 		}
-		query, err = zql.ParseProc(args[0])
+		libs, err := c.includes.read()
+		if err != nil {
+			return err
+		}
+		query, err = zql.ParseProcWithParams(args[0], c.params, libs...)
 		if err != nil {
 			return fmt.Errorf("parse error: %s", err)
 		}
@@ -230,6 +330,13 @@ func (c *Command) Run(args []string) error {
 			}
 		}
 	}
+	if fields, ok := optimizer.Fields(optimizer.Optimize(query)); ok {
+		for _, r := range readers {
+			if zr, ok := r.(*zstio.Reader); ok {
+				zr.SetFields(fields)
+			}
+		}
+	}
 
 	var reader zbuf.Reader
 	if len(readers) == 1 {
@@ -237,22 +344,69 @@ func (c *Command) Run(args []string) error {
 	} else {
 		reader = scanner.NewCombiner(readers)
 	}
-	writer, err := c.openOutput()
+	scanCtx := context.Background()
+	if c.timeout > 0 {
+		var cancel context.CancelFunc
+		scanCtx, cancel = context.WithTimeout(scanCtx, c.timeout)
+		defer cancel()
+	}
+	ctx := &proc.Context{
+		Context:     scanCtx,
+		TypeContext: resolver.NewContext(),
+		Logger:      zap.NewNop(),
+		Warnings:    make(chan string, 5),
+		Memory:      proc.NewBudget("query", c.memLimit, nil),
+	}
+	if c.explain || c.analyze {
+		ctx.Analyzer = &proc.Analyzer{}
+	}
+	mux, err := driver.CompileQuery(ctx, query, reader, nano.Span{}, c.workers)
 	if err != nil {
 		return err
 	}
-	defer writer.Close()
-	mux, err := c.compile(query, reader)
+	if c.explain {
+		return driver.Explain(os.Stdout, query, mux, ctx.Analyzer)
+	}
+	writer, err := c.openOutput()
 	if err != nil {
 		return err
 	}
-	output := driver.New(writer)
+	defer writer.Close()
+	var output *driver.Driver
+	if c.analyze {
+		output = driver.New(discard{})
+	} else {
+		output = driver.New(writer)
+	}
 	if !c.quiet {
 		output.SetWarningsWriter(os.Stderr)
 	}
-	return output.Run(mux)
+	if err := output.Run(mux); err != nil {
+		return err
+	}
+	if mux.Stopped() && !c.quiet {
+		fmt.Fprintf(os.Stderr, "query timed out after %s; results are partial\n", c.timeout)
+	}
+	if c.analyze {
+		recs, err := driver.AnalysisRecords(c.zctx, ctx.Analyzer)
+		if err != nil {
+			return err
+		}
+		for _, rec := range recs {
+			if err := writer.Write(rec); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
 }
 
+// discard is a zbuf.Writer that drops the results of a query run with
+// -analyze.
+type discard struct{}
+
+func (discard) Write(*zng.Record) error { return nil }
+
 func (c *Command) errorf(format string, args ...interface{}) {
 	_, _ = fmt.Fprintf(os.Stderr, format, args...)
 }
@@ -278,6 +432,18 @@ func (c *Command) inputReaders(paths []string) ([]zbuf.Reader, error) {
 				return nil, err
 			}
 		}
+		if f != os.Stdin && (c.ifmt == "auto" || c.ifmt == "zst") {
+			// A zst file is read directly so that its reader can
+			// seek past the columns that the query does not read.
+			isZst := zstio.IsZst(f)
+			if _, err := f.Seek(0, io.SeekStart); err != nil {
+				return nil, err
+			}
+			if isZst {
+				readers = append(readers, zstio.NewReader(f, c.zctx))
+				continue
+			}
+		}
 		r := detector.GzipReader(f)
 		var err error
 		if c.ifmt == "auto" {
@@ -60,6 +60,9 @@ func New(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
 	f.BoolVar(&c.pprof, "pprof", false, "add pprof routes to api")
 	f.StringVar(&c.configfile, "config", "", "path to a zqd config file")
 	f.BoolVar(&c.devMode, "dev", false, "runs zqd in development mode")
+	f.Int64Var(&c.conf.MemoryLimit, "memlimit", 0, "limit in bytes on the memory that all searches use to buffer records (0 for no limit)")
+	f.StringVar(&c.conf.FileRoot, "filedir", "", "directory of files, such as lookup tables, that searches may read (none if empty)")
+	f.Int64Var(&c.conf.QueryMemoryLimit, "querymemlimit", 0, "limit in bytes on the memory that each search uses to buffer records (0 for no limit)")
 	return c, nil
 }
 
@@ -69,6 +72,12 @@ func (c *Command) Run(args []string) error {
 	if err != nil {
 		return err
 	}
+	if c.conf.FileRoot != "" {
+		c.conf.FileRoot, err = filepath.Abs(c.conf.FileRoot)
+		if err != nil {
+			return err
+		}
+	}
 	if err := c.loadConfigFile(); err != nil {
 		return err
 	}
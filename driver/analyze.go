@@ -0,0 +1,89 @@
+package driver
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/brimsec/zq/ast"
+	"github.com/brimsec/zq/optimizer"
+	"github.com/brimsec/zq/proc"
+	"github.com/brimsec/zq/zcode"
+	"github.com/brimsec/zq/zng"
+	"github.com/brimsec/zq/zng/resolver"
+	"github.com/brimsec/zq/zql"
+)
+
+// Explain writes to w the optimized text of program, a description of the
+// flowgraph out compiled from it by CompileQuery with the Analyzer a, and
+// the text of each proc in the flowgraph.
+func Explain(w io.Writer, program ast.Proc, out *proc.MuxOutput, a *proc.Analyzer) error {
+	query, err := zql.FormatProc(optimizer.Optimize(program))
+	if err != nil {
+		return err
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "query:\n  %s\n", query)
+	b.WriteString("flowgraph:\n")
+	for _, line := range strings.SplitAfter(out.Flowgraph(), "\n") {
+		if line != "" {
+			b.WriteString("  " + line)
+		}
+	}
+	b.WriteString("procs:\n")
+	for _, stats := range a.Procs {
+		fmt.Fprintf(&b, "  #%d %s\n", stats.ID, procText(stats.Node))
+	}
+	_, err = io.WriteString(w, b.String())
+	return err
+}
+
+// procText returns the ZQL text of a proc in a flowgraph.  A nil proc is
+// the scanner of a query without a leading filter.
+func procText(p ast.Proc) string {
+	var s string
+	var err error
+	switch p := p.(type) {
+	case nil:
+		return "*"
+	case *ast.FilterProc:
+		s, err = zql.FormatFilter(p.Filter)
+	default:
+		s, err = zql.FormatChainedProc(p)
+	}
+	if err != nil {
+		return fmt.Sprintf("%T", p)
+	}
+	return s
+}
+
+// AnalysisRecords returns a record holding the statistics of each proc
+// analyzed by a.
+func AnalysisRecords(zctx *resolver.Context, a *proc.Analyzer) ([]*zng.Record, error) {
+	typ := zctx.LookupTypeRecord([]zng.Column{
+		zng.NewColumn("id", zng.TypeInt64),
+		zng.NewColumn("proc", zng.TypeString),
+		zng.NewColumn("records_in", zng.TypeUint64),
+		zng.NewColumn("records_out", zng.TypeUint64),
+		zng.NewColumn("batches_out", zng.TypeUint64),
+		zng.NewColumn("wall", zng.TypeDuration),
+		zng.NewColumn("peak_buffered", zng.TypeUint64),
+	})
+	var recs []*zng.Record
+	for _, stats := range a.Procs {
+		var zv zcode.Bytes
+		zv = zcode.AppendPrimitive(zv, zng.EncodeInt(int64(stats.ID)))
+		zv = zcode.AppendPrimitive(zv, zng.EncodeString(procText(stats.Node)))
+		zv = zcode.AppendPrimitive(zv, zng.EncodeUint(uint64(stats.RecordsIn)))
+		zv = zcode.AppendPrimitive(zv, zng.EncodeUint(uint64(stats.RecordsOut)))
+		zv = zcode.AppendPrimitive(zv, zng.EncodeUint(uint64(stats.BatchesOut)))
+		zv = zcode.AppendPrimitive(zv, zng.EncodeDuration(int64(stats.Wall)))
+		zv = zcode.AppendPrimitive(zv, zng.EncodeUint(uint64(stats.PeakBuffered)))
+		rec, err := zng.NewRecord(typ, zv)
+		if err != nil {
+			return nil, err
+		}
+		recs = append(recs, rec)
+	}
+	return recs, nil
+}
@@ -0,0 +1,128 @@
+package driver
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/brimsec/zq/pkg/nano"
+	"github.com/brimsec/zq/proc"
+	"github.com/brimsec/zq/zio/zngio"
+	"github.com/brimsec/zq/zng/resolver"
+	"github.com/brimsec/zq/zql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func compileAnalyzed(t *testing.T, query, input string) (*proc.Context, *proc.MuxOutput) {
+	program, err := zql.ParseProc(query)
+	require.NoError(t, err)
+	zctx := resolver.NewContext()
+	ctx := &proc.Context{
+		Context:     context.Background(),
+		TypeContext: zctx,
+		Warnings:    make(chan string, 5),
+		Analyzer:    &proc.Analyzer{},
+	}
+	reader := zngio.NewReader(strings.NewReader(input), zctx)
+	mux, err := CompileQuery(ctx, program, reader, nano.Span{}, 1)
+	require.NoError(t, err)
+	return ctx, mux
+}
+
+const analyzeInput = `
+#0:record[n:int64]
+0:[3;]
+0:[0;]
+0:[2;]
+0:[1;]
+`
+
+func TestExplain(t *testing.T) {
+	const query = "* | sort n | filter n>0 | (head 1; count())"
+	ctx, mux := compileAnalyzed(t, query, analyzeInput)
+	program, err := zql.ParseProc(query)
+	require.NoError(t, err)
+	var b strings.Builder
+	require.NoError(t, Explain(&b, program, mux, ctx.Analyzer))
+	expected := `query:
+  n>0 | sort n | (head 1; count())
+flowgraph:
+  scanner.Scanner #0
+    proc.Sort #1
+      proc.Split
+        proc.SplitChannel
+          proc.Head #2
+        proc.SplitChannel
+          proc.Reducer #3
+procs:
+  #0 n>0
+  #1 sort n
+  #2 head 1
+  #3 count()
+`
+	assert.Equal(t, expected, b.String())
+}
+
+func TestAnalyze(t *testing.T) {
+	ctx, mux := compileAnalyzed(t, "n>0 | sort n | head 2", analyzeInput)
+	require.NoError(t, New(&counter{}).Run(mux))
+	procs := ctx.Analyzer.Procs
+	require.Len(t, procs, 3)
+	assert.EqualValues(t, 3, procs[0].RecordsOut)
+	assert.EqualValues(t, 3, procs[1].RecordsIn)
+	assert.EqualValues(t, 3, procs[1].RecordsOut)
+	assert.Equal(t, 3, procs[1].PeakBuffered)
+	assert.EqualValues(t, 3, procs[2].RecordsIn)
+	assert.EqualValues(t, 2, procs[2].RecordsOut)
+	assert.EqualValues(t, 1, procs[2].BatchesOut)
+
+	recs, err := AnalysisRecords(ctx.TypeContext, ctx.Analyzer)
+	require.NoError(t, err)
+	require.Len(t, recs, 3)
+	s, err := recs[1].AccessString("proc")
+	require.NoError(t, err)
+	assert.Equal(t, "sort n", s)
+}
+
+func TestAnalyzePeakBuffered(t *testing.T) {
+	cases := []struct {
+		query    string
+		expected int
+	}{
+		{"top 2 n", 2},
+		{"tail 2 by n", 4},
+		{"uniq -by n", 4},
+		{"window next=lead(n)", 1},
+		{"session gap 1h by n", 4},
+		{"sample 2", 2},
+		{"histogram n", 4},
+	}
+	for _, c := range cases {
+		t.Run(c.query, func(t *testing.T) {
+			ctx, mux := compileAnalyzed(t, c.query, analyzeInput)
+			require.NoError(t, New(&counter{}).Run(mux))
+			procs := ctx.Analyzer.Procs
+			require.Len(t, procs, 2)
+			assert.Equal(t, c.expected, procs[1].PeakBuffered)
+		})
+	}
+}
+
+// TestAnalyzeParallel reads the statistics of a flowgraph with parallel
+// branches, which a Split feeds from its own goroutine, so run it with
+// -race to check that they are not read while that goroutine still
+// updates them.
+func TestAnalyzeParallel(t *testing.T) {
+	ctx, mux := compileAnalyzed(t, "* | (sort n | head 3; count())", analyzeInput)
+	require.NoError(t, New(&counter{}).Run(mux))
+	recs, err := AnalysisRecords(ctx.TypeContext, ctx.Analyzer)
+	require.NoError(t, err)
+	require.Len(t, recs, 4)
+	procs := ctx.Analyzer.Procs
+	assert.EqualValues(t, 4, procs[0].RecordsOut)
+	assert.EqualValues(t, 4, procs[1].RecordsIn)
+	assert.EqualValues(t, 3, procs[2].RecordsOut)
+	assert.EqualValues(t, 4, procs[3].RecordsIn)
+	assert.EqualValues(t, 1, procs[3].RecordsOut)
+}
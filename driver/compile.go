@@ -4,7 +4,12 @@ import (
 	"context"
 
 	"github.com/brimsec/zq/ast"
+	"github.com/brimsec/zq/filter"
+	"github.com/brimsec/zq/optimizer"
+	"github.com/brimsec/zq/pkg/nano"
 	"github.com/brimsec/zq/proc"
+	"github.com/brimsec/zq/scanner"
+	"github.com/brimsec/zq/zbuf"
 	"github.com/brimsec/zq/zng/resolver"
 	"go.uber.org/zap"
 )
@@ -22,3 +27,62 @@ func Compile(program ast.Proc, input proc.Proc) (*proc.MuxOutput, error) {
 	}
 	return proc.NewMuxOutput(ctx, leaves), nil
 }
+
+// CompileQuery optimizes program and compiles it into a flowgraph that
+// reads the records of reader within span.  If workers is greater than
+// one or the query begins with a search for which a zbuf.Prefilter can
+// skip records without decoding them, the records are decoded and
+// filtered by a scanner.ParallelScanner with that many workers.  If ctx
+// has an Analyzer, the scanner that reads the records is analyzed as its
+// first proc.  The scan stops when ctx is canceled and ends early,
+// leaving the procs to output results for the records scanned so far,
+// when the deadline of ctx passes, which the Stopped method of the
+// returned MuxOutput reports.
+func CompileQuery(ctx *proc.Context, program ast.Proc, reader zbuf.Reader, span nano.Span, workers int) (*proc.MuxOutput, error) {
+	// Try to move the filter into the scanner so we can throw out
+	// unmatched records without copying their contents in the case of
+	// readers (like zio raw.Reader) that create volatile records that
+	// are kept by the scanner only if matched.  For other readers, it
+	// certainly doesn't hurt to do this.
+	var f filter.Filter
+	var prefilter *zbuf.Prefilter
+	program = optimizer.Optimize(program)
+	filterProc, rest := optimizer.LiftFilter(program)
+	if filterProc != nil {
+		var err error
+		f, err = filter.Compile(filterProc.Filter)
+		if err != nil {
+			return nil, err
+		}
+		prefilter = zbuf.NewPrefilter(filterProc.Filter)
+		program = rest
+	}
+	var input proc.Proc
+	var stopped func() bool
+	if workers > 1 || prefilter != nil {
+		s := scanner.NewParallelScanner(reader, f, workers)
+		s.SetSpan(span)
+		s.SetPrefilter(prefilter)
+		s.SetContext(ctx.Context)
+		input, stopped = s, s.Stopped
+	} else {
+		s := scanner.NewScanner(reader, f)
+		s.SetSpan(span)
+		s.SetContext(ctx.Context)
+		input, stopped = s, s.Stopped
+	}
+	if ctx.Analyzer != nil {
+		var node ast.Proc
+		if filterProc != nil {
+			node = filterProc
+		}
+		input = ctx.Analyzer.Source(node, input)
+	}
+	leaves, err := proc.CompileProc(nil, program, ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	mux := proc.NewMuxOutput(ctx, leaves)
+	mux.SetStopped(stopped)
+	return mux, nil
+}
@@ -1,9 +1,15 @@
 package driver
 
 import (
+	"bytes"
+	"context"
+	"fmt"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/brimsec/zq/pkg/nano"
+	"github.com/brimsec/zq/proc"
 	"github.com/brimsec/zq/scanner"
 	"github.com/brimsec/zq/zbuf"
 	"github.com/brimsec/zq/zio/zngio"
@@ -11,6 +17,7 @@ import (
 	"github.com/brimsec/zq/zng/resolver"
 	"github.com/brimsec/zq/zql"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 type counter struct {
@@ -63,3 +70,78 @@ func TestMuxDriver(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+// stallReader is a reader that stalls after n records until the deadline
+// of ctx passes.
+type stallReader struct {
+	zbuf.Reader
+	ctx context.Context
+	n   int
+}
+
+func (r *stallReader) Read() (*zng.Record, error) {
+	if r.n == 0 {
+		<-r.ctx.Done()
+	}
+	r.n--
+	return r.Reader.Read()
+}
+
+func TestTimeout(t *testing.T) {
+	var input strings.Builder
+	input.WriteString("#0:record[n:int64]\n")
+	const total = 20000
+	for k := 0; k < total; k++ {
+		fmt.Fprintf(&input, "0:[%d;]\n", k%2)
+	}
+	query, err := zql.ParseProc("(count(); count() by n)")
+	require.NoError(t, err)
+	for _, workers := range []int{1, 4} {
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		zctx := resolver.NewContext()
+		pctx := proc.NewTestContext(zctx)
+		pctx.Context = ctx
+		reader := &stallReader{zngio.NewReader(strings.NewReader(input.String()), zctx), ctx, 1500}
+		mux, err := CompileQuery(pctx, query, reader, nano.Span{}, workers)
+		require.NoError(t, err)
+		var count, byN bytes.Buffer
+		d := New(zngio.NewWriter(&count), zngio.NewWriter(&byN))
+		require.NoError(t, d.Run(mux))
+
+		// The results of the scanned records are output even though
+		// the scan ended early.
+		r := zngio.NewReader(&count, resolver.NewContext())
+		rec, err := r.Read()
+		require.NoError(t, err)
+		require.NotNil(t, rec, "%d workers", workers)
+		n, err := rec.AccessInt("count")
+		require.NoError(t, err)
+		assert.True(t, n > 0 && n < total, "%d workers: count %d", workers, n)
+		assert.Equal(t, 2, strings.Count(byN.String(), "\n0:["), "%d workers", workers)
+		assert.True(t, mux.Stopped(), "%d workers", workers)
+	}
+}
+
+func TestTimeoutAfterScan(t *testing.T) {
+	const input = "#0:record[n:int64]\n0:[1;]\n0:[2;]\n"
+	query, err := zql.ParseProc("count()")
+	require.NoError(t, err)
+	for _, workers := range []int{1, 4} {
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		zctx := resolver.NewContext()
+		pctx := proc.NewTestContext(zctx)
+		pctx.Context = ctx
+		reader := zngio.NewReader(strings.NewReader(input), zctx)
+		mux, err := CompileQuery(pctx, query, reader, nano.Span{}, workers)
+		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, New(zngio.NewWriter(&out)).Run(mux))
+		// The scan completed before the deadline, so its passing
+		// afterward does not make the results partial.
+		<-ctx.Done()
+		assert.False(t, mux.Stopped(), "%d workers", workers)
+		assert.Contains(t, out.String(), "0:[2;]", "%d workers", workers)
+	}
+}
@@ -35,7 +35,19 @@ type NativeEvaluator func(*zng.Record) (zngnative.Value, error)
 // we could fail back to the "slow path" implemented here if an
 // expression ever touches a union.
 func CompileExpr(node ast.Expression) (ExpressionEvaluator, error) {
-	ne, err := compileNative(node)
+	return CompileExprWithHook(node, nil)
+}
+
+// A FunctionHook compiles calls to functions that are implemented outside
+// of this package, e.g., by a proc that maintains state across records.
+// It returns a nil evaluator for any function it does not implement so
+// that the call is compiled as a call to a built-in function.
+type FunctionHook func(*ast.FunctionCall) (NativeEvaluator, error)
+
+// CompileExprWithHook is like CompileExpr but consults hook, if non-nil,
+// before compiling each function call in the expression.
+func CompileExprWithHook(node ast.Expression, hook FunctionHook) (ExpressionEvaluator, error) {
+	ne, err := compileNative(node, hook)
 	if err != nil {
 		return nil, err
 	}
@@ -50,7 +62,7 @@ func CompileExpr(node ast.Expression) (ExpressionEvaluator, error) {
 	}, nil
 }
 
-func compileNative(node ast.Expression) (NativeEvaluator, error) {
+func compileNative(node ast.Expression, hook FunctionHook) (NativeEvaluator, error) {
 	switch n := node.(type) {
 	case *ast.Literal:
 		v, err := zng.Parse(*n)
@@ -77,11 +89,11 @@ func compileNative(node ast.Expression) (NativeEvaluator, error) {
 		}, nil
 
 	case *ast.BinaryExpression:
-		lhsFunc, err := compileNative(n.LHS)
+		lhsFunc, err := compileNative(n.LHS, hook)
 		if err != nil {
 			return nil, err
 		}
-		rhsFunc, err := compileNative(n.RHS)
+		rhsFunc, err := compileNative(n.RHS, hook)
 		if err != nil {
 			return nil, err
 		}
@@ -103,7 +115,16 @@ func compileNative(node ast.Expression) (NativeEvaluator, error) {
 		}
 
 	case *ast.FunctionCall:
-		return compileFunctionCall(*n)
+		if hook != nil {
+			eval, err := hook(n)
+			if err != nil {
+				return nil, err
+			}
+			if eval != nil {
+				return eval, nil
+			}
+		}
+		return compileFunctionCall(*n, hook)
 
 	default:
 		return nil, fmt.Errorf("invalid expression type %T", node)
@@ -743,23 +764,20 @@ func compileFieldReference(lhsFunc, rhsFunc NativeEvaluator, operator string) (N
 	}, nil
 }
 
-func compileFunctionCall(node ast.FunctionCall) (NativeEvaluator, error) {
+func compileFunctionCall(node ast.FunctionCall, hook FunctionHook) (NativeEvaluator, error) {
 	fn, ok := allFns[node.Function]
 	if !ok {
 		return nil, fmt.Errorf("%s: %w", node.Function, ErrNoSuchFunction)
 	}
+	if err := CheckFunctionCall(&node); err != nil {
+		return nil, err
+	}
 
 	nargs := len(node.Args)
-	if fn.minArgs >= 0 && nargs < fn.minArgs {
-		return nil, fmt.Errorf("%s: %w", node.Function, ErrTooFewArgs)
-	}
-	if fn.maxArgs >= 0 && nargs > fn.maxArgs {
-		return nil, fmt.Errorf("%s: %w", node.Function, ErrTooManyArgs)
-	}
 
 	exprs := make([]NativeEvaluator, nargs)
 	for i, expr := range node.Args {
-		eval, err := compileNative(expr)
+		eval, err := compileNative(expr, hook)
 		if err != nil {
 			return nil, err
 		}
@@ -5,7 +5,10 @@ import (
 	"fmt"
 	"math"
 
+	"github.com/brimsec/zq/ast"
 	"github.com/brimsec/zq/zng"
+	"github.com/brimsec/zq/zng/inferjson"
+	"github.com/brimsec/zq/zng/resolver"
 	"github.com/brimsec/zq/zngnative"
 )
 
@@ -25,6 +28,36 @@ var allFns = map[string]struct {
 	"Math.sqrt": {1, 1, mathSqrt},
 }
 
+// contextFns holds the arities of the functions implemented by
+// ContextFunctions.
+var contextFns = map[string]struct {
+	minArgs int
+	maxArgs int
+}{
+	"parseJSON": {1, 1},
+}
+
+// CheckFunctionCall returns an error if call names a function that does
+// not exist or passes it too few or too many arguments.
+func CheckFunctionCall(call *ast.FunctionCall) error {
+	var minArgs, maxArgs int
+	if fn, ok := allFns[call.Function]; ok {
+		minArgs, maxArgs = fn.minArgs, fn.maxArgs
+	} else if fn, ok := contextFns[call.Function]; ok {
+		minArgs, maxArgs = fn.minArgs, fn.maxArgs
+	} else {
+		return fmt.Errorf("%s: %w", call.Function, ErrNoSuchFunction)
+	}
+	nargs := len(call.Args)
+	if minArgs >= 0 && nargs < minArgs {
+		return fmt.Errorf("%s: %w", call.Function, ErrTooFewArgs)
+	}
+	if maxArgs >= 0 && nargs > maxArgs {
+		return fmt.Errorf("%s: %w", call.Function, ErrTooManyArgs)
+	}
+	return nil
+}
+
 func mathMax(args []zngnative.Value) (zngnative.Value, error) {
 	switch args[0].Type.ID() {
 	case zng.IdInt16, zng.IdInt32, zng.IdInt64:
@@ -122,3 +155,45 @@ func mathSqrt(args []zngnative.Value) (zngnative.Value, error) {
 
 	return zngnative.Value{zng.TypeFloat64, r}, nil
 }
+
+// ContextFunctions returns a FunctionHook implementing the functions that
+// create values of new types, which must be allocated in zctx.
+func ContextFunctions(zctx *resolver.Context) FunctionHook {
+	var hook FunctionHook
+	hook = func(call *ast.FunctionCall) (NativeEvaluator, error) {
+		switch call.Function {
+		case "parseJSON":
+			if err := CheckFunctionCall(call); err != nil {
+				return nil, err
+			}
+			arg, err := compileNative(call.Args[0], hook)
+			if err != nil {
+				return nil, err
+			}
+			return func(r *zng.Record) (zngnative.Value, error) {
+				v, err := arg(r)
+				if err != nil {
+					return zngnative.Value{}, err
+				}
+				return parseJSON(zctx, v)
+			}, nil
+		}
+		return nil, nil
+	}
+	return hook
+}
+
+// parseJSON decodes a JSON string into a value whose type is inferred as
+// for JSON input, e.g., an object becomes a record.
+func parseJSON(zctx *resolver.Context, v zngnative.Value) (zngnative.Value, error) {
+	switch v.Type.ID() {
+	case zng.IdString, zng.IdBstring:
+	default:
+		return zngnative.Value{}, fmt.Errorf("parseJSON: %w", ErrBadArgument)
+	}
+	zv, err := inferjson.ParseValue(zctx, []byte(v.Value.(string)))
+	if err != nil {
+		return zngnative.Value{}, fmt.Errorf("parseJSON: %w", err)
+	}
+	return zngnative.ToNativeValue(zv)
+}
@@ -0,0 +1,457 @@
+// Package optimizer rewrites the AST of a query into an equivalent AST
+// that runs faster.  Filters are moved toward the start of each chain of
+// procs, where the first one can be run by the scanner, and adjacent
+// filters are merged.  Cuts are moved ahead of sorts so that sorting
+// buffers only the fields that are kept, and a partial cut, which unlike
+// a cut keeps records lacking some of its fields, is inserted ahead of
+// the first sort, groupby, or tail of a chain whose fields are known.
+// Fields reports those fields so that a reader can skip the others.
+package optimizer
+
+import (
+	"strings"
+
+	"github.com/brimsec/zq/ast"
+)
+
+// Optimize returns a proc equivalent to p.  It does not modify p.
+func Optimize(p ast.Proc) ast.Proc {
+	switch p := p.(type) {
+	case *ast.SequentialProc:
+		return &ast.SequentialProc{
+			Node:  ast.Node{"SequentialProc"},
+			Procs: optimizeChain(p.Procs),
+		}
+	case *ast.ParallelProc:
+		procs := make([]ast.Proc, 0, len(p.Procs))
+		for _, p := range p.Procs {
+			procs = append(procs, Optimize(p))
+		}
+		return &ast.ParallelProc{
+			Node:  ast.Node{"ParallelProc"},
+			Procs: procs,
+		}
+	case *ast.SwitchProc:
+		cases := make([]ast.SwitchCase, 0, len(p.Cases))
+		for _, c := range p.Cases {
+			cases = append(cases, ast.SwitchCase{
+				Filter: c.Filter,
+				Proc:   Optimize(c.Proc),
+			})
+		}
+		return &ast.SwitchProc{
+			Node:  ast.Node{"SwitchProc"},
+			Cases: cases,
+		}
+	}
+	return p
+}
+
+// LiftFilter splits p into a filter to be run by the scanner and the
+// proc that processes the records matching the filter.  If p does not
+// begin with a filter, LiftFilter returns nil, nil.
+func LiftFilter(p ast.Proc) (*ast.FilterProc, ast.Proc) {
+	if fp, ok := p.(*ast.FilterProc); ok {
+		pass := &ast.PassProc{
+			Node: ast.Node{"PassProc"},
+		}
+		return fp, pass
+	}
+	seq, ok := p.(*ast.SequentialProc)
+	if ok && len(seq.Procs) > 0 {
+		if fp, ok := seq.Procs[0].(*ast.FilterProc); ok {
+			rest := &ast.SequentialProc{
+				Node:  ast.Node{"SequentialProc"},
+				Procs: seq.Procs[1:],
+			}
+			return fp, rest
+		}
+	}
+	return nil, nil
+}
+
+// Fields returns the top-level fields of its input records that p reads
+// or false if p may read any field.  The fields are known only for a
+// chain of filters, sorts, heads, and tails on named fields ending in a
+// groupby or cut.  If the fields are known, the slice is not nil even if
+// there are none, as for "count()".
+func Fields(p ast.Proc) ([]string, bool) {
+	procs := []ast.Proc{p}
+	if seq, ok := p.(*ast.SequentialProc); ok {
+		procs = seq.Procs
+	}
+	fields := []string{}
+	seen := make(map[string]bool)
+	add := func(names []string) {
+		for _, name := range names {
+			if k := strings.IndexByte(name, '.'); k >= 0 {
+				name = name[:k]
+			}
+			if !seen[name] {
+				seen[name] = true
+				fields = append(fields, name)
+			}
+		}
+	}
+	for _, p := range procs {
+		switch p := p.(type) {
+		case *ast.PassProc:
+		case *ast.FilterProc:
+			names, ok := filterFields(p.Filter)
+			if !ok {
+				return nil, false
+			}
+			add(names)
+		case *ast.SortProc:
+			// A sort without fields sorts by a field chosen from
+			// its first record.
+			names, ok := fieldNames(p.Fields)
+			if !ok || len(names) == 0 {
+				return nil, false
+			}
+			add(names)
+		case *ast.HeadProc:
+			names, ok := fieldNames(p.Keys)
+			if !ok {
+				return nil, false
+			}
+			add(names)
+		case *ast.TailProc:
+			names, ok := fieldNames(p.Keys)
+			if !ok {
+				return nil, false
+			}
+			add(names)
+		case *ast.CutProc:
+			names, ok := fieldNames(p.Fields)
+			if !ok {
+				return nil, false
+			}
+			add(names)
+			// A partial cut outputs a record lacking all of its
+			// fields as is so the procs after it may read others.
+			if !p.Partial {
+				return fields, true
+			}
+		case *ast.ReducerProc:
+			names, ok := reducerFields(p.Reducers)
+			if !ok {
+				return nil, false
+			}
+			add(names)
+			return fields, true
+		case *ast.GroupByProc:
+			keys, ok := fieldNames(p.Keys)
+			if !ok {
+				return nil, false
+			}
+			names, ok := reducerFields(p.Reducers)
+			if !ok {
+				return nil, false
+			}
+			add(append(keys, names...))
+			if p.Duration.Nanoseconds != 0 || p.Window.Kind != "" {
+				add([]string{"ts"})
+			}
+			return fields, true
+		default:
+			return nil, false
+		}
+	}
+	return nil, false
+}
+
+func reducerFields(reducers []ast.Reducer) ([]string, bool) {
+	var names []string
+	for _, r := range reducers {
+		if r.Field == nil {
+			continue
+		}
+		name, ok := fieldName(r.Field)
+		if !ok {
+			return nil, false
+		}
+		names = append(names, name)
+	}
+	return names, true
+}
+
+// optimizeChain optimizes the procs of a SequentialProc, splicing in the
+// procs of any nested SequentialProc.
+func optimizeChain(in []ast.Proc) []ast.Proc {
+	var procs []ast.Proc
+	for _, p := range in {
+		p = Optimize(p)
+		if seq, ok := p.(*ast.SequentialProc); ok {
+			procs = append(procs, seq.Procs...)
+		} else {
+			procs = append(procs, p)
+		}
+	}
+	// Each rewrite moves a proc earlier or removes one so this loop
+	// terminates.
+	for changed := true; changed; {
+		changed = false
+		for k := 1; k < len(procs); k++ {
+			if rewrite(procs, k) {
+				procs = compact(procs)
+				changed = true
+				break
+			}
+		}
+	}
+	return project(procs)
+}
+
+// project returns procs with a partial cut inserted ahead of the first
+// sort, groupby, or tail if the fields read from there on are known and
+// no cut precedes it.  The partial cut keeps every record, so the procs
+// after it see the same records with only the fields they read.
+func project(procs []ast.Proc) []ast.Proc {
+	for k, p := range procs {
+		switch p.(type) {
+		case *ast.CutProc:
+			return procs
+		case *ast.SortProc, *ast.GroupByProc, *ast.ReducerProc, *ast.TailProc:
+			rest := &ast.SequentialProc{
+				Node:  ast.Node{"SequentialProc"},
+				Procs: procs[k:],
+			}
+			fields, ok := Fields(rest)
+			if !ok || len(fields) == 0 {
+				return procs
+			}
+			cut := &ast.CutProc{
+				Node:    ast.Node{"CutProc"},
+				Partial: true,
+			}
+			for _, name := range fields {
+				cut.Fields = append(cut.Fields, &ast.FieldRead{
+					Node:  ast.Node{"FieldRead"},
+					Field: name,
+				})
+			}
+			out := make([]ast.Proc, 0, len(procs)+1)
+			out = append(out, procs[:k]...)
+			out = append(out, cut)
+			return append(out, procs[k:]...)
+		}
+	}
+	return procs
+}
+
+// rewrite replaces procs[k-1] and procs[k] with an equivalent pair,
+// leaving nil in place of a removed proc, and returns true if it made
+// a change.
+func rewrite(procs []ast.Proc, k int) bool {
+	switch p := procs[k].(type) {
+	case *ast.FilterProc:
+		switch prev := procs[k-1].(type) {
+		case *ast.FilterProc:
+			procs[k-1] = &ast.FilterProc{
+				Node:   ast.Node{"FilterProc"},
+				Filter: and(prev.Filter, p.Filter),
+			}
+			procs[k] = nil
+			return true
+		case *ast.SortProc:
+			// A sort without fields sorts by a field chosen from
+			// its first record, which a filter could change.
+			if len(prev.Fields) > 0 {
+				procs[k-1], procs[k] = p, prev
+				return true
+			}
+		case *ast.CutProc:
+			if fields, ok := filterFields(p.Filter); ok && covered(fields, prev.Fields) {
+				procs[k-1], procs[k] = p, prev
+				return true
+			}
+		case *ast.PutProc:
+			if fields, ok := filterFields(p.Filter); ok && !references(fields, prev.Target) {
+				procs[k-1], procs[k] = p, prev
+				return true
+			}
+		case *ast.ParallelProc:
+			branches := make([]ast.Proc, 0, len(prev.Procs))
+			for _, b := range prev.Procs {
+				branches = append(branches, appendProc(b, p))
+			}
+			procs[k-1] = &ast.ParallelProc{
+				Node:  ast.Node{"ParallelProc"},
+				Procs: branches,
+			}
+			procs[k] = nil
+			return true
+		case *ast.SwitchProc:
+			cases := make([]ast.SwitchCase, 0, len(prev.Cases))
+			for _, c := range prev.Cases {
+				cases = append(cases, ast.SwitchCase{
+					Filter: c.Filter,
+					Proc:   appendProc(c.Proc, p),
+				})
+			}
+			procs[k-1] = &ast.SwitchProc{
+				Node:  ast.Node{"SwitchProc"},
+				Cases: cases,
+			}
+			procs[k] = nil
+			return true
+		}
+	case *ast.CutProc:
+		// A cut drops each record lacking any of its fields, and a
+		// partial cut drops none, so either can run before a sort on
+		// fields it keeps without changing which records are output or
+		// their order.
+		if prev, ok := procs[k-1].(*ast.SortProc); ok && len(prev.Fields) > 0 {
+			if fields, ok := fieldNames(prev.Fields); ok && covered(fields, p.Fields) {
+				procs[k-1], procs[k] = p, prev
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func compact(procs []ast.Proc) []ast.Proc {
+	out := procs[:0]
+	for _, p := range procs {
+		if p != nil {
+			out = append(out, p)
+		}
+	}
+	return out
+}
+
+// appendProc returns an optimized chain of p followed by next.
+func appendProc(p ast.Proc, next ast.Proc) ast.Proc {
+	var procs []ast.Proc
+	if seq, ok := p.(*ast.SequentialProc); ok {
+		procs = append(procs, seq.Procs...)
+	} else {
+		procs = append(procs, p)
+	}
+	return &ast.SequentialProc{
+		Node:  ast.Node{"SequentialProc"},
+		Procs: optimizeChain(append(procs, next)),
+	}
+}
+
+func and(left, right ast.BooleanExpr) ast.BooleanExpr {
+	if _, ok := left.(*ast.MatchAll); ok {
+		return right
+	}
+	if _, ok := right.(*ast.MatchAll); ok {
+		return left
+	}
+	return &ast.LogicalAnd{
+		Node:  ast.Node{"LogicalAnd"},
+		Left:  left,
+		Right: right,
+	}
+}
+
+// filterFields returns the names of the fields compared by e or false if
+// e may depend on fields it does not name.
+func filterFields(e ast.BooleanExpr) ([]string, bool) {
+	switch e := e.(type) {
+	case *ast.LogicalAnd:
+		return filterFields2(e.Left, e.Right)
+	case *ast.LogicalOr:
+		return filterFields2(e.Left, e.Right)
+	case *ast.LogicalNot:
+		return filterFields(e.Expr)
+	case *ast.MatchAll:
+		return nil, true
+	case *ast.CompareField:
+		name, ok := fieldName(e.Field)
+		if !ok {
+			return nil, false
+		}
+		return []string{name}, true
+	}
+	return nil, false
+}
+
+func filterFields2(left, right ast.BooleanExpr) ([]string, bool) {
+	l, ok := filterFields(left)
+	if !ok {
+		return nil, false
+	}
+	r, ok := filterFields(right)
+	if !ok {
+		return nil, false
+	}
+	return append(l, r...), true
+}
+
+// fieldName returns the dotted name of the field whose value determines
+// the value of e.
+func fieldName(e ast.FieldExpr) (string, bool) {
+	switch e := e.(type) {
+	case *ast.FieldRead:
+		return e.Field, true
+	case *ast.FieldCall:
+		base, ok := fieldName(e.Field)
+		if !ok {
+			return "", false
+		}
+		switch e.Fn {
+		case "RecordFieldRead":
+			return base + "." + e.Param, true
+		case "Index", "Len":
+			return base, true
+		}
+	}
+	return "", false
+}
+
+func fieldNames(fields []ast.FieldExpr) ([]string, bool) {
+	var names []string
+	for _, f := range fields {
+		name, ok := fieldName(f)
+		if !ok {
+			return nil, false
+		}
+		names = append(names, name)
+	}
+	return names, true
+}
+
+// contains returns true if the field named field is within the field
+// named parent or is the same field.
+func contains(parent, field string) bool {
+	return field == parent || strings.HasPrefix(field, parent+".")
+}
+
+// covered returns true if each of fields keeps its value in the output
+// of a cut of the cut fields.
+func covered(fields []string, cut []ast.FieldExpr) bool {
+	names, ok := fieldNames(cut)
+	if !ok {
+		return false
+	}
+	for _, f := range fields {
+		found := false
+		for _, name := range names {
+			if contains(name, f) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// references returns true if any of fields is changed by an assignment
+// to target.
+func references(fields []string, target string) bool {
+	for _, f := range fields {
+		if contains(target, f) || contains(f, target) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,83 @@
+package optimizer
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/brimsec/zq/zql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOptimize(t *testing.T) {
+	tests := []struct {
+		query    string
+		expected string
+	}{
+		{"* | filter x=1", "x=1"},
+		{"a | filter b | filter c", "a b c"},
+		{"* | sort x | filter y=1", "y=1 | sort x"},
+		{"* | sort | filter y=1", "* | sort | filter y=1"},
+		{"* | cut x, y | filter x=1", "x=1 | cut x, y"},
+		{"* | cut x | filter y=1", "* | cut x | filter y=1"},
+		{"* | cut id | filter id.orig_h=10.0.0.1", "id.orig_h=10.0.0.1 | cut id"},
+		{"* | cut x | filter foo", "* | cut x | filter foo"},
+		{"* | put z = x + 1 | filter y=1", "y=1 | put z = x + 1"},
+		{"* | put z = x + 1 | filter z=1", "* | put z = x + 1 | filter z=1"},
+		{"* | sort x | cut x, y", "* | cut x, y | sort x"},
+		{"* | sort x | cut y", "* | cut -partial x, y | sort x | cut y"},
+		{"* | sort x | head 5 | cut x, y", "* | cut -partial x, y | sort x | head 5 | cut x, y"},
+		{"* | tail 5 | count() by x", "* | cut -partial x | tail 5 | count() by x"},
+		{"* | sum(y) by x", "* | cut -partial x, y | sum(y) by x"},
+		{"x=1 | sort y | cut x, y", "x=1 | cut x, y | sort y"},
+		{"* | cut -partial x | tail 5 | count() by x", "* | cut -partial x | tail 5 | count() by x"},
+		{"* | count()", "* | count()"},
+		{"* | sort | count() by x", "* | sort | count() by x"},
+		{"* | put y = x + 1 | sort y | count() by y", "* | put y = x + 1 | cut -partial y | sort y | count() by y"},
+		{"* | head 1 | filter x=1", "* | head 1 | filter x=1"},
+		{"* | count() by x | filter count=1", "* | cut -partial x | count() by x | filter count=1"},
+		{"* | (count(); sort x) | filter y=1", "* | (count() | filter y=1; filter y=1 | sort x)"},
+		{"* | switch (x=1 => sort y; default => head 1) | filter z=1",
+			"* | switch (x=1 => filter z=1 | sort y; default => head 1 | filter z=1)"},
+	}
+	for _, tc := range tests {
+		p, err := zql.ParseProc(tc.query)
+		require.NoError(t, err, "zql: %q", tc.query)
+		before, err := json.Marshal(p)
+		require.NoError(t, err)
+		actual, err := zql.FormatProc(Optimize(p))
+		require.NoError(t, err, "zql: %q", tc.query)
+		assert.Equal(t, tc.expected, actual, "zql: %q", tc.query)
+		after, err := json.Marshal(p)
+		require.NoError(t, err)
+		assert.JSONEq(t, string(before), string(after), "Optimize modified its input")
+	}
+}
+
+func TestFields(t *testing.T) {
+	tests := []struct {
+		query    string
+		expected []string
+	}{
+		{"count()", []string{}},
+		{"sum(orig_bytes) by proto", []string{"proto", "orig_bytes"}},
+		{"id.orig_h=10.0.0.1 | count() by id.resp_p", []string{"id"}},
+		{"x>1 | sort -r y | head 5 | cut z", []string{"x", "y", "z"}},
+		{"every 1h count()", []string{"ts"}},
+		{"x=1 | tail 1 by y | countdistinct(z)", []string{"x", "y", "z"}},
+		{"cut -partial x, y | sort x | count() by y", []string{"x", "y"}},
+		{"*", nil},
+		{"x=1", nil},
+		{"foo | count()", nil},
+		{"* | sort | count() by x", nil},
+		{"* | put y = x + 1 | count() by y", nil},
+		{"* | (count(); sum(x))", nil},
+	}
+	for _, tc := range tests {
+		p, err := zql.ParseProc(tc.query)
+		require.NoError(t, err, "zql: %q", tc.query)
+		fields, ok := Fields(p)
+		assert.Equal(t, tc.expected != nil, ok, "zql: %q", tc.query)
+		assert.Equal(t, tc.expected, fields, "zql: %q", tc.query)
+	}
+}
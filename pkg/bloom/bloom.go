@@ -0,0 +1,65 @@
+// Package bloom provides a Bloom filter, a compact summary of a set of keys
+// that tells with certainty when a key is not in the set and with a small
+// rate of false positives when it is.
+package bloom
+
+import (
+	"hash/fnv"
+)
+
+const (
+	// bitsPerKey and numHashes give a false positive rate of about 1%.
+	bitsPerKey = 10
+	numHashes  = 7
+)
+
+// Filter is a Bloom filter.  Its fields are exported so that it can be
+// marshaled.
+type Filter struct {
+	Bits []byte
+	K    int
+}
+
+// New returns an empty Filter sized for n keys.
+func New(n int) *Filter {
+	nbytes := (n*bitsPerKey + 7) / 8
+	if nbytes == 0 {
+		nbytes = 1
+	}
+	return &Filter{
+		Bits: make([]byte, nbytes),
+		K:    numHashes,
+	}
+}
+
+// hash returns the two halves of a 64-bit hash of key, from which the
+// positions of the key's bits are derived by double hashing.
+func hash(key []byte) (uint32, uint32) {
+	h := fnv.New64a()
+	h.Write(key)
+	sum := h.Sum64()
+	return uint32(sum), uint32(sum >> 32)
+}
+
+func (f *Filter) Add(key []byte) {
+	nbits := uint32(len(f.Bits) * 8)
+	h1, h2 := hash(key)
+	for k := 0; k < f.K; k++ {
+		bit := (h1 + uint32(k)*h2) % nbits
+		f.Bits[bit/8] |= 1 << (bit % 8)
+	}
+}
+
+// Test returns false if key was not added to f.  It returns true if the key
+// was added or, rarely, if it was not.
+func (f *Filter) Test(key []byte) bool {
+	nbits := uint32(len(f.Bits) * 8)
+	h1, h2 := hash(key)
+	for k := 0; k < f.K; k++ {
+		bit := (h1 + uint32(k)*h2) % nbits
+		if f.Bits[bit/8]&(1<<(bit%8)) == 0 {
+			return false
+		}
+	}
+	return true
+}
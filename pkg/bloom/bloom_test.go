@@ -0,0 +1,31 @@
+package bloom_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/brimsec/zq/pkg/bloom"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilter(t *testing.T) {
+	t.Parallel()
+	const n = 10000
+	f := bloom.New(n)
+	for k := 0; k < n; k++ {
+		f.Add([]byte(strconv.Itoa(k)))
+	}
+	for k := 0; k < n; k++ {
+		assert.True(t, f.Test([]byte(strconv.Itoa(k))))
+	}
+	var positives int
+	for k := n; k < 2*n; k++ {
+		if f.Test([]byte(strconv.Itoa(k))) {
+			positives++
+		}
+	}
+	assert.Less(t, positives, n/50, "false positive rate")
+
+	empty := bloom.New(0)
+	assert.False(t, empty.Test([]byte("a")))
+}
@@ -0,0 +1,175 @@
+// Package grok compiles grok-style patterns, which are regular expressions
+// that refer to named patterns from a library with the syntax %{NAME},
+// into Go regular expressions.  A reference may name the field that
+// captures the text it matches with %{NAME:field} and the type of that
+// field with %{NAME:field:type}.
+package grok
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+)
+
+// maxDepth bounds the nesting of pattern references to catch patterns that
+// refer to themselves.
+const maxDepth = 64
+
+var (
+	refRegexp  = regexp.MustCompile(`%\{([^}]*)\}`)
+	nameRegexp = regexp.MustCompile(`^\w+$`)
+)
+
+// A Library is a set of named patterns.
+type Library struct {
+	patterns map[string]string
+}
+
+// NewLibrary returns a library holding the built-in patterns.
+func NewLibrary() *Library {
+	l := &Library{patterns: make(map[string]string)}
+	if err := l.Load(strings.NewReader(defaultPatterns)); err != nil {
+		panic(err)
+	}
+	return l
+}
+
+// Add adds a pattern to the library, replacing any pattern of the same name.
+func (l *Library) Add(name, pattern string) {
+	l.patterns[name] = pattern
+}
+
+// Load adds the patterns read from r, which has one pattern per line with
+// the pattern's name separated from the pattern by white space.  Blank
+// lines and lines beginning with "#" are ignored.
+func (l *Library) Load(r io.Reader) error {
+	scanner := bufio.NewScanner(r)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || line[0] == '#' {
+			continue
+		}
+		k := strings.IndexAny(line, " \t")
+		if k < 0 {
+			return fmt.Errorf("line %d: pattern is missing", n)
+		}
+		name := line[:k]
+		if !nameRegexp.MatchString(name) {
+			return fmt.Errorf("line %d: invalid pattern name: %s", n, name)
+		}
+		l.Add(name, strings.TrimSpace(line[k:]))
+	}
+	return scanner.Err()
+}
+
+// A Field is a field captured by a pattern.  Type is the type given in the
+// pattern, the default type of the referenced pattern (e.g., int64 for INT
+// or ip for IP), or empty if neither is known.
+type Field struct {
+	Name string
+	Type string
+}
+
+// A Capture is the text captured for a field.  Matched is false if the
+// part of the pattern capturing the field did not participate in a match.
+type Capture struct {
+	Value   string
+	Matched bool
+}
+
+// A Grok is a compiled pattern.
+type Grok struct {
+	Fields []Field
+	re     *regexp.Regexp
+	// groups holds the index of the regexp subexpression capturing each
+	// field.
+	groups []int
+}
+
+// Compile compiles pattern using the patterns of the library.
+func (l *Library) Compile(pattern string) (*Grok, error) {
+	var fields []Field
+	expanded, err := l.expand(pattern, 0, &fields)
+	if err != nil {
+		return nil, err
+	}
+	re, err := regexp.Compile(expanded)
+	if err != nil {
+		return nil, err
+	}
+	groups := make([]int, len(fields))
+	for k, name := range re.SubexpNames() {
+		var n int
+		if _, err := fmt.Sscanf(name, "grok%d", &n); err == nil && n < len(groups) {
+			groups[n] = k
+		}
+	}
+	return &Grok{Fields: fields, re: re, groups: groups}, nil
+}
+
+// expand replaces each pattern reference in pattern with the referenced
+// pattern.  References naming a field are captured only at the top level,
+// i.e., when fields is not nil.
+func (l *Library) expand(pattern string, depth int, fields *[]Field) (string, error) {
+	if depth > maxDepth {
+		return "", fmt.Errorf("pattern references nested too deeply: %s", pattern)
+	}
+	var b strings.Builder
+	var err error
+	last := 0
+	for _, loc := range refRegexp.FindAllStringSubmatchIndex(pattern, -1) {
+		b.WriteString(pattern[last:loc[0]])
+		last = loc[1]
+		parts := strings.Split(pattern[loc[2]:loc[3]], ":")
+		name := parts[0]
+		sub, ok := l.patterns[name]
+		if !ok {
+			return "", fmt.Errorf("unknown pattern: %s", name)
+		}
+		if len(parts) > 3 {
+			return "", fmt.Errorf("invalid pattern reference: %s", pattern[loc[0]:loc[1]])
+		}
+		sub, err = l.expand(sub, depth+1, nil)
+		if err != nil {
+			return "", err
+		}
+		if len(parts) == 1 || fields == nil {
+			b.WriteString("(?:" + sub + ")")
+			continue
+		}
+		field := Field{Name: parts[1], Type: defaultTypes[name]}
+		if len(parts) == 3 {
+			field.Type = parts[2]
+		}
+		if !nameRegexp.MatchString(field.Name) {
+			return "", fmt.Errorf("invalid field name: %s", field.Name)
+		}
+		for _, f := range *fields {
+			if f.Name == field.Name {
+				return "", fmt.Errorf("duplicate field name: %s", field.Name)
+			}
+		}
+		fmt.Fprintf(&b, "(?P<grok%d>%s)", len(*fields), sub)
+		*fields = append(*fields, field)
+	}
+	b.WriteString(pattern[last:])
+	return b.String(), nil
+}
+
+// Match returns the text captured for each field if s matches the pattern
+// or nil if it does not.
+func (g *Grok) Match(s string) []Capture {
+	loc := g.re.FindStringSubmatchIndex(s)
+	if loc == nil {
+		return nil
+	}
+	captures := make([]Capture, len(g.groups))
+	for k, group := range g.groups {
+		if start := loc[2*group]; start >= 0 {
+			captures[k] = Capture{s[start:loc[2*group+1]], true}
+		}
+	}
+	return captures
+}
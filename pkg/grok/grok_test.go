@@ -0,0 +1,91 @@
+package grok
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompile(t *testing.T) {
+	l := NewLibrary()
+	g, err := l.Compile(`%{IP:client} %{WORD:method} %{URIPATHPARAM:path}(?: %{NUMBER:bytes:int64})?`)
+	require.NoError(t, err)
+	assert.Equal(t, []Field{
+		{"client", "ip"},
+		{"method", ""},
+		{"path", ""},
+		{"bytes", "int64"},
+	}, g.Fields)
+
+	assert.Equal(t, []Capture{
+		{"10.0.0.1", true},
+		{"GET", true},
+		{"/index.html?a=b", true},
+		{"512", true},
+	}, g.Match("10.0.0.1 GET /index.html?a=b 512"))
+	assert.Equal(t, []Capture{
+		{"fe80::1", true},
+		{"POST", true},
+		{"/", true},
+		{"", false},
+	}, g.Match("fe80::1 POST /"))
+	assert.Nil(t, g.Match("no match here"))
+}
+
+func TestBuiltins(t *testing.T) {
+	l := NewLibrary()
+	cases := []struct {
+		pattern string
+		input   string
+		capture string
+	}{
+		{"%{TIMESTAMP_ISO8601:x}", "at 2020-03-01T12:30:45.123Z", "2020-03-01T12:30:45.123Z"},
+		{"%{HTTPDATE:x}", "[10/Oct/2000:13:55:36 -0700]", "10/Oct/2000:13:55:36 -0700"},
+		{"%{SYSLOGTIMESTAMP:x}", "Mar  1 12:30:45 host", "Mar  1 12:30:45"},
+		{"%{EMAILADDRESS:x}", "to: user@example.com", "user@example.com"},
+		{"%{URI:x}", "see https://example.com:8080/a/b?c=d", "https://example.com:8080/a/b?c=d"},
+		{"%{QUOTEDSTRING:x}", `say "hello \"there\""`, `"hello \"there\""`},
+		{"%{LOGLEVEL:x}", "[WARNING] disk full", "WARNING"},
+		{"%{MAC:x}", "hw 00:1a:2b:3c:4d:5e", "00:1a:2b:3c:4d:5e"},
+	}
+	for _, c := range cases {
+		g, err := l.Compile(c.pattern)
+		require.NoError(t, err, c.pattern)
+		assert.Equal(t, []Capture{{c.capture, true}}, g.Match(c.input), c.pattern)
+	}
+}
+
+func TestLoad(t *testing.T) {
+	l := NewLibrary()
+	err := l.Load(strings.NewReader(`
+# custom patterns
+STATUS (?:OK|FAIL)
+RESULT %{STATUS} after %{INT:attempts} attempts
+`))
+	require.NoError(t, err)
+	g, err := l.Compile("%{RESULT:result}")
+	require.NoError(t, err)
+	// Fields named within library patterns are not captured.
+	assert.Equal(t, []Field{{"result", ""}}, g.Fields)
+	assert.Equal(t, []Capture{{"FAIL after 3 attempts", true}}, g.Match("FAIL after 3 attempts"))
+
+	require.Error(t, l.Load(strings.NewReader("NOPATTERN")))
+}
+
+func TestErrors(t *testing.T) {
+	l := NewLibrary()
+	l.Add("LOOP", "a%{LOOP}")
+	for _, pattern := range []string{
+		"%{NOSUCH}",
+		"%{LOOP}",
+		"%{INT:a} %{INT:a}",
+		"%{INT:a.b}",
+		"%{INT:a:int64:x}",
+		"%{INT:a}(",
+	} {
+		_, err := l.Compile(pattern)
+		assert.Error(t, err, pattern)
+	}
+}
@@ -0,0 +1,77 @@
+package grok
+
+// defaultPatterns is the built-in pattern library in the format read by
+// Library.Load.  The patterns follow those distributed with Logstash,
+// rewritten where needed for the RE2 syntax of package regexp.
+const defaultPatterns = `
+USERNAME [a-zA-Z0-9._-]+
+USER %{USERNAME}
+EMAILLOCALPART [a-zA-Z][a-zA-Z0-9_.+=:-]+
+EMAILADDRESS %{EMAILLOCALPART}@%{HOSTNAME}
+INT [+-]?[0-9]+
+BASE10NUM [+-]?(?:[0-9]+(?:\.[0-9]*)?|\.[0-9]+)
+NUMBER %{BASE10NUM}
+BASE16NUM [+-]?(?:0x)?[0-9A-Fa-f]+
+POSINT \b[1-9][0-9]*\b
+NONNEGINT \b[0-9]+\b
+WORD \b\w+\b
+NOTSPACE \S+
+SPACE \s*
+DATA .*?
+GREEDYDATA .*
+QUOTEDSTRING "(?:[^"\\]|\\.)*"|'(?:[^'\\]|\\.)*'
+UUID [A-Fa-f0-9]{8}-(?:[A-Fa-f0-9]{4}-){3}[A-Fa-f0-9]{12}
+MAC (?:[A-Fa-f0-9]{2}[:-]){5}[A-Fa-f0-9]{2}|(?:[A-Fa-f0-9]{4}\.){2}[A-Fa-f0-9]{4}
+
+IPV4 (?:(?:25[0-5]|2[0-4][0-9]|1[0-9]{2}|[1-9]?[0-9])\.){3}(?:25[0-5]|2[0-4][0-9]|1[0-9]{2}|[1-9]?[0-9])
+IPV6 (?:[0-9A-Fa-f]{1,4}:){7}[0-9A-Fa-f]{1,4}|(?:[0-9A-Fa-f]{1,4}:){1,7}:|(?:[0-9A-Fa-f]{1,4}:){1,6}(?::[0-9A-Fa-f]{1,4}){1,6}|::(?:[0-9A-Fa-f]{1,4}:){0,6}[0-9A-Fa-f]{1,4}|::|(?:[0-9A-Fa-f]{1,4}:){1,6}:?%{IPV4}|::(?:[Ff]{4}:)?%{IPV4}
+IP (?:%{IPV6}|%{IPV4})
+HOSTNAME \b[0-9A-Za-z][0-9A-Za-z-]{0,62}(?:\.[0-9A-Za-z][0-9A-Za-z-]{0,62})*\.?\b
+HOST %{HOSTNAME}
+IPORHOST (?:%{IP}|%{HOSTNAME})
+HOSTPORT %{IPORHOST}:%{POSINT}
+
+PATH (?:%{UNIXPATH}|%{WINPATH})
+UNIXPATH (?:/[\w%!$@:.,+~-]*)+
+WINPATH (?:[A-Za-z]+:|\\)(?:\\[^\\?*]*)+
+URIPROTO [A-Za-z][A-Za-z0-9+.-]+
+URIHOST %{IPORHOST}(?::%{POSINT})?
+URIPATH (?:/[A-Za-z0-9$.+!*'(){},~:;=@#%&_-]*)+
+URIPARAM \?[A-Za-z0-9$.+!*'|(){},~@#%&/=:;_?<>\[\]-]*
+URIPATHPARAM %{URIPATH}(?:%{URIPARAM})?
+URI %{URIPROTO}://(?:%{USER}(?::[^@]*)?@)?(?:%{URIHOST})?(?:%{URIPATHPARAM})?
+
+MONTH \b(?:[Jj]an(?:uary)?|[Ff]eb(?:ruary)?|[Mm]ar(?:ch)?|[Aa]pr(?:il)?|[Mm]ay|[Jj]une?|[Jj]uly?|[Aa]ug(?:ust)?|[Ss]ep(?:tember)?|[Oo]ct(?:ober)?|[Nn]ov(?:ember)?|[Dd]ec(?:ember)?)\b
+MONTHNUM (?:0?[1-9]|1[0-2])
+MONTHDAY (?:0[1-9]|[12][0-9]|3[01]|[1-9])
+DAY (?:[Mm]on(?:day)?|[Tt]ue(?:sday)?|[Ww]ed(?:nesday)?|[Tt]hu(?:rsday)?|[Ff]ri(?:day)?|[Ss]at(?:urday)?|[Ss]un(?:day)?)
+YEAR (?:[0-9]{2}){1,2}
+HOUR (?:2[0123]|[01]?[0-9])
+MINUTE [0-5][0-9]
+SECOND (?:[0-5]?[0-9]|60)(?:[:.,][0-9]+)?
+TIME %{HOUR}:%{MINUTE}(?::%{SECOND})?
+ISO8601_TIMEZONE (?:Z|[+-]%{HOUR}(?::?%{MINUTE}))
+TIMESTAMP_ISO8601 %{YEAR}-%{MONTHNUM}-%{MONTHDAY}[T ]%{HOUR}:?%{MINUTE}(?::?%{SECOND})?%{ISO8601_TIMEZONE}?
+HTTPDATE %{MONTHDAY}/%{MONTH}/%{YEAR}:%{TIME} %{INT}
+SYSLOGTIMESTAMP %{MONTH} +%{MONTHDAY} %{TIME}
+
+PROG [\x21-\x5a\x5c\x5e-\x7e]+
+SYSLOGPROG %{PROG}(?:\[%{POSINT}\])?
+SYSLOGHOST %{IPORHOST}
+LOGLEVEL (?:[Aa]lert|ALERT|[Tt]race|TRACE|[Dd]ebug|DEBUG|[Nn]otice|NOTICE|[Ii]nfo|INFO|[Ww]arn?(?:ing)?|WARN?(?:ING)?|[Ee]rr?(?:or)?|ERR?(?:OR)?|[Cc]rit?(?:ical)?|CRIT?(?:ICAL)?|[Ff]atal|FATAL|[Ss]evere|SEVERE|EMERG(?:ENCY)?|[Ee]merg(?:ency)?)
+`
+
+// defaultTypes gives the type of the values captured by patterns whose
+// values are not strings.  The types are named as in zng.LookupPrimitive.
+var defaultTypes = map[string]string{
+	"INT":               "int64",
+	"POSINT":            "int64",
+	"NONNEGINT":         "int64",
+	"NUMBER":            "float64",
+	"BASE10NUM":         "float64",
+	"IP":                "ip",
+	"IPV4":              "ip",
+	"IPV6":              "ip",
+	"TIMESTAMP_ISO8601": "time",
+	"HTTPDATE":          "time",
+}
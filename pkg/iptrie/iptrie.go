@@ -0,0 +1,97 @@
+// Package iptrie provides a binary radix tree of IP subnets for finding the
+// most specific subnet containing an address.
+package iptrie
+
+import (
+	"net"
+)
+
+type node struct {
+	children [2]*node
+	value    interface{}
+	ok       bool
+}
+
+// Trie maps IPv4 and IPv6 subnets to values.  IPv4 subnets match only IPv4
+// addresses (including IPv4-mapped IPv6 addresses) and IPv6 subnets match
+// only IPv6 addresses.
+type Trie struct {
+	v4  node
+	v6  node
+	len int
+}
+
+func New() *Trie {
+	return &Trie{}
+}
+
+// normalize returns the bytes of ip and the root of the tree for its
+// address family.
+func (t *Trie) normalize(ip net.IP) (net.IP, *node) {
+	if ip4 := ip.To4(); ip4 != nil {
+		return ip4, &t.v4
+	}
+	if ip16 := ip.To16(); ip16 != nil {
+		return ip16, &t.v6
+	}
+	return nil, nil
+}
+
+func bit(ip net.IP, k int) int {
+	return int(ip[k/8]>>(7-uint(k%8))) & 1
+}
+
+// Insert adds subnet to the tree with the given value, replacing the value
+// of the subnet if it is already in the tree.
+func (t *Trie) Insert(subnet *net.IPNet, value interface{}) {
+	ip, n := t.normalize(subnet.IP)
+	if n == nil {
+		return
+	}
+	ones, bits := subnet.Mask.Size()
+	if bits == 8*net.IPv6len && len(ip) == net.IPv4len {
+		// An IPv4 subnet written as an IPv4-mapped IPv6 subnet.
+		ones -= 8 * (net.IPv6len - net.IPv4len)
+		if ones < 0 {
+			return
+		}
+	}
+	for k := 0; k < ones; k++ {
+		b := bit(ip, k)
+		if n.children[b] == nil {
+			n.children[b] = &node{}
+		}
+		n = n.children[b]
+	}
+	if !n.ok {
+		t.len++
+	}
+	n.value = value
+	n.ok = true
+}
+
+// Lookup returns the value of the most specific subnet containing ip and
+// true, or nil and false if no subnet contains ip.
+func (t *Trie) Lookup(ip net.IP) (interface{}, bool) {
+	ip, n := t.normalize(ip)
+	if n == nil {
+		return nil, false
+	}
+	var value interface{}
+	var found bool
+	for k := 0; n != nil; k++ {
+		if n.ok {
+			value, found = n.value, true
+		}
+		if k == 8*len(ip) {
+			break
+		}
+		n = n.children[bit(ip, k)]
+	}
+	return value, found
+}
+
+// Len returns the number of subnets in the tree.
+func (t *Trie) Len() int {
+	return t.len
+}
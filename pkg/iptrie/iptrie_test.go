@@ -0,0 +1,53 @@
+package iptrie
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func insert(t *testing.T, trie *Trie, cidr string) {
+	_, subnet, err := net.ParseCIDR(cidr)
+	require.NoError(t, err)
+	trie.Insert(subnet, cidr)
+}
+
+func TestTrie(t *testing.T) {
+	trie := New()
+	insert(t, trie, "10.0.0.0/8")
+	insert(t, trie, "10.1.0.0/16")
+	insert(t, trie, "10.1.2.3/32")
+	insert(t, trie, "2001:db8::/32")
+	insert(t, trie, "10.1.0.0/16")
+	assert.Equal(t, 4, trie.Len())
+
+	cases := []struct {
+		ip       string
+		expected interface{}
+	}{
+		{"10.2.3.4", "10.0.0.0/8"},
+		{"10.1.9.9", "10.1.0.0/16"},
+		{"10.1.2.3", "10.1.2.3/32"},
+		{"::ffff:10.1.2.3", "10.1.2.3/32"},
+		{"2001:db8::1", "2001:db8::/32"},
+		{"192.168.1.1", nil},
+		{"2001:db9::1", nil},
+	}
+	for _, c := range cases {
+		v, ok := trie.Lookup(net.ParseIP(c.ip))
+		assert.Equal(t, c.expected != nil, ok, c.ip)
+		assert.Equal(t, c.expected, v, c.ip)
+	}
+}
+
+func TestTrieDefaultRoute(t *testing.T) {
+	trie := New()
+	insert(t, trie, "0.0.0.0/0")
+	v, ok := trie.Lookup(net.ParseIP("192.168.1.1"))
+	assert.True(t, ok)
+	assert.Equal(t, "0.0.0.0/0", v)
+	_, ok = trie.Lookup(net.ParseIP("::1"))
+	assert.False(t, ok)
+}
@@ -81,3 +81,9 @@ func (r *Reader) Read(n int) ([]byte, error) {
 	r.cursor = r.cursor[n:]
 	return b, nil
 }
+
+// Buffered returns the number of bytes read from the underlying reader
+// that have not yet been consumed by Read.
+func (r *Reader) Buffered() int {
+	return len(r.cursor)
+}
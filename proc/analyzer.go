@@ -0,0 +1,205 @@
+package proc
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/brimsec/zq/ast"
+	"github.com/brimsec/zq/zbuf"
+)
+
+// An Analyzer collects statistics about each proc compiled with a Context
+// whose Analyzer field refers to it.  The statistics may be read once the
+// flowgraph has finished running.
+type Analyzer struct {
+	Procs []*ProcStats
+}
+
+// ProcStats holds the statistics of a proc compiled from Node.  ID is the
+// index of the ProcStats in Analyzer.Procs.
+type ProcStats struct {
+	ID         int
+	Node       ast.Proc
+	RecordsIn  int64
+	RecordsOut int64
+	BatchesOut int64
+	// Wall is the time spent in the proc's Pull, not including the time
+	// spent waiting for its parent.
+	Wall time.Duration
+	// PeakBuffered is the largest number of records the proc held at
+	// once or, for procs that hold something else, such as the rows of
+	// groupby or the keys of uniq -by, the largest number of those.
+	// It is zero for procs that don't report what they hold.
+	PeakBuffered int
+}
+
+// A buffering proc holds records between calls to Pull and reports how
+// many it holds.
+type buffering interface {
+	Buffered() int
+}
+
+// analyzed is a proc that wraps a compiled proc to count the records that
+// flow out of it and to time its Pull.  Its input wraps the proc's parent
+// to count the records that flow in.
+type analyzed struct {
+	proc   Proc
+	input  *analyzedInput
+	stats  *ProcStats
+	parent time.Duration
+}
+
+type analyzedInput struct {
+	parent Proc
+	a      *analyzed
+}
+
+func (a *Analyzer) newStats(node ast.Proc) *ProcStats {
+	stats := &ProcStats{ID: len(a.Procs), Node: node}
+	a.Procs = append(a.Procs, stats)
+	return stats
+}
+
+// Source wraps the proc that is the source of the flowgraph, such as a
+// scanner, so that its output is counted.  Node is the filter, if any,
+// applied by the source.
+func (a *Analyzer) Source(node ast.Proc, p Proc) Proc {
+	return &analyzed{proc: p, stats: a.newStats(node)}
+}
+
+func (a *Analyzer) compile(custom Compiler, node ast.Proc, c *Context, parent Proc) ([]Proc, error) {
+	w := &analyzed{stats: a.newStats(node)}
+	if parent != nil {
+		w.input = &analyzedInput{parent: parent, a: w}
+		parent = w.input
+	}
+	procs, err := compileProc(custom, node, c, parent)
+	if err != nil {
+		return nil, err
+	}
+	if len(procs) != 1 {
+		return nil, fmt.Errorf("analyzer: %T compiled to %d procs", node, len(procs))
+	}
+	w.proc = procs[0]
+	return []Proc{w}, nil
+}
+
+func (a *analyzed) sample() {
+	if b, ok := a.proc.(buffering); ok {
+		if n := b.Buffered(); n > a.stats.PeakBuffered {
+			a.stats.PeakBuffered = n
+		}
+	}
+}
+
+func (a *analyzed) Pull() (zbuf.Batch, error) {
+	start := time.Now()
+	a.parent = 0
+	batch, err := a.proc.Pull()
+	a.stats.Wall += time.Since(start) - a.parent
+	if batch != nil {
+		a.stats.BatchesOut++
+		a.stats.RecordsOut += int64(batch.Length())
+	}
+	a.sample()
+	return batch, err
+}
+
+func (a *analyzed) Done() {
+	a.proc.Done()
+}
+
+func (a *analyzed) Parents() []Proc {
+	return a.proc.Parents()
+}
+
+func (i *analyzedInput) Pull() (zbuf.Batch, error) {
+	// Sample before pulling since a proc like sort has released what it
+	// holds by the time its own Pull returns.
+	i.a.sample()
+	start := time.Now()
+	batch, err := i.parent.Pull()
+	i.a.parent += time.Since(start)
+	if batch != nil {
+		i.a.stats.RecordsIn += int64(batch.Length())
+	}
+	return batch, err
+}
+
+func (i *analyzedInput) Done() {
+	i.parent.Done()
+}
+
+func (i *analyzedInput) Parents() []Proc {
+	return []Proc{i.parent}
+}
+
+// describe returns the type of p and, if p is analyzed, its ID.
+func describe(p Proc) string {
+	if a, ok := p.(*analyzed); ok {
+		return fmt.Sprintf("%s #%d", describe(a.proc), a.stats.ID)
+	}
+	return strings.TrimPrefix(fmt.Sprintf("%T", p), "*")
+}
+
+// parents returns the parents of p, skipping the inputs of analyzed procs.
+func parents(p Proc) []Proc {
+	var out []Proc
+	for _, parent := range p.Parents() {
+		if i, ok := parent.(*analyzedInput); ok {
+			parent = i.parent
+		}
+		out = append(out, parent)
+	}
+	return out
+}
+
+// Flowgraph returns a description of the flowgraph whose outputs are
+// leaves.  Each proc is described by its type, followed by its ID in the
+// Analyzer if it was compiled with one, on a line indented below the
+// line of its parent.  A proc with more than one child is described in
+// full below its first child only.
+func Flowgraph(leaves []Proc) string {
+	children := make(map[Proc][]Proc)
+	seen := make(map[Proc]bool)
+	var roots []Proc
+	var visit func(Proc)
+	visit = func(p Proc) {
+		if seen[p] {
+			return
+		}
+		seen[p] = true
+		pp := parents(p)
+		if len(pp) == 0 {
+			roots = append(roots, p)
+		}
+		for _, parent := range pp {
+			visit(parent)
+			children[parent] = append(children[parent], p)
+		}
+	}
+	for _, leaf := range leaves {
+		visit(leaf)
+	}
+	var b strings.Builder
+	printed := make(map[Proc]bool)
+	var print func(Proc, int)
+	print = func(p Proc, depth int) {
+		b.WriteString(strings.Repeat("  ", depth))
+		b.WriteString(describe(p))
+		if printed[p] {
+			b.WriteString(" (see above)\n")
+			return
+		}
+		b.WriteString("\n")
+		printed[p] = true
+		for _, child := range children[p] {
+			print(child, depth+1)
+		}
+	}
+	for _, root := range roots {
+		print(root, 0)
+	}
+	return b.String()
+}
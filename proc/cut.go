@@ -12,10 +12,24 @@ import (
 
 type Cut struct {
 	Base
+	fields    []ast.FieldExpr
 	resolvers []expr.FieldExprResolver
 	builder   *ColumnBuilder
 	cutmap    map[int]*zng.TypeRecord
 	nblocked  int
+	partial   bool
+	partials  map[int]*partialCut
+}
+
+// A partialCut holds how a partial cut outputs the records of an input
+// type: with the fields at indexes, those of the cut's fields the type
+// has, in a record of type typ.  A nil typ means the type has none of
+// the fields and its records are output as is, since a record must have
+// at least one column.
+type partialCut struct {
+	indexes []int
+	builder *ColumnBuilder
+	typ     *zng.TypeRecord
 }
 
 // XXX update me
@@ -37,12 +51,55 @@ func CompileCutProc(c *Context, parent Proc, node *ast.CutProc) (*Cut, error) {
 	}
 	return &Cut{
 		Base:      Base{Context: c, Parent: parent},
+		fields:    node.Fields,
 		resolvers: resolvers,
 		builder:   builder,
 		cutmap:    make(map[int]*zng.TypeRecord),
+		partial:   node.Partial,
+		partials:  make(map[int]*partialCut),
 	}, nil
 }
 
+// cutPartial returns a new record value derived by keeping only those of
+// the fields that in has or, if it has none of them, in itself.
+func (c *Cut) cutPartial(in *zng.Record) (*zng.Record, error) {
+	pc, ok := c.partials[in.Type.ID()]
+	if !ok {
+		pc = &partialCut{}
+		var fields []ast.FieldExpr
+		var types []zng.Type
+		for k, resolver := range c.resolvers {
+			if val := resolver(in); val.Type != nil {
+				pc.indexes = append(pc.indexes, k)
+				fields = append(fields, c.fields[k])
+				types = append(types, val.Type)
+			}
+		}
+		if len(fields) > 0 {
+			builder, err := NewColumnBuilder(c.TypeContext, fields)
+			if err != nil {
+				return nil, err
+			}
+			pc.builder = builder
+			pc.typ = c.TypeContext.LookupTypeRecord(builder.TypedColumns(types))
+		}
+		c.partials[in.Type.ID()] = pc
+	}
+	if pc.typ == nil {
+		return in.Keep(), nil
+	}
+	pc.builder.Reset()
+	for _, k := range pc.indexes {
+		val := c.resolvers[k](in)
+		pc.builder.Append(val.Bytes, val.IsContainer())
+	}
+	zv, err := pc.builder.Encode()
+	if err != nil {
+		return nil, err
+	}
+	return zng.NewRecord(pc.typ, zv)
+}
+
 // cut returns a new record value derived by keeping only the fields
 // specified by name in the fields slice.  If the record can't be cut
 // (i.e., it doesn't have one of the specified fields), returns nil.
@@ -99,6 +156,9 @@ func (c *Cut) cut(in *zng.Record) *zng.Record {
 }
 
 func (c *Cut) warn() {
+	if c.partial {
+		return
+	}
 	if len(c.cutmap) > c.nblocked {
 		return
 	}
@@ -125,6 +185,15 @@ func (c *Cut) Pull() (zbuf.Batch, error) {
 		recs := make([]*zng.Record, 0, batch.Length())
 		for k := 0; k < batch.Length(); k++ {
 			in := batch.Index(k)
+			if c.partial {
+				out, err := c.cutPartial(in)
+				if err != nil {
+					batch.Unref()
+					return nil, fmt.Errorf("cut: %w", err)
+				}
+				recs = append(recs, out)
+				continue
+			}
 			out := c.cut(in)
 			if out != nil {
 				recs = append(recs, out)
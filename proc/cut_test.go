@@ -58,6 +58,23 @@ func TestCut(t *testing.T) {
 	proc.TestOneProc(t, fooAndBar, fooAndBar, "cut foo,bar")
 }
 
+func TestCutPartial(t *testing.T) {
+	// A partial cut keeps the fields each record has and outputs a
+	// record that has none of them as is.
+	const out = `
+#0:record[foo:string]
+0:[foo1;]
+0:[foo2;]
+0:[foo3;]
+#1:record[bar:string]
+1:[bar1;]
+1:[bar2;]
+1:[bar3;]
+`
+	proc.TestOneProc(t, fooAndBar+barOnly, out, "cut -partial foo")
+	proc.TestOneProc(t, fooAndBar, fooAndBar, "cut -partial foo,bar,baz")
+}
+
 func ctx() *proc.Context {
 	return &proc.Context{
 		TypeContext: resolver.NewContext(),
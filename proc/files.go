@@ -0,0 +1,46 @@
+package proc
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ErrFileNotAllowed is the error returned when a query names a file that
+// its FileRoot does not allow it to read.
+var ErrFileNotAllowed = errors.New("file not allowed")
+
+// A FileRoot restricts the files that a query names, such as the table of
+// a lookup proc, to those within a directory, as when a query comes from
+// a client of a server that should not read the server's other files.  A
+// FileRoot with no directory allows no files.  A nil FileRoot allows any
+// file.
+type FileRoot struct {
+	dir string
+}
+
+// NewFileRoot returns a FileRoot that allows the files within dir or no
+// files if dir is empty.
+func NewFileRoot(dir string) *FileRoot {
+	return &FileRoot{dir}
+}
+
+// Open opens the file that a query names.  If f is not nil, name must be
+// a relative path that does not contain "..", and it is resolved relative
+// to the directory of f.
+func (f *FileRoot) Open(name string) (*os.File, error) {
+	if f == nil {
+		return os.Open(name)
+	}
+	if f.dir == "" || filepath.IsAbs(name) {
+		return nil, fmt.Errorf("%s: %w", name, ErrFileNotAllowed)
+	}
+	for _, elem := range strings.Split(filepath.ToSlash(name), "/") {
+		if elem == ".." {
+			return nil, fmt.Errorf("%s: %w", name, ErrFileNotAllowed)
+		}
+	}
+	return os.Open(filepath.Join(f.dir, name))
+}
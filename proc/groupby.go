@@ -24,7 +24,7 @@ type GroupByKey struct {
 }
 
 type GroupByParams struct {
-	duration        ast.Duration
+	binner          timeBinner
 	update_interval ast.Duration
 	limit           int
 	keys            []GroupByKey
@@ -45,18 +45,36 @@ func IsErrTooBig(err error) bool {
 
 const defaultGroupByLimit = 1000000
 
-func CompileGroupBy(node *ast.GroupByProc, zctx *resolver.Context) (*GroupByParams, error) {
+// rowOverhead and reducerOverhead approximate the memory used by a
+// GroupByRow and its table entry and by each of its reducers apart from
+// the row's key values.
+const (
+	rowOverhead     = 160
+	reducerOverhead = 64
+)
+
+// CompileGroupByKeys compiles a list of field expressions into the
+// group-by keys used to partition records into groups.
+func CompileGroupByKeys(nodes []ast.FieldExpr) ([]GroupByKey, error) {
 	keys := make([]GroupByKey, 0)
-	for _, key := range node.Keys {
+	for _, key := range nodes {
 		resolver, err := expr.CompileFieldExpr(key)
 		if err != nil {
-			return nil, fmt.Errorf("compiling groupby: %w", err)
+			return nil, err
 		}
 		keys = append(keys, GroupByKey{
 			name:     GroupKey(key),
 			resolver: resolver,
 		})
 	}
+	return keys, nil
+}
+
+func CompileGroupBy(node *ast.GroupByProc, zctx *resolver.Context) (*GroupByParams, error) {
+	keys, err := CompileGroupByKeys(node.Keys)
+	if err != nil {
+		return nil, fmt.Errorf("compiling groupby: %w", err)
+	}
 	reducers := make([]compile.CompiledReducer, 0)
 	for _, reducer := range node.Reducers {
 		compiled, err := compile.Compile(reducer)
@@ -69,8 +87,12 @@ func CompileGroupBy(node *ast.GroupByProc, zctx *resolver.Context) (*GroupByPara
 	if err != nil {
 		return nil, fmt.Errorf("compiling groupby: %w", err)
 	}
+	binner, err := newTimeBinner(node.Duration, node.Window)
+	if err != nil {
+		return nil, fmt.Errorf("compiling groupby: %w", err)
+	}
 	return &GroupByParams{
-		duration:        node.Duration,
+		binner:          binner,
 		update_interval: node.UpdateInterval,
 		limit:           node.Limit,
 		keys:            keys,
@@ -99,33 +121,24 @@ type keyRow struct {
 // by time-binning are partially ordered by timestamp coincident with
 // search direction.
 type GroupByAggregator struct {
-	// keyCols maps incoming type ID of the record's type to a set of columns
-	// for that record type where each column represents a key.  If the
-	// inbound record doesn't have all of the group-by keys, then it is
-	// blocked by setting the map entry to nil.  If there are no group-by
-	// keys, then the map is set to an empty slice.
-	keyCols  map[int]keyRow
-	cacheKey []byte // Reduces memory allocations in Consume.
+	keyMaker *keyMaker
 	// zctx is the type context of the running search.
-	zctx *resolver.Context
-	// kctx is a scratch type context used to generate unique
-	// type IDs for prepending to the entires for the key-value
-	// lookup table so that values with the same encoding but of
-	// different types do not collide.  No types from this context
-	// are ever referenced.
-	kctx        *resolver.Context
+	zctx        *resolver.Context
 	keys        []GroupByKey
 	reducerDefs []compile.CompiledReducer
 	builder     *ColumnBuilder
 	// For a regular group-by, tables has one entry with key 0.  For a
 	// time-binned group-by, tables has one entry per bin and is keyed by
-	// bin timestamp (so that a bin with span [ts, ts+timeBinDuration) has
-	// key ts).
-	tables          map[nano.Ts]map[string]*GroupByRow
-	TimeBinDuration int64 // Zero means regular group-by (no time binning).
-	reverse         bool
-	logger          *zap.Logger
-	limit           int
+	// bin start time.
+	tables map[nano.Ts]map[string]*GroupByRow
+	// binner assigns records to time bins.  Nil means regular group-by
+	// (no time binning).
+	binner  timeBinner
+	binBuf  []nano.Ts // Reduces memory allocations in Consume.
+	reverse bool
+	logger  *zap.Logger
+	limit   int
+	memory  *Budget
 }
 
 type GroupByRow struct {
@@ -136,28 +149,22 @@ type GroupByRow struct {
 }
 
 func NewGroupByAggregator(c *Context, params GroupByParams) *GroupByAggregator {
-	//XXX we should change this AST format... left over from Looky
-	// convert second to nano second
-	dur := int64(params.duration.Seconds) * 1000000000
-	if dur < 0 {
-		panic("dur cannot be negative")
-	}
 	limit := params.limit
 	if limit == 0 {
 		limit = defaultGroupByLimit
 	}
 	return &GroupByAggregator{
-		keys:            params.keys,
-		zctx:            c.TypeContext,
-		kctx:            resolver.NewContext(),
-		reducerDefs:     params.reducers,
-		builder:         params.builder,
-		keyCols:         make(map[int]keyRow),
-		tables:          make(map[nano.Ts]map[string]*GroupByRow),
-		TimeBinDuration: dur,
-		reverse:         c.Reverse,
-		logger:          c.Logger,
-		limit:           limit,
+		keyMaker:    newKeyMaker(params.keys, params.builder),
+		keys:        params.keys,
+		zctx:        c.TypeContext,
+		reducerDefs: params.reducers,
+		builder:     params.builder,
+		tables:      make(map[nano.Ts]map[string]*GroupByRow),
+		binner:      params.binner,
+		reverse:     c.Reverse,
+		logger:      c.Logger,
+		limit:       limit,
+		memory:      c.Memory,
 	}
 }
 
@@ -165,8 +172,8 @@ func NewGroupBy(c *Context, parent Proc, params GroupByParams) *GroupBy {
 	// XXX in a subsequent PR we will isolate ast params and pass in
 	// ast.GroupByParams
 	agg := NewGroupByAggregator(c, params)
-	timeBinned := params.duration.Seconds > 0
-	interval := time.Duration(params.update_interval.Seconds) * time.Second
+	timeBinned := params.binner != nil
+	interval := time.Duration(params.update_interval.Nanoseconds)
 	return &GroupBy{
 		Base:       Base{Context: c, Parent: parent},
 		timeBinned: timeBinned,
@@ -175,6 +182,15 @@ func NewGroupBy(c *Context, parent Proc, params GroupByParams) *GroupBy {
 	}
 }
 
+// Buffered returns the number of rows being aggregated.
+func (g *GroupBy) Buffered() int {
+	var n int
+	for _, table := range g.agg.tables {
+		n += len(table)
+	}
+	return n
+}
+
 func (g *GroupBy) Pull() (zbuf.Batch, error) {
 	start := time.Now()
 	for {
@@ -239,24 +255,70 @@ func newKeyRow(kctx *resolver.Context, r *zng.Record, keys []GroupByKey) keyRow
 	return keyRow{id, cols}
 }
 
-// Consume takes a record and adds it to the aggregation. Records
-// successively passed to Consume are expected to have timestamps in
-// monotonically increasing or decreasing order determined by g.reverse.
-func (g *GroupByAggregator) Consume(r *zng.Record) error {
+// keyMaker computes the lookup key of a record for a list of group-by keys.
+// It is shared by the procs that partition records into groups (groupby,
+// uniq -by, head/tail by) so that they all agree on what makes two records
+// members of the same group.
+type keyMaker struct {
+	// keyCols maps incoming type ID of the record's type to a set of columns
+	// for that record type where each column represents a key.  If the
+	// inbound record doesn't have all of the group-by keys, then it is
+	// blocked by setting the map entry to nil.  If there are no group-by
+	// keys, then the map is set to an empty slice.
+	keyCols  map[int]keyRow
+	cacheKey []byte // Reduces memory allocations in lookup.
+	// kctx is a scratch type context used to generate unique
+	// type IDs for prepending to the entires for the key-value
+	// lookup table so that values with the same encoding but of
+	// different types do not collide.  No types from this context
+	// are ever referenced.
+	kctx    *resolver.Context
+	keys    []GroupByKey
+	builder *ColumnBuilder
+}
+
+func newKeyMaker(keys []GroupByKey, builder *ColumnBuilder) *keyMaker {
+	return &keyMaker{
+		keyCols: make(map[int]keyRow),
+		kctx:    resolver.NewContext(),
+		keys:    keys,
+		builder: builder,
+	}
+}
+
+// compileKeyMaker returns a keyMaker for the given list of key expressions.
+func compileKeyMaker(zctx *resolver.Context, nodes []ast.FieldExpr) (*keyMaker, error) {
+	keys, err := CompileGroupByKeys(nodes)
+	if err != nil {
+		return nil, err
+	}
+	builder, err := NewColumnBuilder(zctx, nodes)
+	if err != nil {
+		return nil, err
+	}
+	return newKeyMaker(keys, builder), nil
+}
+
+// lookup returns the key columns for the type of r along with the lookup
+// key of r.  The first four bytes of the lookup key hold a type ID that
+// distinguishes keys of different types and the remaining bytes hold the
+// encoded key values.  If r doesn't have all of the group-by keys, the
+// returned columns are nil.  The returned key is only valid until the
+// next call to lookup.
+func (k *keyMaker) lookup(r *zng.Record) (keyRow, zcode.Bytes) {
 	// First check if we've seen this descriptor before and if not
 	// build an entry for it.
 	id := r.Type.ID()
-	keyCols, ok := g.keyCols[id]
+	keyCols, ok := k.keyCols[id]
 	if !ok {
-		keyCols = newKeyRow(g.kctx, r, g.keys)
-		g.keyCols[id] = keyCols
+		keyCols = newKeyRow(k.kctx, r, k.keys)
+		k.keyCols[id] = keyCols
 	}
 	if keyCols.columns == nil {
 		// block this descriptor since it doesn't have all the group-by keys
-		return nil
+		return keyCols, nil
 	}
 
-	// See if we've encountered this row before.
 	// We compute a key for this row by exploiting the fact that
 	// a row key is uniquely determined by the inbound descriptor
 	// (implying the types of the keys) and the keys values.
@@ -269,28 +331,51 @@ func (g *GroupByAggregator) Consume(r *zng.Record) error {
 	// implying those types)
 
 	var keyBytes zcode.Bytes
-	if g.cacheKey != nil {
-		keyBytes = g.cacheKey[:4]
+	if k.cacheKey != nil {
+		keyBytes = k.cacheKey[:4]
 	} else {
 		keyBytes = make(zcode.Bytes, 4, 128)
 	}
 	binary.BigEndian.PutUint32(keyBytes, uint32(keyCols.id))
-	g.builder.Reset()
-	for _, key := range g.keys {
+	k.builder.Reset()
+	for _, key := range k.keys {
 		keyVal := key.resolver(r)
-		g.builder.Append(keyVal.Bytes, keyVal.IsContainer())
+		k.builder.Append(keyVal.Bytes, keyVal.IsContainer())
 	}
-	zv, err := g.builder.Encode()
+	zv, err := k.builder.Encode()
 	if err != nil {
 		// XXX internal error
 	}
 	keyBytes = append(keyBytes, zv...)
-	g.cacheKey = keyBytes
+	k.cacheKey = keyBytes
+	return keyCols, keyBytes
+}
 
-	var ts nano.Ts
-	if g.TimeBinDuration > 0 {
-		ts = r.Ts.Trunc(g.TimeBinDuration)
+// Consume takes a record and adds it to the aggregation. Records
+// successively passed to Consume are expected to have timestamps in
+// monotonically increasing or decreasing order determined by g.reverse.
+func (g *GroupByAggregator) Consume(r *zng.Record) error {
+	// See if we've encountered this row before.
+	keyCols, keyBytes := g.keyMaker.lookup(r)
+	if keyCols.columns == nil {
+		return nil
 	}
+
+	if g.binner == nil {
+		return g.consumeBin(r, 0, keyCols, keyBytes)
+	}
+	g.binBuf = g.binner.bins(g.binBuf[:0], r.Ts)
+	for _, ts := range g.binBuf {
+		if err := g.consumeBin(r, ts, keyCols, keyBytes); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// consumeBin adds a record to the aggregation for the time bin starting
+// at ts.
+func (g *GroupByAggregator) consumeBin(r *zng.Record, ts nano.Ts, keyCols keyRow, keyBytes zcode.Bytes) error {
 	table, ok := g.tables[ts]
 	if !ok {
 		table = make(map[string]*GroupByRow)
@@ -302,12 +387,19 @@ func (g *GroupByAggregator) Consume(r *zng.Record) error {
 			return errTooBig(g.limit)
 		}
 		row = g.createRow(keyCols, ts, keyBytes[4:])
+		if err := g.memory.Charge(g.rowSize(row)); err != nil {
+			return err
+		}
 		table[string(keyBytes)] = row
 	}
 	row.reducers.Consume(r)
 	return nil
 }
 
+func (g *GroupByAggregator) rowSize(row *GroupByRow) int {
+	return rowOverhead + len(row.keyvals) + reducerOverhead*len(g.reducerDefs)
+}
+
 // Results returns a batch of aggregation result records.
 // If this is a time-binned aggregation, this can be called multiple
 // times; all completed time bins at the time of the invocation are
@@ -327,17 +419,21 @@ func (g *GroupByAggregator) Results(eof bool, minTs nano.Ts, maxTs nano.Ts) zbuf
 	}
 	var recs []*zng.Record
 	for _, b := range bins {
-		if g.TimeBinDuration > 0 && !eof {
+		if g.binner != nil && !eof {
 			// We're not yet at EOF, so for a reverse search, we haven't
-			// seen all of g.minTs's bin and should skip it.
-			// Similarly, for a forward search, we haven't seen all
-			// of g.maxTs's bin and should skip it.
-			if g.reverse && b == minTs.Trunc(g.TimeBinDuration) ||
-				!g.reverse && b == maxTs.Trunc(g.TimeBinDuration) {
+			// seen all of the bins starting at or before minTs and
+			// should skip them.  Similarly, for a forward search, we
+			// haven't seen all of the bins ending after maxTs and should
+			// skip them.  With overlapping windows, there may be
+			// several such bins.
+			if g.reverse && b <= minTs || !g.reverse && g.binner.end(b) > maxTs {
 				continue
 			}
 		}
 		recs = append(recs, g.recordsForTable(g.tables[b])...)
+		for _, row := range g.tables[b] {
+			g.memory.Release(g.rowSize(row))
+		}
 		delete(g.tables, b)
 	}
 	if len(recs) == 0 {
@@ -348,7 +444,11 @@ func (g *GroupByAggregator) Results(eof bool, minTs nano.Ts, maxTs nano.Ts) zbuf
 	if g.reverse {
 		first, last = last, first
 	}
-	span := nano.NewSpanTs(first.Ts, last.Ts.Add(g.TimeBinDuration))
+	end := last.Ts
+	if g.binner != nil {
+		end = g.binner.end(last.Ts)
+	}
+	span := nano.NewSpanTs(first.Ts, end)
 	return zbuf.NewArray(recs, span)
 }
 
@@ -365,7 +465,7 @@ func (g *GroupByAggregator) recordsForTable(table map[string]*GroupByRow) []*zng
 	for _, k := range keys {
 		row := table[k]
 		var zv zcode.Bytes
-		if g.TimeBinDuration > 0 {
+		if g.binner != nil {
 			zv = zcode.AppendPrimitive(zv, zng.EncodeTime(row.ts))
 		}
 		zv = append(zv, row.keyvals...)
@@ -390,12 +490,12 @@ func (g *GroupByAggregator) lookupRowType(row *GroupByRow) *zng.TypeRecord {
 	// descriptor since it is rare for there to be multiple descriptors
 	// or for it change from row to row.
 	n := len(g.keys) + len(g.reducerDefs)
-	if g.TimeBinDuration > 0 {
+	if g.binner != nil {
 		n++
 	}
 	cols := make([]zng.Column, 0, n)
 
-	if g.TimeBinDuration > 0 {
+	if g.binner != nil {
 		cols = append(cols, zng.NewColumn("ts", zng.TypeTime))
 	}
 	types := make([]zng.Type, len(row.keycols.columns))
@@ -143,6 +143,62 @@ const aliasOut = `
 1:[127.0.0.1;1;]
 `
 
+const timeBinIn = `
+#0:record[ts:time,key:string]
+0:[0;a;]
+0:[1800;a;]
+0:[3600;a;]
+0:[5400;b;]
+`
+
+const tumblingOut = `
+#0:record[ts:time,count:uint64]
+0:[0;2;]
+0:[3600;2;]
+`
+
+const slidingOut = `
+#0:record[ts:time,key:string,count:uint64]
+0:[-1800;a;1;]
+0:[0;a;2;]
+0:[1800;a;2;]
+0:[3600;a;1;]
+0:[3600;b;1;]
+0:[5400;b;1;]
+`
+
+const subsecondIn = `
+#0:record[ts:time]
+0:[1.1;]
+0:[1.2;]
+0:[1.6;]
+`
+
+const subsecondOut = `
+#0:record[ts:time,count:uint64]
+0:[1;2;]
+0:[1.5;1;]
+`
+
+// The first record is at 2020-01-31T23:30 in New York, which is
+// 2020-02-01T04:30 in UTC.
+const calendarIn = `
+#0:record[ts:time]
+0:[1580531400;]
+0:[1581724800;]
+`
+
+const calendarUTCOut = `
+#0:record[ts:time,count:uint64]
+0:[1580515200;2;]
+`
+
+const calendarNewYorkOut = `
+#0:record[ts:time,count:uint64]
+0:[1577854800;1;]
+0:[1580533200;1;]
+`
+
 //XXX this should go in a shared package
 type suite []test.Internal
 
@@ -209,7 +265,13 @@ func tests() suite {
 	s.add(New("mixed-inputs", mixedIn, mixedOut, "first(f), last(f) by key"))
 
 	s.add(New("aliases", aliasIn, aliasOut, "count() by host"))
-	// XXX add coverage of time batching (every ..)
+
+	// Test time binning
+	s.add(New("every-tumbling", timeBinIn, tumblingOut, "every 1h count()"))
+	s.add(New("every-sliding", timeBinIn, slidingOut, "every 1h slide 30m count() by key"))
+	s.add(New("every-subsecond", subsecondIn, subsecondOut, "every 500ms count()"))
+	s.add(New("every-month", calendarIn, calendarUTCOut, "every 1month count()"))
+	s.add(New("every-month-tz", calendarIn, calendarNewYorkOut, `every 1month tz "America/New_York" count()`))
 
 	return s
 }
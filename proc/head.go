@@ -43,3 +43,47 @@ func (h *Head) Pull() (zbuf.Batch, error) {
 	h.Done()
 	return zbuf.NewArray(recs, nano.NewSpanTs(h.MinTs, h.MaxTs)), nil
 }
+
+// HeadBy forwards the first limit records of each group of records
+// sharing the same group-by key values.  Records that do not have all
+// of the keys are discarded.
+type HeadBy struct {
+	Base
+	limit    int
+	keyMaker *keyMaker
+	counts   map[string]int
+}
+
+func NewHeadBy(c *Context, parent Proc, limit int, keyMaker *keyMaker) *HeadBy {
+	return &HeadBy{
+		Base:     Base{Context: c, Parent: parent},
+		limit:    limit,
+		keyMaker: keyMaker,
+		counts:   make(map[string]int),
+	}
+}
+
+func (h *HeadBy) Pull() (zbuf.Batch, error) {
+	batch, err := h.Get()
+	if EOS(batch, err) {
+		return nil, err
+	}
+	defer batch.Unref()
+	out := make([]*zng.Record, 0, batch.Length())
+	for k := 0; k < batch.Length(); k++ {
+		r := batch.Index(k)
+		keyCols, keyBytes := h.keyMaker.lookup(r)
+		if keyCols.columns == nil {
+			continue
+		}
+		count, ok := h.counts[string(keyBytes)]
+		if !ok && len(h.counts) >= defaultGroupByLimit {
+			return nil, errTooBig(defaultGroupByLimit)
+		}
+		if count < h.limit {
+			out = append(out, r.Keep())
+			h.counts[string(keyBytes)] = count + 1
+		}
+	}
+	return zbuf.NewArray(out, batch.Span()), nil
+}
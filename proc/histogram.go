@@ -0,0 +1,430 @@
+package proc
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/brimsec/zq/ast"
+	"github.com/brimsec/zq/expr"
+	"github.com/brimsec/zq/pkg/nano"
+	"github.com/brimsec/zq/zbuf"
+	"github.com/brimsec/zq/zcode"
+	"github.com/brimsec/zq/zng"
+	"github.com/brimsec/zq/zngnative"
+)
+
+const (
+	defaultHistogramBins = 10
+	// histogramExactLimit is the number of values of a group that are
+	// buffered so that its bins of equal width are counted exactly.
+	histogramExactLimit = 100000
+	// histogramCellsPerBin is the number of cells per bin in which the
+	// values of a group with more values than histogramExactLimit are
+	// counted.
+	histogramCellsPerBin = 64
+	// histogramValueSize is the memory charged for each buffered value
+	// and each cell.
+	histogramValueSize = 8
+)
+
+// Histogram counts the values of a numeric field in buckets.  Values are
+// converted to float64 (with durations and times in seconds) and the
+// bucket bounds are transmitted with the type of the field if it is a
+// duration or time and as float64 otherwise.  Logarithmic and explicit
+// buckets are counted as values arrive.  Bins of equal width span the
+// range of values, which is known only at EOS, so the values of each
+// group are buffered until there are too many of them.  From then on,
+// they are counted in finer cells of equal width whose range doubles as
+// needed to cover each value, and the count of each cell goes to the bin
+// holding its midpoint.  A bin count is then off by at most the counts of
+// the two cells straddling its bounds.  The groups, buffered values, and
+// cells are charged to the Memory budget of the Context.
+type Histogram struct {
+	Base
+	field    expr.FieldExprResolver
+	bins     int
+	log      bool
+	bounds   []float64
+	keyMaker *keyMaker
+	groups   map[string]*histogramGroup
+	charged  int
+	nvals    int
+}
+
+type histogramGroup struct {
+	keyCols keyRow
+	keyvals zcode.Bytes
+	typ     zng.Type
+	// vals holds the values of the group for bins of equal width until
+	// there are more than histogramExactLimit of them.
+	vals []float64
+	// min and max are the least and greatest values of the group for
+	// bins of equal width.
+	min float64
+	max float64
+	// cells holds the count of each cell of width width starting at lo
+	// once the values of the group are no longer buffered.
+	cells []uint64
+	lo    float64
+	width float64
+	// counts holds the count of each bucket for explicit boundaries and,
+	// for logarithmic buckets, the count of each bucket indexed by the
+	// base-ten exponent of its lower bound offset by minExp.
+	counts []uint64
+	minExp int
+}
+
+func CompileHistogram(c *Context, parent Proc, node *ast.HistogramProc) (*Histogram, error) {
+	field, err := expr.CompileFieldExpr(node.Field)
+	if err != nil {
+		return nil, fmt.Errorf("compiling histogram: %w", err)
+	}
+	if len(node.Bounds) == 1 {
+		return nil, errors.New("compiling histogram: at least two bounds are required")
+	}
+	if !sort.Float64sAreSorted(node.Bounds) {
+		return nil, errors.New("compiling histogram: bounds must be in increasing order")
+	}
+	bins := node.Bins
+	if bins < 0 {
+		return nil, errors.New("compiling histogram: number of bins must be positive")
+	}
+	if bins == 0 {
+		bins = defaultHistogramBins
+	}
+	keyMaker, err := compileKeyMaker(c.TypeContext, node.Keys)
+	if err != nil {
+		return nil, fmt.Errorf("compiling histogram: %w", err)
+	}
+	return &Histogram{
+		Base:     Base{Context: c, Parent: parent},
+		field:    field,
+		bins:     bins,
+		log:      node.Log,
+		bounds:   node.Bounds,
+		keyMaker: keyMaker,
+		groups:   make(map[string]*histogramGroup),
+	}, nil
+}
+
+func (h *Histogram) consume(r *zng.Record) error {
+	zv := h.field(r)
+	if zv.Type == nil || zv.Bytes == nil {
+		return nil
+	}
+	v, ok := zngnative.CoerceToFloat64(zv)
+	if !ok {
+		return nil
+	}
+	keyCols, keyBytes := h.keyMaker.lookup(r)
+	if keyCols.columns == nil {
+		return nil
+	}
+	g, ok := h.groups[string(keyBytes)]
+	if !ok {
+		if len(h.groups) >= defaultGroupByLimit {
+			return errTooBig(defaultGroupByLimit)
+		}
+		// Make a deep copy since keyBytes is reused by the keyMaker.
+		keyvals := make(zcode.Bytes, len(keyBytes)-4)
+		copy(keyvals, keyBytes[4:])
+		if err := h.charge(rowOverhead + len(keyvals)); err != nil {
+			return err
+		}
+		g = &histogramGroup{
+			keyCols: keyCols,
+			keyvals: keyvals,
+			typ:     boundType(zv.Type),
+		}
+		if h.bounds != nil {
+			g.counts = make([]uint64, len(h.bounds)-1)
+		}
+		h.groups[string(keyBytes)] = g
+	}
+	switch {
+	case h.bounds != nil:
+		// Buckets are half-open so the bucket of v is the last one
+		// whose lower bound is less than or equal to v.
+		k := sort.Search(len(h.bounds), func(i int) bool { return h.bounds[i] > v }) - 1
+		if k >= 0 && k < len(g.counts) {
+			g.counts[k]++
+		}
+	case h.log:
+		if v > 0 {
+			g.addExp(log10Floor(v))
+		}
+	default:
+		// Infinite values have no place in bins of equal width.
+		if math.IsInf(v, 0) || math.IsNaN(v) {
+			return nil
+		}
+		if g.cells == nil && len(g.vals) == 0 {
+			g.min, g.max = v, v
+		}
+		g.min = math.Min(g.min, v)
+		g.max = math.Max(g.max, v)
+		if g.cells != nil {
+			g.addCell(v)
+			return nil
+		}
+		if len(g.vals) == histogramExactLimit {
+			return h.toCells(g, v)
+		}
+		if err := h.charge(histogramValueSize); err != nil {
+			return err
+		}
+		g.vals = append(g.vals, v)
+		h.nvals++
+	}
+	return nil
+}
+
+// toCells counts the buffered values of g and v in cells spanning their
+// range and releases the buffered values.
+func (h *Histogram) toCells(g *histogramGroup, v float64) error {
+	n := h.bins * histogramCellsPerBin
+	if err := h.charge(n * histogramValueSize); err != nil {
+		return err
+	}
+	g.cells = make([]uint64, n)
+	g.lo = g.min
+	// The greatest value falls within the last cell.
+	g.width = (g.max - g.min) / float64(n-1)
+	if g.width == 0 {
+		g.width = 1
+	}
+	for _, v := range g.vals {
+		g.addCell(v)
+	}
+	g.addCell(v)
+	h.release(len(g.vals) * histogramValueSize)
+	h.nvals -= len(g.vals)
+	g.vals = nil
+	return nil
+}
+
+// addCell increments the count of the cell holding v, doubling the range
+// of the cells until it holds v.
+func (g *histogramGroup) addCell(v float64) {
+	for v < g.lo || v >= g.lo+g.width*float64(len(g.cells)) {
+		g.grow(v < g.lo)
+	}
+	k := int((v - g.lo) / g.width)
+	if k >= len(g.cells) {
+		k = len(g.cells) - 1
+	}
+	g.cells[k]++
+}
+
+// grow doubles the width of the cells, merging each pair of adjacent
+// cells, and extends their range below lo if down is true and above it
+// otherwise.
+func (g *histogramGroup) grow(down bool) {
+	n := len(g.cells)
+	cells := make([]uint64, n)
+	var off int
+	if down {
+		off = n / 2
+		g.lo -= float64(n) * g.width
+	}
+	for k, count := range g.cells {
+		cells[off+k/2] += count
+	}
+	g.cells = cells
+	g.width *= 2
+}
+
+// Buffered returns the number of values buffered for bins of equal width.
+func (h *Histogram) Buffered() int {
+	return h.nvals
+}
+
+func (h *Histogram) charge(n int) error {
+	if err := h.Memory.Charge(n); err != nil {
+		return err
+	}
+	h.charged += n
+	return nil
+}
+
+func (h *Histogram) release(n int) {
+	h.Memory.Release(n)
+	h.charged -= n
+}
+
+// boundType returns the type of the bucket bounds for values of type typ.
+func boundType(typ zng.Type) zng.Type {
+	switch typ {
+	case zng.TypeDuration, zng.TypeTime:
+		return typ
+	}
+	return zng.TypeFloat64
+}
+
+func encodeBound(typ zng.Type, v float64) zcode.Bytes {
+	switch typ {
+	case zng.TypeDuration:
+		return zng.EncodeDuration(int64(math.Round(v * 1e9)))
+	case zng.TypeTime:
+		return zng.EncodeTime(nano.Ts(math.Round(v * 1e9)))
+	}
+	return zng.EncodeFloat64(v)
+}
+
+// log10Floor returns the largest integer e such that 10^e <= v, correcting
+// for the rounding error of math.Log10.
+func log10Floor(v float64) int {
+	e := int(math.Floor(math.Log10(v)))
+	if math.Pow10(e) > v {
+		e--
+	} else if math.Pow10(e+1) <= v {
+		e++
+	}
+	return e
+}
+
+// addExp increments the count of the logarithmic bucket with exponent e,
+// growing the range of buckets as needed.
+func (g *histogramGroup) addExp(e int) {
+	if len(g.counts) == 0 {
+		g.minExp = e
+	}
+	if e < g.minExp {
+		counts := make([]uint64, g.minExp-e+len(g.counts))
+		copy(counts[g.minExp-e:], g.counts)
+		g.counts = counts
+		g.minExp = e
+	}
+	for len(g.counts) <= e-g.minExp {
+		g.counts = append(g.counts, 0)
+	}
+	g.counts[e-g.minExp]++
+}
+
+type bucket struct {
+	lower float64
+	upper float64
+	count uint64
+}
+
+func (h *Histogram) buckets(g *histogramGroup) []bucket {
+	var out []bucket
+	switch {
+	case h.bounds != nil:
+		for k, count := range g.counts {
+			out = append(out, bucket{h.bounds[k], h.bounds[k+1], count})
+		}
+	case h.log:
+		for k, count := range g.counts {
+			e := g.minExp + k
+			out = append(out, bucket{math.Pow10(e), math.Pow10(e + 1), count})
+		}
+	default:
+		if len(g.vals) == 0 && g.cells == nil {
+			return nil
+		}
+		min, max := g.min, g.max
+		if min == max {
+			count := uint64(len(g.vals))
+			for _, c := range g.cells {
+				count += c
+			}
+			return []bucket{{min, max, count}}
+		}
+		width := (max - min) / float64(h.bins)
+		out = make([]bucket, h.bins)
+		for k := range out {
+			out[k].lower = min + float64(k)*width
+			out[k].upper = min + float64(k+1)*width
+		}
+		out[h.bins-1].upper = max
+		bin := func(v float64) int {
+			// The last bucket includes the maximum value.
+			k := int((v - min) / width)
+			if k < 0 {
+				return 0
+			}
+			if k >= h.bins {
+				return h.bins - 1
+			}
+			return k
+		}
+		for _, v := range g.vals {
+			out[bin(v)].count++
+		}
+		for k, count := range g.cells {
+			if count > 0 {
+				mid := g.lo + (float64(k)+0.5)*g.width
+				out[bin(mid)].count += count
+			}
+		}
+	}
+	return out
+}
+
+func (h *Histogram) records(g *histogramGroup) []*zng.Record {
+	types := make([]zng.Type, len(g.keyCols.columns))
+	for k, col := range g.keyCols.columns {
+		types[k] = col.Type
+	}
+	cols := h.keyMaker.builder.TypedColumns(types)
+	cols = append(cols,
+		zng.NewColumn("lower", g.typ),
+		zng.NewColumn("upper", g.typ),
+		zng.NewColumn("count", zng.TypeUint64))
+	typ := h.TypeContext.LookupTypeRecord(cols)
+	var recs []*zng.Record
+	for _, b := range h.buckets(g) {
+		zv := make(zcode.Bytes, len(g.keyvals))
+		copy(zv, g.keyvals)
+		zv = zcode.AppendPrimitive(zv, encodeBound(g.typ, b.lower))
+		zv = zcode.AppendPrimitive(zv, encodeBound(g.typ, b.upper))
+		zv = zcode.AppendPrimitive(zv, zng.EncodeUint(b.count))
+		recs = append(recs, zng.NewRecordTs(typ, 0, zv))
+	}
+	return recs
+}
+
+func (h *Histogram) histogram() zbuf.Batch {
+	if len(h.groups) == 0 {
+		return nil
+	}
+	keys := make([]string, 0, len(h.groups))
+	for k := range h.groups {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var recs []*zng.Record
+	for _, k := range keys {
+		recs = append(recs, h.records(h.groups[k])...)
+	}
+	h.groups = make(map[string]*histogramGroup)
+	h.Memory.Release(h.charged)
+	h.charged = 0
+	h.nvals = 0
+	if len(recs) == 0 {
+		return nil
+	}
+	return zbuf.NewArray(recs, nano.NewSpanTs(h.MinTs, h.MaxTs))
+}
+
+func (h *Histogram) Pull() (zbuf.Batch, error) {
+	for {
+		batch, err := h.Get()
+		if err != nil {
+			return nil, err
+		}
+		if batch == nil {
+			return h.histogram(), nil
+		}
+		for k := 0; k < batch.Length(); k++ {
+			if err := h.consume(batch.Index(k)); err != nil {
+				batch.Unref()
+				return nil, err
+			}
+		}
+		batch.Unref()
+	}
+}
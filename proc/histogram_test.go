@@ -0,0 +1,115 @@
+package proc_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/brimsec/zq/pkg/nano"
+	"github.com/brimsec/zq/proc"
+	"github.com/brimsec/zq/zbuf"
+	"github.com/brimsec/zq/zio/zngio"
+	"github.com/brimsec/zq/zng"
+	"github.com/brimsec/zq/zng/resolver"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const histogramIn = `
+#0:record[path:string,bytes:int64,dur:duration]
+0:[a;1;0.002;]
+0:[a;5;0.5;]
+0:[b;10;1.5;]
+0:[a;100;12;]
+0:[b;0;-;]
+`
+
+func TestHistogramLinear(t *testing.T) {
+	const out = `
+#0:record[lower:float64,upper:float64,count:uint64]
+0:[0;25;4;]
+0:[25;50;0;]
+0:[50;75;0;]
+0:[75;100;1;]
+`
+	proc.TestOneProc(t, histogramIn, out, "histogram bytes bins 4")
+
+	// Duration bounds are durations and unset values are ignored.
+	const outDur = `
+#0:record[lower:duration,upper:duration,count:uint64]
+0:[0.002;6.001;3;]
+0:[6.001;12;1;]
+`
+	proc.TestOneProc(t, histogramIn, outDur, "histogram dur bins 2")
+}
+
+func TestHistogramLog(t *testing.T) {
+	const out = `
+#0:record[lower:duration,upper:duration,count:uint64]
+0:[0.001;0.01;1;]
+0:[0.01;0.1;0;]
+0:[0.1;1;1;]
+0:[1;10;1;]
+0:[10;100;1;]
+`
+	proc.TestOneProc(t, histogramIn, out, "histogram dur log")
+}
+
+func TestHistogramBounds(t *testing.T) {
+	const out = `
+#0:record[path:string,lower:float64,upper:float64,count:uint64]
+0:[a;0;10;2;]
+0:[a;10;100.5;1;]
+0:[b;0;10;1;]
+0:[b;10;100.5;1;]
+`
+	proc.TestOneProc(t, histogramIn, out, "histogram bytes bounds 0,10,100.5 by path")
+}
+
+func TestHistogramLarge(t *testing.T) {
+	// Too many values to buffer, first rising from 0 and then falling
+	// from -1, so that the cells grow in both directions.
+	const n = 300000
+	var src strings.Builder
+	src.WriteString("#0:record[x:int64]\n")
+	for k := 0; k < n/2; k++ {
+		fmt.Fprintf(&src, "0:[%d;]\n", k)
+	}
+	for k := 1; k <= n/2; k++ {
+		fmt.Fprintf(&src, "0:[%d;]\n", -k)
+	}
+	zctx := resolver.NewContext()
+	r := zngio.NewReader(strings.NewReader(src.String()), zctx)
+	var recs []*zng.Record
+	for {
+		rec, err := r.Read()
+		require.NoError(t, err)
+		if rec == nil {
+			break
+		}
+		recs = append(recs, rec)
+	}
+	// The values would need more memory than this to be buffered.
+	ctx := proc.NewTestContext(zctx)
+	ctx.Memory = proc.NewBudget("query", 1<<20, nil)
+	source := proc.NewTestSource([]zbuf.Batch{zbuf.NewArray(recs, nano.MaxSpan)})
+	p, err := proc.CompileTestProc("histogram x bins 20", ctx, source)
+	require.NoError(t, err)
+	batch, err := p.Pull()
+	require.NoError(t, err)
+	require.Equal(t, 20, batch.Length())
+	var total uint64
+	for k := 0; k < batch.Length(); k++ {
+		rec := batch.Index(k)
+		v, err := rec.Access("lower")
+		require.NoError(t, err)
+		lower, err := zng.DecodeFloat64(v.Bytes)
+		require.NoError(t, err)
+		assert.InDelta(t, -n/2+float64(k)*(n-1)/20, lower, 1e-6)
+		count, err := rec.AccessInt("count")
+		require.NoError(t, err)
+		assert.InDelta(t, n/20, count, n/20/100, "bin %d", k)
+		total += uint64(count)
+	}
+	assert.EqualValues(t, n, total)
+}
@@ -0,0 +1,240 @@
+package proc
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"net"
+	"path/filepath"
+	"strings"
+
+	"github.com/brimsec/zq/ast"
+	"github.com/brimsec/zq/filter"
+	"github.com/brimsec/zq/pkg/iptrie"
+	"github.com/brimsec/zq/zbuf"
+	"github.com/brimsec/zq/zcode"
+	"github.com/brimsec/zq/zng"
+)
+
+// An indicator is an indicator of compromise along with the name of the
+// file it was loaded from.
+type indicator struct {
+	value  string
+	source string
+}
+
+// An intelSet holds indicators of compromise indexed for matching: IP
+// addresses and subnets in a radix tree, hashes in a hash set, and domains
+// in a hash set that is probed with each parent domain of a value.
+type intelSet struct {
+	nets    *iptrie.Trie
+	hashes  map[string]*indicator
+	domains map[string]*indicator
+}
+
+func newIntelSet() *intelSet {
+	return &intelSet{
+		nets:    iptrie.New(),
+		hashes:  make(map[string]*indicator),
+		domains: make(map[string]*indicator),
+	}
+}
+
+func isHash(s string) bool {
+	switch len(s) {
+	case 32, 40, 64:
+		// MD5, SHA1, and SHA256
+	default:
+		return false
+	}
+	for _, c := range s {
+		if !(c >= '0' && c <= '9' || c >= 'a' && c <= 'f') {
+			return false
+		}
+	}
+	return true
+}
+
+// add adds an indicator, which is an IP address, a subnet in CIDR
+// notation, a hash in hexadecimal, or a domain.  A domain, which may be
+// written with a leading "*." or ".", matches itself and its subdomains.
+func (s *intelSet) add(value, source string) {
+	ind := &indicator{value, source}
+	if ip := net.ParseIP(value); ip != nil {
+		bits := 8 * net.IPv6len
+		if ip.To4() != nil {
+			bits = 8 * net.IPv4len
+		}
+		s.nets.Insert(&net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}, ind)
+		return
+	}
+	if _, subnet, err := net.ParseCIDR(value); err == nil {
+		s.nets.Insert(subnet, ind)
+		return
+	}
+	v := strings.ToLower(value)
+	if isHash(v) {
+		s.hashes[v] = ind
+		return
+	}
+	v = strings.TrimPrefix(v, "*")
+	v = strings.Trim(v, ".")
+	if v != "" {
+		s.domains[v] = ind
+	}
+}
+
+// load adds the indicators in the file at path, which lists one indicator
+// at the start of each line.  Blank lines and lines beginning with "#" are
+// ignored as is any text following an indicator.  The file is opened by
+// files.
+func (s *intelSet) load(files *FileRoot, path string) error {
+	f, err := files.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	source := filepath.Base(path)
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || line[0] == '#' {
+			continue
+		}
+		fields := strings.FieldsFunc(line, func(r rune) bool {
+			return r == ',' || r == ' ' || r == '\t'
+		})
+		s.add(fields[0], source)
+	}
+	return scanner.Err()
+}
+
+func (s *intelSet) matchIP(ip net.IP) *indicator {
+	if v, ok := s.nets.Lookup(ip); ok {
+		return v.(*indicator)
+	}
+	return nil
+}
+
+func (s *intelSet) matchString(str string) *indicator {
+	str = strings.ToLower(str)
+	if ind, ok := s.hashes[str]; ok {
+		return ind
+	}
+	if s.nets.Len() > 0 {
+		if ip := net.ParseIP(str); ip != nil {
+			return s.matchIP(ip)
+		}
+	}
+	if len(s.domains) == 0 {
+		return nil
+	}
+	str = strings.TrimSuffix(str, ".")
+	for {
+		if ind, ok := s.domains[str]; ok {
+			return ind
+		}
+		k := strings.IndexByte(str, '.')
+		if k < 0 {
+			return nil
+		}
+		str = str[k+1:]
+	}
+}
+
+// match returns the indicator matching the value or nil if none matches.
+func (s *intelSet) match(v zng.Value) *indicator {
+	if v.Bytes == nil {
+		return nil
+	}
+	switch v.Type {
+	case zng.TypeIP:
+		ip, err := zng.DecodeIP(v.Bytes)
+		if err != nil {
+			return nil
+		}
+		return s.matchIP(ip)
+	case zng.TypeString, zng.TypeBstring:
+		return s.matchString(string(v.Bytes))
+	}
+	return nil
+}
+
+// Intel matches the values of each record against lists of indicators of
+// compromise.  Every field is examined, including the fields of nested
+// records, and an intel field is appended to each record reporting whether
+// any value matched and, for the first matching value, which indicator
+// it matched and the file that listed the indicator.
+type Intel struct {
+	Base
+	set     *intelSet
+	filter  filter.Filter
+	match   *indicator
+	cols    []zng.Column
+	unset   zng.Value
+	typ     *zng.TypeRecord
+	builder zcode.Bytes
+}
+
+func CompileIntel(c *Context, parent Proc, node *ast.IntelProc) (*Intel, error) {
+	if len(node.Files) == 0 {
+		return nil, errors.New("compiling intel: no indicator files")
+	}
+	set := newIntelSet()
+	for _, path := range node.Files {
+		if err := set.load(c.Files, path); err != nil {
+			return nil, fmt.Errorf("compiling intel: %w", err)
+		}
+	}
+	typ := c.TypeContext.LookupTypeRecord([]zng.Column{
+		zng.NewColumn("matched", zng.TypeBool),
+		zng.NewColumn("indicator", zng.TypeString),
+		zng.NewColumn("source", zng.TypeString),
+	})
+	i := &Intel{
+		Base: Base{Context: c, Parent: parent},
+		set:  set,
+		cols: []zng.Column{zng.NewColumn("intel", typ)},
+		typ:  typ,
+	}
+	var zv zcode.Bytes
+	zv = zcode.AppendPrimitive(zv, zng.EncodeBool(false))
+	zv = zcode.AppendPrimitive(zv, nil)
+	zv = zcode.AppendPrimitive(zv, nil)
+	i.unset = zng.Value{Type: typ, Bytes: zv}
+	i.filter = filter.EvalAny(func(v zng.Value) bool {
+		i.match = set.match(v)
+		return i.match != nil
+	}, true)
+	return i, nil
+}
+
+func (i *Intel) tag(r *zng.Record) (*zng.Record, error) {
+	val := i.unset
+	if i.filter(r) {
+		zv := i.builder[:0]
+		zv = zcode.AppendPrimitive(zv, zng.EncodeBool(true))
+		zv = zcode.AppendPrimitive(zv, zng.EncodeString(i.match.value))
+		zv = zcode.AppendPrimitive(zv, zng.EncodeString(i.match.source))
+		i.builder = zv
+		val = zng.Value{Type: i.typ, Bytes: zv}
+	}
+	return i.TypeContext.AddColumns(r, i.cols, []zng.Value{val})
+}
+
+func (i *Intel) Pull() (zbuf.Batch, error) {
+	batch, err := i.Get()
+	if EOS(batch, err) {
+		return nil, err
+	}
+	defer batch.Unref()
+	out := make([]*zng.Record, 0, batch.Length())
+	for k := 0; k < batch.Length(); k++ {
+		r, err := i.tag(batch.Index(k))
+		if err != nil {
+			return nil, fmt.Errorf("intel: %w", err)
+		}
+		out = append(out, r)
+	}
+	return zbuf.NewArray(out, batch.Span()), nil
+}
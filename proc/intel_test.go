@@ -0,0 +1,46 @@
+package proc_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/brimsec/zq/proc"
+)
+
+func TestIntel(t *testing.T) {
+	dir, ips := writeTempFile(t, "ips.txt", `
+# bad hosts
+10.1.0.0/16
+10.1.2.3 c2 server
+2001:db8::1
+`)
+	defer os.RemoveAll(dir)
+	dir, names := writeTempFile(t, "names.txt", `
+evil.com
+*.bad.org
+d41d8cd98f00b204e9800998ecf8427e
+`)
+	defer os.RemoveAll(dir)
+	const in = `
+#0:record[id:record[orig_h:ip,resp_h:ip],query:string]
+0:[[192.168.1.1;10.1.2.3;]x.example.com;]
+0:[[192.168.1.1;10.1.9.9;]-;]
+0:[[2001:db8::1;192.168.1.2;]WWW.Evil.COM;]
+0:[[192.168.1.1;192.168.1.2;]notevil.com;]
+0:[[192.168.1.1;192.168.1.2;]a.b.bad.org;]
+0:[[192.168.1.1;192.168.1.2;]D41D8CD98F00B204E9800998ECF8427E;]
+0:[[192.168.1.1;192.168.1.2;]10.1.0.1;]
+`
+	const out = `
+#0:record[id:record[orig_h:ip,resp_h:ip],query:string,intel:record[matched:bool,indicator:string,source:string]]
+0:[[192.168.1.1;10.1.2.3;]x.example.com;[T;10.1.2.3;ips.txt;]]
+0:[[192.168.1.1;10.1.9.9;]-;[T;10.1.0.0/16;ips.txt;]]
+0:[[2001:db8::1;192.168.1.2;]WWW.Evil.COM;[T;2001:db8::1;ips.txt;]]
+0:[[192.168.1.1;192.168.1.2;]notevil.com;[F;-;-;]]
+0:[[192.168.1.1;192.168.1.2;]a.b.bad.org;[T;*.bad.org;names.txt;]]
+0:[[192.168.1.1;192.168.1.2;]D41D8CD98F00B204E9800998ECF8427E;[T;d41d8cd98f00b204e9800998ecf8427e;names.txt;]]
+0:[[192.168.1.1;192.168.1.2;]10.1.0.1;[T;10.1.0.0/16;ips.txt;]]
+`
+	proc.TestOneProc(t, in, out, fmt.Sprintf("intel file=%q, %q", ips, names))
+}
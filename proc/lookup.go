@@ -0,0 +1,306 @@
+package proc
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"path/filepath"
+	"strings"
+
+	"github.com/brimsec/zq/ast"
+	"github.com/brimsec/zq/expr"
+	"github.com/brimsec/zq/pkg/iptrie"
+	"github.com/brimsec/zq/zbuf"
+	"github.com/brimsec/zq/zcode"
+	"github.com/brimsec/zq/zio/detector"
+	"github.com/brimsec/zq/zng"
+	"github.com/brimsec/zq/zng/resolver"
+)
+
+// A lookupTable maps key values to the values of the columns appended by
+// a Lookup proc.  Keys are compared by their text representation so that
+// a key from a CSV file, whose values are all strings, matches a record
+// key of any type.
+type lookupTable struct {
+	cols []zng.Column
+	rows map[string][]zng.Value
+	// nets holds the rows whose keys are subnets in a radix tree so that
+	// an address finds the row of the most specific subnet containing it.
+	// subnets holds the subnets in nets.
+	nets    *iptrie.Trie
+	subnets map[string]bool
+}
+
+func lookupKey(v zng.Value) string {
+	return v.Type.StringOf(v.Bytes, zng.OutFormatUnescaped, false)
+}
+
+func newLookupTable(cols []zng.Column) *lookupTable {
+	return &lookupTable{
+		cols:    cols,
+		rows:    make(map[string][]zng.Value),
+		nets:    iptrie.New(),
+		subnets: make(map[string]bool),
+	}
+}
+
+// add adds a row to the table.  If the key is a subnet, either of type net
+// or a string in CIDR notation, the row matches keys in the subnet.  The
+// first row for a key takes precedence.
+func (t *lookupTable) add(key zng.Value, vals []zng.Value) error {
+	var subnet *net.IPNet
+	switch key.Type {
+	case zng.TypeNet:
+		var err error
+		subnet, err = zng.DecodeNet(key.Bytes)
+		if err != nil {
+			return err
+		}
+	case zng.TypeString, zng.TypeBstring:
+		if s := string(key.Bytes); strings.Contains(s, "/") {
+			_, subnet, _ = net.ParseCIDR(s)
+		}
+	}
+	if subnet != nil {
+		if k := subnet.String(); !t.subnets[k] {
+			t.subnets[k] = true
+			t.nets.Insert(subnet, vals)
+		}
+		return nil
+	}
+	k := lookupKey(key)
+	if _, ok := t.rows[k]; !ok {
+		t.rows[k] = vals
+	}
+	return nil
+}
+
+// lookup returns the values of the row matching key or nil if no row
+// matches.
+func (t *lookupTable) lookup(key zng.Value) []zng.Value {
+	if vals, ok := t.rows[lookupKey(key)]; ok {
+		return vals
+	}
+	if t.nets.Len() == 0 || key.Type != zng.TypeIP {
+		return nil
+	}
+	ip, err := zng.DecodeIP(key.Bytes)
+	if err != nil {
+		return nil
+	}
+	if vals, ok := t.nets.Lookup(ip); ok {
+		return vals.([]zng.Value)
+	}
+	return nil
+}
+
+// loadLookupTable reads the table in path, which is a CSV file with a
+// header line if its name ends in ".csv" and otherwise any format
+// recognized by detector.NewReader.  The file is opened by files.
+func loadLookupTable(zctx *resolver.Context, files *FileRoot, path, key string, fields []string) (*lookupTable, error) {
+	f, err := files.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	var t *lookupTable
+	if strings.EqualFold(filepath.Ext(path), ".csv") {
+		t, err = readCSVTable(f, key, fields)
+	} else {
+		var reader zbuf.Reader
+		reader, err = detector.NewReader(detector.GzipReader(f), zctx)
+		if err == nil {
+			t, err = readZngTable(reader, key, fields)
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return t, nil
+}
+
+func readCSVTable(r io.Reader, key string, fields []string) (*lookupTable, error) {
+	reader := csv.NewReader(r)
+	header, err := reader.Read()
+	if err != nil {
+		return nil, err
+	}
+	index := make(map[string]int)
+	for k, name := range header {
+		index[name] = k
+	}
+	keyIndex, ok := index[key]
+	if !ok {
+		return nil, fmt.Errorf("no such column: %s", key)
+	}
+	if len(fields) == 0 {
+		for _, name := range header {
+			if name != key {
+				fields = append(fields, name)
+			}
+		}
+	}
+	cols := make([]zng.Column, len(fields))
+	positions := make([]int, len(fields))
+	for k, name := range fields {
+		pos, ok := index[name]
+		if !ok {
+			return nil, fmt.Errorf("no such column: %s", name)
+		}
+		cols[k] = zng.NewColumn(name, zng.TypeString)
+		positions[k] = pos
+	}
+	t := newLookupTable(cols)
+	for {
+		row, err := reader.Read()
+		if err == io.EOF {
+			return t, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		vals := make([]zng.Value, len(positions))
+		for k, pos := range positions {
+			vals[k] = zng.Value{Type: zng.TypeString, Bytes: zng.EncodeString(row[pos])}
+		}
+		keyVal := zng.Value{Type: zng.TypeString, Bytes: zng.EncodeString(row[keyIndex])}
+		if err := t.add(keyVal, vals); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func readZngTable(reader zbuf.Reader, key string, fields []string) (*lookupTable, error) {
+	type row struct {
+		key  zng.Value
+		vals map[string]zng.Value
+	}
+	var rows []row
+	types := make(map[string]zng.Type)
+	var names []string
+	for {
+		rec, err := reader.Read()
+		if err != nil {
+			return nil, err
+		}
+		if rec == nil {
+			break
+		}
+		keyVal, err := rec.ValueByField(key)
+		if err != nil || keyVal.Bytes == nil {
+			continue
+		}
+		// Copy the values since the reader may reuse the record.
+		r := row{key: copyValue(keyVal), vals: make(map[string]zng.Value)}
+		for k, col := range rec.Type.Columns {
+			if col.Name == key {
+				continue
+			}
+			if typ, ok := types[col.Name]; !ok {
+				types[col.Name] = col.Type
+				names = append(names, col.Name)
+			} else if typ != col.Type {
+				return nil, fmt.Errorf("column %s has inconsistent types", col.Name)
+			}
+			r.vals[col.Name] = copyValue(rec.Value(k))
+		}
+		rows = append(rows, r)
+	}
+	if len(rows) == 0 {
+		return nil, errors.New("table has no rows with key column " + key)
+	}
+	if len(fields) == 0 {
+		fields = names
+	}
+	cols := make([]zng.Column, len(fields))
+	for k, name := range fields {
+		typ, ok := types[name]
+		if !ok {
+			return nil, fmt.Errorf("no such column: %s", name)
+		}
+		cols[k] = zng.NewColumn(name, typ)
+	}
+	t := newLookupTable(cols)
+	for _, r := range rows {
+		vals := make([]zng.Value, len(cols))
+		for k, col := range cols {
+			if v, ok := r.vals[col.Name]; ok {
+				vals[k] = v
+			} else {
+				vals[k] = zng.Value{Type: col.Type}
+			}
+		}
+		if err := t.add(r.key, vals); err != nil {
+			return nil, err
+		}
+	}
+	return t, nil
+}
+
+func copyValue(v zng.Value) zng.Value {
+	if v.Bytes == nil {
+		return v
+	}
+	b := make(zcode.Bytes, len(v.Bytes))
+	copy(b, v.Bytes)
+	return zng.Value{Type: v.Type, Bytes: b}
+}
+
+// Lookup enriches each record with the columns of the matching row of
+// a lookup table.
+type Lookup struct {
+	Base
+	key   expr.FieldExprResolver
+	table *lookupTable
+	unset []zng.Value
+}
+
+func CompileLookup(c *Context, parent Proc, node *ast.LookupProc) (*Lookup, error) {
+	key, err := expr.CompileFieldExpr(node.Key)
+	if err != nil {
+		return nil, fmt.Errorf("compiling lookup: %w", err)
+	}
+	table, err := loadLookupTable(c.TypeContext, c.Files, node.File, node.TableKey, node.Fields)
+	if err != nil {
+		return nil, fmt.Errorf("compiling lookup: %w", err)
+	}
+	unset := make([]zng.Value, len(table.cols))
+	for k, col := range table.cols {
+		unset[k] = zng.Value{Type: col.Type}
+	}
+	return &Lookup{
+		Base:  Base{Context: c, Parent: parent},
+		key:   key,
+		table: table,
+		unset: unset,
+	}, nil
+}
+
+func (l *Lookup) enrich(r *zng.Record) (*zng.Record, error) {
+	vals := l.unset
+	if key := l.key(r); key.Type != nil && key.Bytes != nil {
+		if v := l.table.lookup(key); v != nil {
+			vals = v
+		}
+	}
+	return l.TypeContext.AddColumns(r, l.table.cols, vals)
+}
+
+func (l *Lookup) Pull() (zbuf.Batch, error) {
+	batch, err := l.Get()
+	if EOS(batch, err) {
+		return nil, err
+	}
+	defer batch.Unref()
+	out := make([]*zng.Record, 0, batch.Length())
+	for k := 0; k < batch.Length(); k++ {
+		r, err := l.enrich(batch.Index(k))
+		if err != nil {
+			return nil, fmt.Errorf("lookup: %w", err)
+		}
+		out = append(out, r)
+	}
+	return zbuf.NewArray(out, batch.Span()), nil
+}
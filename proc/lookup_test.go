@@ -0,0 +1,80 @@
+package proc_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/brimsec/zq/proc"
+	"github.com/stretchr/testify/require"
+)
+
+const lookupIn = `
+#0:record[ts:time,src:ip]
+0:[1;10.0.0.1;]
+0:[2;10.1.2.3;]
+0:[3;10.1.1.9;]
+0:[4;192.168.1.1;]
+0:[5;-;]
+`
+
+// writeTempFile writes a file, such as a lookup table or an intel list,
+// to a new temporary directory and returns the directory and the path of
+// the file.
+func writeTempFile(t *testing.T, name, contents string) (string, string) {
+	dir, err := ioutil.TempDir("", "proc")
+	require.NoError(t, err)
+	path := filepath.Join(dir, name)
+	require.NoError(t, ioutil.WriteFile(path, []byte(contents), 0644))
+	return dir, path
+}
+
+func TestLookupCSV(t *testing.T) {
+	dir, path := writeTempFile(t, "assets.csv", `ip,owner,dept
+10.0.0.1,alice,eng
+10.1.0.0/16,bob,ops
+10.1.1.0/24,carol,sec
+10.1.0.0/16,dave,it
+`)
+	defer os.RemoveAll(dir)
+	const out = `
+#0:record[ts:time,src:ip,owner:string,dept:string]
+0:[1;10.0.0.1;alice;eng;]
+0:[2;10.1.2.3;bob;ops;]
+0:[3;10.1.1.9;carol;sec;]
+0:[4;192.168.1.1;-;-;]
+0:[5;-;-;-;]
+`
+	proc.TestOneProc(t, lookupIn, out, fmt.Sprintf("lookup file=%q on src=ip", path))
+
+	const outOwner = `
+#0:record[ts:time,src:ip,owner:string]
+0:[1;10.0.0.1;alice;]
+0:[2;10.1.2.3;bob;]
+0:[3;10.1.1.9;carol;]
+0:[4;192.168.1.1;-;]
+0:[5;-;-;]
+`
+	proc.TestOneProc(t, lookupIn, outOwner, fmt.Sprintf("lookup file=%q on src=ip fields owner", path))
+}
+
+func TestLookupZng(t *testing.T) {
+	dir, path := writeTempFile(t, "assets.tzng", `
+#0:record[net:net,vlan:int64]
+0:[10.1.0.0/16;20;]
+#1:record[net:ip,vlan:int64]
+1:[10.0.0.1;10;]
+`)
+	defer os.RemoveAll(dir)
+	const out = `
+#0:record[ts:time,src:ip,vlan:int64]
+0:[1;10.0.0.1;10;]
+0:[2;10.1.2.3;20;]
+0:[3;10.1.1.9;20;]
+0:[4;192.168.1.1;-;]
+0:[5;-;-;]
+`
+	proc.TestOneProc(t, lookupIn, out, fmt.Sprintf("lookup file=%q on src=net", path))
+}
@@ -0,0 +1,113 @@
+package proc
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/brimsec/zq/zng"
+)
+
+// A Budget bounds the memory that procs use to buffer records.  Procs that
+// buffer records, such as sort, tail, top, and groupby, charge the size of
+// each record or row they hold to the Budget of their Context and release
+// it when they no longer hold it.  A Budget may have a parent, such as the
+// server-wide budget of a query's budget, to which each charge is also
+// made.  A nil Budget has no limit.  A Budget is safe for concurrent use.
+type Budget struct {
+	name   string
+	limit  int64
+	parent *Budget
+	mu     sync.Mutex
+	used   int64
+	closed bool
+}
+
+// NewBudget returns a Budget named name that holds at most limit bytes,
+// or any number if limit is zero, and that charges parent as well if
+// parent is not nil.
+func NewBudget(name string, limit int64, parent *Budget) *Budget {
+	return &Budget{
+		name:   name,
+		limit:  limit,
+		parent: parent,
+	}
+}
+
+// MemoryLimitError is the error returned when a charge would exceed the
+// limit of a Budget.
+type MemoryLimitError struct {
+	Budget string `json:"budget"`
+	Limit  int64  `json:"limit"`
+}
+
+func (e *MemoryLimitError) Error() string {
+	return fmt.Sprintf("%s memory limit of %d bytes exceeded", e.Budget, e.Limit)
+}
+
+// Charge adds n bytes to the memory used under b and its ancestors.  If
+// that would exceed the limit of any of them, nothing is charged and
+// Charge returns a *MemoryLimitError.
+func (b *Budget) Charge(n int) error {
+	if b == nil {
+		return nil
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.limit > 0 && b.used+int64(n) > b.limit {
+		return &MemoryLimitError{Budget: b.name, Limit: b.limit}
+	}
+	if !b.closed {
+		if err := b.parent.Charge(n); err != nil {
+			return err
+		}
+	}
+	b.used += int64(n)
+	return nil
+}
+
+// Release subtracts n bytes previously charged from the memory used under
+// b and its ancestors.
+func (b *Budget) Release(n int) {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.used -= int64(n)
+	if !b.closed {
+		b.parent.Release(n)
+	}
+}
+
+// Used returns the number of bytes charged to b and not yet released.
+func (b *Budget) Used() int64 {
+	if b == nil {
+		return 0
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.used
+}
+
+// Close releases from the ancestors of b all that is still charged to b,
+// as when a query that failed or was canceled leaves records buffered.
+// Charges to b after Close are not made to its ancestors.
+func (b *Budget) Close() {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if !b.closed {
+		b.closed = true
+		b.parent.Release(int(b.used))
+	}
+}
+
+// recordOverhead approximates the memory used by a buffered zng.Record
+// and the pointer to it apart from the record's body.
+const recordOverhead = 96
+
+func recordSize(r *zng.Record) int {
+	return recordOverhead + len(r.Raw)
+}
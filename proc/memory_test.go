@@ -0,0 +1,88 @@
+package proc_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/brimsec/zq/pkg/nano"
+	"github.com/brimsec/zq/proc"
+	"github.com/brimsec/zq/zbuf"
+	"github.com/brimsec/zq/zio/zngio"
+	"github.com/brimsec/zq/zng"
+	"github.com/brimsec/zq/zng/resolver"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBudget(t *testing.T) {
+	server := proc.NewBudget("server", 1000, nil)
+	q1 := proc.NewBudget("query", 600, server)
+	q2 := proc.NewBudget("query", 600, server)
+
+	require.NoError(t, q1.Charge(500))
+	var merr *proc.MemoryLimitError
+	err := q1.Charge(200)
+	require.True(t, errors.As(err, &merr))
+	assert.Equal(t, &proc.MemoryLimitError{Budget: "query", Limit: 600}, merr)
+
+	require.NoError(t, q2.Charge(400))
+	err = q2.Charge(200)
+	require.True(t, errors.As(err, &merr))
+	assert.Equal(t, "server", merr.Budget)
+	assert.EqualValues(t, 400, q2.Used())
+	assert.EqualValues(t, 900, server.Used())
+
+	q2.Release(100)
+	assert.EqualValues(t, 800, server.Used())
+
+	// Closing a budget releases what it holds from its parent.
+	q1.Close()
+	assert.EqualValues(t, 300, server.Used())
+	q1.Release(500)
+	assert.EqualValues(t, 300, server.Used())
+
+	var unlimited *proc.Budget
+	assert.NoError(t, unlimited.Charge(1<<40))
+}
+
+const memoryIn = `
+#0:record[foo:int32,s:string]
+0:[1;aaaaaaaaaaaaaaaaaaaaaaaaaaaaaa;]
+0:[2;bbbbbbbbbbbbbbbbbbbbbbbbbbbbbb;]
+0:[3;cccccccccccccccccccccccccccccc;]
+0:[1;dddddddddddddddddddddddddddddd;]
+0:[2;eeeeeeeeeeeeeeeeeeeeeeeeeeeeee;]
+`
+
+func TestMemoryLimit(t *testing.T) {
+	zctx := resolver.NewContext()
+	r := zngio.NewReader(strings.NewReader(memoryIn), zctx)
+	var recs []*zng.Record
+	for {
+		rec, err := r.Read()
+		require.NoError(t, err)
+		if rec == nil {
+			break
+		}
+		recs = append(recs, rec)
+	}
+	for _, cmd := range []string{"sort foo", "tail 4", "tail 2 by foo", "top 4 foo", "count() by s", "histogram foo by s", "window prev=lag(foo)", "sample 4", "session gap 1h by s"} {
+		for _, limit := range []int64{300, 100000} {
+			ctx := proc.NewTestContext(zctx)
+			ctx.Memory = proc.NewBudget("query", limit, nil)
+			src := proc.NewTestSource([]zbuf.Batch{zbuf.NewArray(recs, nano.MaxSpan)})
+			p, err := proc.CompileTestProc(cmd, ctx, src)
+			require.NoError(t, err)
+			batch, err := p.Pull()
+			if limit < 1000 {
+				var merr *proc.MemoryLimitError
+				assert.True(t, errors.As(err, &merr), "%s: expected memory limit error, got %v", cmd, err)
+				continue
+			}
+			require.NoError(t, err, cmd)
+			require.NotNil(t, batch, cmd)
+			assert.EqualValues(t, 0, ctx.Memory.Used(), "%s: memory held after output", cmd)
+		}
+	}
+}
@@ -109,20 +109,18 @@ func (m *Merge) Pull() (zbuf.Batch, error) {
 		m.Done()
 		return nil, m.err
 	}
-	oldest := nano.MaxTs
 	pick := -1
 
 	// For now our "merge" just pushes out the batch with the oldest
-	// timestamp at each round... this means that we may not
-	// sending out monotonically ordered tiemstamps. Proper
-	// time-ordered merging will come after Pull(span) is
-	// implemented.
+	// timestamp at each round (or the newest if the search is reversed)...
+	// this means that we may not sending out monotonically ordered
+	// tiemstamps. Proper time-ordered merging will come after Pull(span)
+	// is implemented.
 	for i, buf := range m.bufs {
 		if buf == nil {
 			continue
 		}
-		if buf.Span().Ts < oldest {
-			oldest = buf.Span().Ts
+		if pick < 0 || m.before(buf.Span(), m.bufs[pick].Span()) {
 			pick = i
 		}
 	}
@@ -140,6 +138,15 @@ func (m *Merge) Pull() (zbuf.Batch, error) {
 	return res, nil
 }
 
+// before returns true if a batch with span a comes before one with span b
+// in the direction of the search.
+func (m *Merge) before(a, b nano.Span) bool {
+	if m.Reverse {
+		return a.End() > b.End()
+	}
+	return a.Ts < b.Ts
+}
+
 func (m *Merge) Done() {
 	for k, parent := range m.parents {
 		if parent != nil {
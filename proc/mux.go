@@ -18,6 +18,9 @@ type MuxOutput struct {
 	muxProcs []*Mux
 	once     sync.Once
 	in       chan MuxResult
+	// stopped, if not nil, reports whether the scan that feeds the
+	// flowgraph ended early.
+	stopped func() bool
 }
 
 type Mux struct {
@@ -57,6 +60,18 @@ func NewMuxOutput(ctx *Context, parents []Proc) *MuxOutput {
 	return mux
 }
 
+// SetStopped sets the function with which Stopped reports whether the
+// scan that feeds the flowgraph ended early.
+func (m *MuxOutput) SetStopped(stopped func() bool) {
+	m.stopped = stopped
+}
+
+// Stopped returns true if the scan that feeds the flowgraph was ended
+// early by the deadline of its context so that the results are partial.
+func (m *MuxOutput) Stopped() bool {
+	return m.stopped != nil && m.stopped()
+}
+
 func (m *MuxOutput) Complete() bool {
 	return m.runners <= 0
 }
@@ -101,3 +116,13 @@ func (m *MuxOutput) Drain() {
 		m.Pull(nil)
 	}
 }
+
+// Flowgraph returns a description of the flowgraph feeding m as described
+// by the Flowgraph function.
+func (m *MuxOutput) Flowgraph() string {
+	leaves := make([]Proc, 0, len(m.muxProcs))
+	for _, mux := range m.muxProcs {
+		leaves = append(leaves, mux.Parent)
+	}
+	return Flowgraph(leaves)
+}
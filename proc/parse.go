@@ -0,0 +1,180 @@
+package proc
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/brimsec/zq/ast"
+	"github.com/brimsec/zq/expr"
+	"github.com/brimsec/zq/pkg/grok"
+	"github.com/brimsec/zq/pkg/nano"
+	"github.com/brimsec/zq/zbuf"
+	"github.com/brimsec/zq/zcode"
+	"github.com/brimsec/zq/zng"
+)
+
+// timeLayouts are the layouts tried in order when converting captured text
+// to a time before falling back to nano.Parse, which accepts seconds since
+// the Unix epoch.
+var timeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05Z07:00",
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05",
+	"2006-01-02T15:04:05Z0700",
+	"2006-01-02 15:04:05Z0700",
+	"02/Jan/2006:15:04:05 -0700",
+}
+
+// grokTypes maps the type names commonly used in grok patterns to their
+// zng equivalents.
+var grokTypes = map[string]zng.Type{
+	"":      zng.TypeString,
+	"int":   zng.TypeInt64,
+	"long":  zng.TypeInt64,
+	"float": zng.TypeFloat64,
+}
+
+func parseTime(s string) (zcode.Bytes, error) {
+	for _, layout := range timeLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return zng.EncodeTime(nano.TimeToTs(t)), nil
+		}
+	}
+	ts, err := nano.Parse([]byte(s))
+	if err != nil {
+		return nil, err
+	}
+	return zng.EncodeTime(ts), nil
+}
+
+// Parse extracts fields from the string value of a field by matching it
+// against a grok pattern.  The fields captured by the pattern are appended
+// to each matching record with the types given in the pattern, and a
+// captured value that cannot be converted to its type is unset.  Records
+// that do not match are transmitted unchanged.
+type Parse struct {
+	Base
+	field     expr.FieldExprResolver
+	fieldName string
+	grok      *grok.Grok
+	cols      []zng.Column
+	warn      bool
+	nmatched  int
+	nvals     int
+}
+
+// loadPatterns adds the patterns in the file at path, which is opened by
+// files, to lib.
+func loadPatterns(lib *grok.Library, files *FileRoot, path string) error {
+	f, err := files.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if err := lib.Load(f); err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	return nil
+}
+
+func CompileParse(c *Context, parent Proc, node *ast.ParseProc) (*Parse, error) {
+	field, err := expr.CompileFieldExpr(node.Field)
+	if err != nil {
+		return nil, fmt.Errorf("compiling parse: %w", err)
+	}
+	lib := grok.NewLibrary()
+	if node.PatternFile != "" {
+		if err := loadPatterns(lib, c.Files, node.PatternFile); err != nil {
+			return nil, fmt.Errorf("compiling parse: %w", err)
+		}
+	}
+	g, err := lib.Compile(node.Pattern)
+	if err != nil {
+		return nil, fmt.Errorf("compiling parse: %w", err)
+	}
+	cols := make([]zng.Column, len(g.Fields))
+	for k, f := range g.Fields {
+		typ, ok := grokTypes[f.Type]
+		if !ok {
+			typ = zng.LookupPrimitive(f.Type)
+		}
+		if typ == nil {
+			return nil, fmt.Errorf("compiling parse: unknown type for field %s: %s", f.Name, f.Type)
+		}
+		cols[k] = zng.NewColumn(f.Name, typ)
+	}
+	return &Parse{
+		Base:      Base{Context: c, Parent: parent},
+		field:     field,
+		fieldName: expr.FieldExprToString(node.Field),
+		grok:      g,
+		cols:      cols,
+		warn:      node.Warn,
+	}, nil
+}
+
+func (p *Parse) convert(typ zng.Type, s string) zcode.Bytes {
+	var zv zcode.Bytes
+	var err error
+	switch typ {
+	case zng.TypeString:
+		return zng.EncodeString(s)
+	case zng.TypeTime:
+		zv, err = parseTime(s)
+	default:
+		zv, err = typ.Parse([]byte(s))
+	}
+	if err != nil {
+		return nil
+	}
+	return zv
+}
+
+func (p *Parse) parse(r *zng.Record) (*zng.Record, error) {
+	v := p.field(r)
+	if v.Type == nil {
+		return r, nil
+	}
+	p.nvals++
+	if v.Bytes == nil || (v.Type != zng.TypeString && v.Type != zng.TypeBstring) {
+		return r, nil
+	}
+	captures := p.grok.Match(string(v.Bytes))
+	if captures == nil {
+		return r, nil
+	}
+	p.nmatched++
+	vals := make([]zng.Value, len(captures))
+	for k, c := range captures {
+		vals[k].Type = p.cols[k].Type
+		if c.Matched {
+			vals[k].Bytes = p.convert(vals[k].Type, c.Value)
+		}
+	}
+	return p.TypeContext.AddColumns(r, p.cols, vals)
+}
+
+func (p *Parse) warning() {
+	if p.warn && p.nmatched < p.nvals {
+		p.Warnings <- fmt.Sprintf("Parse pattern did not match %d of %d values of field %s", p.nvals-p.nmatched, p.nvals, p.fieldName)
+	}
+}
+
+func (p *Parse) Pull() (zbuf.Batch, error) {
+	batch, err := p.Get()
+	if EOS(batch, err) {
+		p.warning()
+		return nil, err
+	}
+	defer batch.Unref()
+	out := make([]*zng.Record, 0, batch.Length())
+	for k := 0; k < batch.Length(); k++ {
+		r, err := p.parse(batch.Index(k))
+		if err != nil {
+			return nil, fmt.Errorf("parse: %w", err)
+		}
+		out = append(out, r.Keep())
+	}
+	return zbuf.NewArray(out, batch.Span()), nil
+}
@@ -0,0 +1,75 @@
+package proc_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/brimsec/zq/proc"
+	"github.com/stretchr/testify/require"
+)
+
+const parseIn = `
+#0:record[ts:time,msg:string]
+0:[1;10.0.0.1 GET /index.html?a=b 200 2020-03-01T12:00:00Z;]
+0:[2;fe80::1 POST /login 404 2020-03-01T12:00:01.5+01:00;]
+0:[3;10.0.0.2 GET /big 99999999999999999999 2020-03-01T12:00:02Z;]
+0:[4;malformed;]
+0:[5;-;]
+#1:record[ts:time]
+1:[6;]
+`
+
+func TestParse(t *testing.T) {
+	const out = `
+#0:record[ts:time,msg:string,client:ip,method:string,path:string,status:int64,when:time]
+0:[1;10.0.0.1 GET /index.html?a=b 200 2020-03-01T12:00:00Z;10.0.0.1;GET;/index.html?a=b;200;1583064000;]
+0:[2;fe80::1 POST /login 404 2020-03-01T12:00:01.5+01:00;fe80::1;POST;/login;404;1583060401.5;]
+0:[3;10.0.0.2 GET /big 99999999999999999999 2020-03-01T12:00:02Z;10.0.0.2;GET;/big;-;1583064002;]
+#1:record[ts:time,msg:string]
+1:[4;malformed;]
+1:[5;-;]
+#2:record[ts:time]
+2:[6;]
+`
+	const pattern = `%{IP:client} %{WORD:method} %{URIPATHPARAM:path} %{INT:status} %{TIMESTAMP_ISO8601:when}`
+	proc.TestOneProc(t, parseIn, out, fmt.Sprintf("parse msg with %q", pattern))
+
+	warning := "Parse pattern did not match 2 of 5 values of field msg"
+	proc.TestOneProcWithWarnings(t, parseIn, out, []string{warning}, fmt.Sprintf("parse msg with %q -warn", pattern))
+}
+
+func TestParseTypes(t *testing.T) {
+	const in = `
+#0:record[msg:string]
+0:[took 1.5 seconds to send 10 bytes;]
+`
+	const out = `
+#0:record[msg:string,elapsed:duration,size:float64,unit:string]
+0:[took 1.5 seconds to send 10 bytes;1.5;10;bytes;]
+`
+	proc.TestOneProc(t, in, out, `parse msg with "took %{NUMBER:elapsed:duration} seconds to send %{INT:size:float} %{WORD:unit}"`)
+}
+
+func TestParsePatternFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "parse")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "patterns")
+	require.NoError(t, ioutil.WriteFile(path, []byte(`
+# application log patterns
+STATUS (?:OK|FAIL)
+RESULT %{STATUS} after %{INT} attempts
+`), 0644))
+	const in = `
+#0:record[msg:string]
+0:[job 7: FAIL after 3 attempts;]
+`
+	const out = `
+#0:record[msg:string,job:int64,result:string]
+0:[job 7: FAIL after 3 attempts;7;FAIL after 3 attempts;]
+`
+	proc.TestOneProc(t, in, out, fmt.Sprintf(`parse msg with "job %%{INT:job}: %%{RESULT:result}" -patterns %q`, path))
+}
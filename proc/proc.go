@@ -10,6 +10,7 @@ import (
 	"github.com/brimsec/zq/pkg/nano"
 	"github.com/brimsec/zq/reducer/compile"
 	"github.com/brimsec/zq/zbuf"
+	"github.com/brimsec/zq/zng"
 	"github.com/brimsec/zq/zng/resolver"
 	"go.uber.org/zap"
 )
@@ -46,6 +47,14 @@ type Context struct {
 	Logger      *zap.Logger
 	Reverse     bool
 	Warnings    chan string
+	// Analyzer, if not nil, collects statistics about each proc.
+	Analyzer *Analyzer
+	// Memory, if not nil, bounds the memory that procs use to buffer
+	// records.
+	Memory *Budget
+	// Files, if not nil, restricts the files that procs such as lookup
+	// read to those it allows.
+	Files *FileRoot
 }
 
 type Base struct {
@@ -112,6 +121,18 @@ func GroupKey(node ast.FieldExpr) string {
 // the leaves.  A custom proc compiler can be included and it will be tried first
 // for each node encountered during the compilation.
 func CompileProc(custom Compiler, node ast.Proc, c *Context, parent Proc) ([]Proc, error) {
+	if c.Analyzer != nil {
+		switch node.(type) {
+		case *ast.SequentialProc, *ast.ParallelProc, *ast.SwitchProc:
+			// The procs within these are analyzed individually.
+		default:
+			return c.Analyzer.compile(custom, node, c, parent)
+		}
+	}
+	return compileProc(custom, node, c, parent)
+}
+
+func compileProc(custom Compiler, node ast.Proc, c *Context, parent Proc) ([]Proc, error) {
 	if custom != nil {
 		p, err := custom.Compile(node, c, parent)
 		if err != nil {
@@ -163,6 +184,13 @@ func CompileProc(custom Compiler, node ast.Proc, c *Context, parent Proc) ([]Pro
 		if limit == 0 {
 			limit = 1
 		}
+		if len(v.Keys) > 0 {
+			keyMaker, err := compileKeyMaker(c.TypeContext, v.Keys)
+			if err != nil {
+				return nil, fmt.Errorf("compiling head: %w", err)
+			}
+			return []Proc{NewHeadBy(c, parent, limit, keyMaker)}, nil
+		}
 		return []Proc{NewHead(c, parent, limit)}, nil
 
 	case *ast.TailProc:
@@ -170,9 +198,23 @@ func CompileProc(custom Compiler, node ast.Proc, c *Context, parent Proc) ([]Pro
 		if limit == 0 {
 			limit = 1
 		}
+		if len(v.Keys) > 0 {
+			keyMaker, err := compileKeyMaker(c.TypeContext, v.Keys)
+			if err != nil {
+				return nil, fmt.Errorf("compiling tail: %w", err)
+			}
+			return []Proc{NewTailBy(c, parent, limit, keyMaker)}, nil
+		}
 		return []Proc{NewTail(c, parent, limit)}, nil
 
 	case *ast.UniqProc:
+		if len(v.Keys) > 0 {
+			keyMaker, err := compileKeyMaker(c.TypeContext, v.Keys)
+			if err != nil {
+				return nil, fmt.Errorf("compiling uniq: %w", err)
+			}
+			return []Proc{NewUniqBy(c, parent, keyMaker, v.Limit)}, nil
+		}
 		return []Proc{NewUniq(c, parent, v.Cflag)}, nil
 
 	case *ast.PassProc:
@@ -199,6 +241,70 @@ func CompileProc(custom Compiler, node ast.Proc, c *Context, parent Proc) ([]Pro
 		}
 		return []Proc{put}, nil
 
+	case *ast.WindowProc:
+		window, err := CompileWindowProc(c, parent, v)
+		if err != nil {
+			return nil, err
+		}
+		return []Proc{window}, nil
+
+	case *ast.SessionProc:
+		params, err := CompileSession(c, v)
+		if err != nil {
+			return nil, err
+		}
+		return []Proc{NewSession(c, parent, *params)}, nil
+
+	case *ast.SampleProc:
+		sample, err := CompileSample(c, parent, v)
+		if err != nil {
+			return nil, err
+		}
+		return []Proc{sample}, nil
+
+	case *ast.HistogramProc:
+		histogram, err := CompileHistogram(c, parent, v)
+		if err != nil {
+			return nil, err
+		}
+		return []Proc{histogram}, nil
+
+	case *ast.LookupProc:
+		lookup, err := CompileLookup(c, parent, v)
+		if err != nil {
+			return nil, err
+		}
+		return []Proc{lookup}, nil
+
+	case *ast.IntelProc:
+		intel, err := CompileIntel(c, parent, v)
+		if err != nil {
+			return nil, err
+		}
+		return []Proc{intel}, nil
+
+	case *ast.UnnestProc:
+		put, err := CompilePutProc(c, parent, &ast.PutProc{
+			Node:   ast.Node{"PutProc"},
+			Target: v.Field,
+			Expr: &ast.FunctionCall{
+				Node:     ast.Node{"FunctionCall"},
+				Function: "parseJSON",
+				Args:     []ast.Expression{&ast.FieldRead{ast.Node{"FieldRead"}, v.Field}},
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+		return []Proc{put}, nil
+
+	case *ast.ParseProc:
+		parse, err := CompileParse(c, parent, v)
+		if err != nil {
+			return nil, err
+		}
+		return []Proc{parse}, nil
+
 	case *ast.SequentialProc:
 		var parents []Proc
 		var err error
@@ -238,6 +344,37 @@ func CompileProc(custom Compiler, node ast.Proc, c *Context, parent Proc) ([]Pro
 		}
 		return procs, nil
 
+	case *ast.SwitchProc:
+		filters := make([]filter.Filter, len(v.Cases))
+		for k, cs := range v.Cases {
+			f, err := filter.Compile(cs.Filter)
+			if err != nil {
+				return nil, fmt.Errorf("compiling switch case filter: %w", err)
+			}
+			filters[k] = f
+		}
+		route := func(r *zng.Record) int {
+			for k, f := range filters {
+				if f(r) {
+					return k
+				}
+			}
+			return -1
+		}
+		splitter := NewPartition(c, parent, route)
+		var procs []Proc
+		for _, cs := range v.Cases {
+			// Each case's chain gets the records routed to it by
+			// its SplitChannel.
+			sc := NewSplitChannel(splitter)
+			proc, err := CompileProc(custom, cs.Proc, c, sc)
+			if err != nil {
+				return nil, err
+			}
+			procs = append(procs, proc...)
+		}
+		return procs, nil
+
 	default:
 		return nil, fmt.Errorf("unknown AST type: %v", v)
 	}
@@ -28,7 +28,7 @@ type Put struct {
 }
 
 func CompilePutProc(c *Context, parent Proc, node *ast.PutProc) (*Put, error) {
-	eval, err := expr.CompileExpr(node.Expr)
+	eval, err := expr.CompileExprWithHook(node.Expr, expr.ContextFunctions(c.TypeContext))
 	if err != nil {
 		return nil, err
 	}
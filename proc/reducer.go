@@ -23,7 +23,7 @@ type Reducer struct {
 }
 
 func NewReducer(c *Context, parent Proc, params ReducerParams) Proc {
-	interval := time.Duration(params.interval.Seconds) * time.Second
+	interval := time.Duration(params.interval.Nanoseconds)
 	return &Reducer{
 		Base:     Base{Context: c, Parent: parent},
 		interval: interval,
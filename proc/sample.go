@@ -0,0 +1,195 @@
+package proc
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"sort"
+
+	"github.com/brimsec/zq/ast"
+	"github.com/brimsec/zq/pkg/nano"
+	"github.com/brimsec/zq/zbuf"
+	"github.com/brimsec/zq/zng"
+)
+
+// Sample transmits a random subset of its input, either by independently
+// selecting each record with a fixed probability as it arrives or by
+// reservoir sampling a fixed number of records from each group.  The
+// records in the reservoirs are charged to the Memory budget of the
+// Context.
+type Sample struct {
+	Base
+	size     int
+	rate     float64
+	keyed    bool
+	keyMaker *keyMaker
+	rng      *rand.Rand
+	// reservoirs holds the sample of each group when sampling by size.
+	// When sampling by rate, it only records which groups have been seen.
+	reservoirs map[string]*reservoir
+	seq        int
+	nrecs      int
+}
+
+// reservoir holds a uniform random sample of the records of a group along
+// with each record's position in the input.
+type reservoir struct {
+	recs []*zng.Record
+	seqs []int
+	seen int
+}
+
+func CompileSample(c *Context, parent Proc, node *ast.SampleProc) (*Sample, error) {
+	if node.Rate < 0 || node.Rate > 1 {
+		return nil, errors.New("compiling sample: rate must be between 0% and 100%")
+	}
+	if node.Rate == 0 && node.Size <= 0 {
+		return nil, errors.New("compiling sample: size must be positive")
+	}
+	keyMaker, err := compileKeyMaker(c.TypeContext, node.Keys)
+	if err != nil {
+		return nil, fmt.Errorf("compiling sample: %w", err)
+	}
+	return &Sample{
+		Base:       Base{Context: c, Parent: parent},
+		size:       node.Size,
+		rate:       node.Rate,
+		keyed:      len(node.Keys) > 0,
+		keyMaker:   keyMaker,
+		rng:        rand.New(rand.NewSource(node.Seed)),
+		reservoirs: make(map[string]*reservoir),
+	}, nil
+}
+
+// group returns the reservoir for the group of r, or nil if r doesn't have
+// all of the keys.  The boolean result is true if the group is new.
+func (s *Sample) group(r *zng.Record) (*reservoir, bool, error) {
+	keyCols, keyBytes := s.keyMaker.lookup(r)
+	if keyCols.columns == nil {
+		return nil, false, nil
+	}
+	res, ok := s.reservoirs[string(keyBytes)]
+	if !ok {
+		if len(s.reservoirs) >= defaultGroupByLimit {
+			return nil, false, errTooBig(defaultGroupByLimit)
+		}
+		res = &reservoir{}
+		s.reservoirs[string(keyBytes)] = res
+	}
+	return res, !ok, nil
+}
+
+// selectByRate returns true if r should be transmitted when sampling by rate.
+func (s *Sample) selectByRate(r *zng.Record) (bool, error) {
+	if !s.keyed {
+		return s.rng.Float64() < s.rate, nil
+	}
+	res, isNew, err := s.group(r)
+	if res == nil || err != nil {
+		return false, err
+	}
+	// Always draw a random number so that the selection of each
+	// record does not depend on the groups seen so far.
+	return s.rng.Float64() < s.rate || isNew, nil
+}
+
+// consume adds r to the reservoir of its group using Algorithm R so that
+// each record of a group is equally likely to be in the final sample.
+func (s *Sample) consume(r *zng.Record) error {
+	res, _, err := s.group(r)
+	if res == nil || err != nil {
+		return err
+	}
+	res.seen++
+	if len(res.recs) < s.size {
+		rec := r.Keep()
+		if err := s.Memory.Charge(recordSize(rec)); err != nil {
+			return err
+		}
+		res.recs = append(res.recs, rec)
+		res.seqs = append(res.seqs, s.seq)
+		s.nrecs++
+	} else if k := s.rng.Intn(res.seen); k < s.size {
+		rec := r.Keep()
+		if err := s.Memory.Charge(recordSize(rec)); err != nil {
+			return err
+		}
+		s.Memory.Release(recordSize(res.recs[k]))
+		res.recs[k] = rec
+		res.seqs[k] = s.seq
+	}
+	s.seq++
+	return nil
+}
+
+func (s *Sample) sample() zbuf.Batch {
+	if len(s.reservoirs) == 0 {
+		return nil
+	}
+	type entry struct {
+		seq int
+		rec *zng.Record
+	}
+	var entries []entry
+	for _, res := range s.reservoirs {
+		for k, rec := range res.recs {
+			entries = append(entries, entry{res.seqs[k], rec})
+			s.Memory.Release(recordSize(rec))
+		}
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].seq < entries[j].seq })
+	out := make([]*zng.Record, len(entries))
+	for k, e := range entries {
+		out[k] = e.rec
+	}
+	s.reservoirs = make(map[string]*reservoir)
+	s.nrecs = 0
+	return zbuf.NewArray(out, nano.NewSpanTs(s.MinTs, s.MaxTs))
+}
+
+// Buffered returns the number of records held in the reservoirs.
+func (s *Sample) Buffered() int {
+	return s.nrecs
+}
+
+func (s *Sample) Pull() (zbuf.Batch, error) {
+	if s.rate > 0 {
+		return s.pullByRate()
+	}
+	for {
+		batch, err := s.Get()
+		if err != nil {
+			return nil, err
+		}
+		if batch == nil {
+			return s.sample(), nil
+		}
+		for k := 0; k < batch.Length(); k++ {
+			if err := s.consume(batch.Index(k)); err != nil {
+				batch.Unref()
+				return nil, err
+			}
+		}
+		batch.Unref()
+	}
+}
+
+func (s *Sample) pullByRate() (zbuf.Batch, error) {
+	batch, err := s.Get()
+	if EOS(batch, err) {
+		return nil, err
+	}
+	defer batch.Unref()
+	out := make([]*zng.Record, 0, batch.Length())
+	for k := 0; k < batch.Length(); k++ {
+		r := batch.Index(k)
+		ok, err := s.selectByRate(r)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			out = append(out, r.Keep())
+		}
+	}
+	return zbuf.NewArray(out, batch.Span()), nil
+}
@@ -0,0 +1,57 @@
+package proc_test
+
+import (
+	"testing"
+
+	"github.com/brimsec/zq/proc"
+)
+
+const sampleIn = `
+#0:record[ts:time,host:ip,n:int64]
+0:[0;10.0.0.1;1;]
+0:[60;10.0.0.2;2;]
+0:[600;10.0.0.1;3;]
+0:[3000;10.0.0.1;4;]
+0:[3100;10.0.0.2;5;]
+0:[3200;10.0.0.1;6;]
+`
+
+func TestSampleBySize(t *testing.T) {
+	// The sample is transmitted in input order.
+	const out = `
+#0:record[ts:time,host:ip,n:int64]
+0:[60;10.0.0.2;2;]
+0:[600;10.0.0.1;3;]
+`
+	proc.TestOneProc(t, sampleIn, out, "sample 2")
+
+	// A sample at least as large as the input is the whole input.
+	proc.TestOneProc(t, sampleIn, sampleIn, "sample 10 -seed 3")
+
+	const outBy = `
+#0:record[ts:time,host:ip,n:int64]
+0:[60;10.0.0.2;2;]
+0:[3000;10.0.0.1;4;]
+`
+	proc.TestOneProc(t, sampleIn, outBy, "sample 1 by host")
+}
+
+func TestSampleByRate(t *testing.T) {
+	const out = `
+#0:record[ts:time,host:ip,n:int64]
+0:[60;10.0.0.2;2;]
+0:[3000;10.0.0.1;4;]
+0:[3100;10.0.0.2;5;]
+0:[3200;10.0.0.1;6;]
+`
+	proc.TestOneProc(t, sampleIn, out, "sample 50%")
+
+	// The first record of each group is always transmitted.
+	const outBy = `
+#0:record[ts:time,host:ip,n:int64]
+0:[0;10.0.0.1;1;]
+0:[60;10.0.0.2;2;]
+0:[3000;10.0.0.1;4;]
+`
+	proc.TestOneProc(t, sampleIn, outBy, "sample 10% by host")
+}
@@ -0,0 +1,225 @@
+package proc
+
+import (
+	"container/list"
+	"errors"
+	"fmt"
+
+	"github.com/brimsec/zq/ast"
+	"github.com/brimsec/zq/pkg/nano"
+	"github.com/brimsec/zq/reducer"
+	"github.com/brimsec/zq/reducer/compile"
+	"github.com/brimsec/zq/zbuf"
+	"github.com/brimsec/zq/zcode"
+	"github.com/brimsec/zq/zng"
+)
+
+type SessionParams struct {
+	gap      int64
+	keyMaker *keyMaker
+	reducers []compile.CompiledReducer
+}
+
+func CompileSession(c *Context, node *ast.SessionProc) (*SessionParams, error) {
+	if node.Gap.Nanoseconds <= 0 {
+		return nil, errors.New("compiling session: gap must be positive")
+	}
+	keyMaker, err := compileKeyMaker(c.TypeContext, node.Keys)
+	if err != nil {
+		return nil, fmt.Errorf("compiling session: %w", err)
+	}
+	reducers := make([]compile.CompiledReducer, 0, len(node.Reducers))
+	for _, reducer := range node.Reducers {
+		compiled, err := compile.Compile(reducer)
+		if err != nil {
+			return nil, err
+		}
+		reducers = append(reducers, compiled)
+	}
+	return &SessionParams{
+		gap:      node.Gap.Nanoseconds,
+		keyMaker: keyMaker,
+		reducers: reducers,
+	}, nil
+}
+
+// Session partitions the records of each group into sessions of activity
+// separated by gaps in time and transmits a summary record for each session.
+// Records are expected to arrive in time order (reversed if the search is
+// reversed), so that a session is complete, and can be transmitted, as soon
+// as a record arrives more than the gap after the session's latest record.
+// The open sessions are charged to the Memory budget of the Context.
+type Session struct {
+	Base
+	gap      int64
+	keyMaker *keyMaker
+	reducers []compile.CompiledReducer
+	// active maps the lookup key of each group with an open session to
+	// that session's element in the lru list, which is ordered by the time
+	// of each session's latest record.
+	active map[string]*list.Element
+	lru    *list.List
+	limit  int
+}
+
+type session struct {
+	key      string
+	keyCols  keyRow
+	keyvals  zcode.Bytes
+	first    nano.Ts
+	last     nano.Ts
+	count    uint64
+	reducers compile.Row
+}
+
+func NewSession(c *Context, parent Proc, params SessionParams) *Session {
+	return &Session{
+		Base:     Base{Context: c, Parent: parent},
+		gap:      params.gap,
+		keyMaker: params.keyMaker,
+		reducers: params.reducers,
+		active:   make(map[string]*list.Element),
+		lru:      list.New(),
+		limit:    defaultGroupByLimit,
+	}
+}
+
+// Buffered returns the number of open sessions.
+func (s *Session) Buffered() int {
+	return len(s.active)
+}
+
+func (s *Session) Pull() (zbuf.Batch, error) {
+	for {
+		batch, err := s.Get()
+		if err != nil {
+			return nil, err
+		}
+		if batch == nil {
+			return s.output(s.expire(nil, nil)), nil
+		}
+		var out []*zng.Record
+		for k := 0; k < batch.Length(); k++ {
+			r := batch.Index(k)
+			out = s.expire(out, &r.Ts)
+			if err := s.consume(r); err != nil {
+				batch.Unref()
+				return nil, err
+			}
+		}
+		batch.Unref()
+		if len(out) > 0 {
+			return s.output(out), nil
+		}
+	}
+}
+
+// elapsed returns the time from a to b in the direction of the search.
+func (s *Session) elapsed(a, b nano.Ts) int64 {
+	if s.Reverse {
+		return a.SubTs(b)
+	}
+	return b.SubTs(a)
+}
+
+// expire appends to out a record for each open session that has been
+// inactive for longer than the gap as of time now and closes the session.
+// If now is nil, all open sessions are closed.
+func (s *Session) expire(out []*zng.Record, now *nano.Ts) []*zng.Record {
+	for e := s.lru.Front(); e != nil; e = s.lru.Front() {
+		sess := e.Value.(*session)
+		if now != nil && s.elapsed(sess.last, *now) <= s.gap {
+			break
+		}
+		out = append(out, s.record(sess))
+		delete(s.active, sess.key)
+		s.lru.Remove(e)
+		s.Memory.Release(s.size(sess))
+	}
+	return out
+}
+
+func (s *Session) consume(r *zng.Record) error {
+	keyCols, keyBytes := s.keyMaker.lookup(r)
+	if keyCols.columns == nil {
+		return nil
+	}
+	var sess *session
+	if e, ok := s.active[string(keyBytes)]; ok {
+		sess = e.Value.(*session)
+		s.lru.MoveToBack(e)
+	} else {
+		if len(s.active) >= s.limit {
+			return errTooBig(s.limit)
+		}
+		// Make a deep copy since keyBytes is reused by the keyMaker.
+		keyvals := make(zcode.Bytes, len(keyBytes)-4)
+		copy(keyvals, keyBytes[4:])
+		sess = &session{
+			key:      string(keyBytes),
+			keyCols:  keyCols,
+			keyvals:  keyvals,
+			first:    r.Ts,
+			reducers: compile.Row{Defs: s.reducers},
+		}
+		if err := s.Memory.Charge(s.size(sess)); err != nil {
+			return err
+		}
+		s.active[sess.key] = s.lru.PushBack(sess)
+	}
+	sess.last = r.Ts
+	sess.count++
+	sess.reducers.Consume(r)
+	return nil
+}
+
+// size returns the memory charged for an open session, which like a
+// groupby row holds key values and reducers.
+func (s *Session) size(sess *session) int {
+	return rowOverhead + len(sess.key) + len(sess.keyvals) + reducerOverhead*len(s.reducers)
+}
+
+// record returns the summary record for a session.
+func (s *Session) record(sess *session) *zng.Record {
+	start, end := sess.first, sess.last
+	if s.Reverse {
+		start, end = end, start
+	}
+	types := make([]zng.Type, len(sess.keyCols.columns))
+	for k, col := range sess.keyCols.columns {
+		types[k] = col.Type
+	}
+	cols := []zng.Column{
+		zng.NewColumn("start", zng.TypeTime),
+		zng.NewColumn("end", zng.TypeTime),
+		zng.NewColumn("duration", zng.TypeDuration),
+	}
+	cols = append(cols, s.keyMaker.builder.TypedColumns(types)...)
+	cols = append(cols, zng.NewColumn("count", zng.TypeUint64))
+	var zv zcode.Bytes
+	zv = zcode.AppendPrimitive(zv, zng.EncodeTime(start))
+	zv = zcode.AppendPrimitive(zv, zng.EncodeTime(end))
+	zv = zcode.AppendPrimitive(zv, zng.EncodeDuration(end.SubTs(start)))
+	zv = append(zv, sess.keyvals...)
+	zv = zcode.AppendPrimitive(zv, zng.EncodeUint(sess.count))
+	for k, red := range sess.reducers.Reducers {
+		v := reducer.Result(red)
+		cols = append(cols, zng.NewColumn(sess.reducers.Defs[k].Target(), v.Type))
+		zv = v.Encode(zv)
+	}
+	typ := s.TypeContext.LookupTypeRecord(cols)
+	return zng.NewRecordTs(typ, start, zv)
+}
+
+func (s *Session) output(recs []*zng.Record) zbuf.Batch {
+	if len(recs) == 0 {
+		// Don't propagate empty batches.
+		return nil
+	}
+	first, last := recs[0].Ts, recs[0].Ts
+	for _, r := range recs[1:] {
+		first = nano.Min(first, r.Ts)
+		last = nano.Max(last, r.Ts)
+	}
+	return zbuf.NewArray(recs, nano.NewSpanTs(first, last+1))
+}
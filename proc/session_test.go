@@ -0,0 +1,47 @@
+package proc_test
+
+import (
+	"testing"
+
+	"github.com/brimsec/zq/proc"
+	"github.com/brimsec/zq/zbuf"
+	"github.com/brimsec/zq/zng/resolver"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSession(t *testing.T) {
+	const in = `
+#0:record[ts:time,host:ip,n:int64]
+0:[0;10.0.0.1;1;]
+0:[60;10.0.0.2;2;]
+0:[600;10.0.0.1;3;]
+0:[3000;10.0.0.1;4;]
+0:[3100;10.0.0.2;5;]
+0:[3200;10.0.0.1;6;]
+`
+	// The first session of each host expires when the record at 3000
+	// arrives while the second sessions are transmitted at EOS.
+	const out1 = `
+#0:record[start:time,end:time,duration:duration,host:ip,count:uint64,sum:int64]
+0:[60;60;0;10.0.0.2;1;2;]
+0:[0;600;600;10.0.0.1;2;4;]
+`
+	const out2 = `
+#0:record[start:time,end:time,duration:duration,host:ip,count:uint64,sum:int64]
+0:[3100;3100;0;10.0.0.2;1;5;]
+0:[3000;3200;200;10.0.0.1;2;10;]
+`
+	zctx := resolver.NewContext()
+	test, err := proc.NewProcTestFromSource("session gap 30m sum(n) by host", zctx, []zbuf.Batch{parseBatch(t, zctx, in)})
+	require.NoError(t, err)
+	require.NoError(t, test.Expect(parseBatch(t, zctx, out1)))
+	require.NoError(t, test.Expect(parseBatch(t, zctx, out2)))
+	require.NoError(t, test.ExpectEOS())
+	require.NoError(t, test.Finish())
+
+	const outAll = `
+#0:record[start:time,end:time,duration:duration,count:uint64]
+0:[0;3200;3200;6;]
+`
+	proc.TestOneProc(t, in, outAll, "session gap 1h")
+}
@@ -24,6 +24,8 @@ type Sort struct {
 	fields     []ast.FieldExpr
 	resolvers  []expr.FieldExprResolver
 	out        []*zng.Record
+	// charged is the memory charged to the budget for out.
+	charged int
 }
 
 // defaultSortLimit is the default limit of the number of records that
@@ -93,6 +95,11 @@ func guessSortField(rec *zng.Record) string {
 	return "ts"
 }
 
+// Buffered returns the number of records waiting to be sorted.
+func (s *Sort) Buffered() int {
+	return len(s.out)
+}
+
 func (s *Sort) Pull() (zbuf.Batch, error) {
 	for {
 		batch, err := s.Get()
@@ -107,16 +114,25 @@ func (s *Sort) Pull() (zbuf.Batch, error) {
 			return nil, ErrSortLimitReached(s.limit)
 		}
 		// XXX this should handle group-by every ... need to change how we do this
-		s.consume(batch)
+		err = s.consume(batch)
 		batch.Unref()
+		if err != nil {
+			return nil, err
+		}
 	}
 }
 
-func (s *Sort) consume(batch zbuf.Batch) {
+func (s *Sort) consume(batch zbuf.Batch) error {
 	//XXX this could be made more efficient
 	for k := 0; k < batch.Length(); k++ {
-		s.out = append(s.out, batch.Index(k).Keep())
+		rec := batch.Index(k).Keep()
+		if err := s.Memory.Charge(recordSize(rec)); err != nil {
+			return err
+		}
+		s.charged += recordSize(rec)
+		s.out = append(s.out, rec)
 	}
+	return nil
 }
 
 func (s *Sort) sort() zbuf.Batch {
@@ -125,6 +141,8 @@ func (s *Sort) sort() zbuf.Batch {
 		return nil
 	}
 	s.out = nil
+	s.Memory.Release(s.charged)
+	s.charged = 0
 	if s.resolvers == nil {
 		fld := guessSortField(out[0])
 		resolver := func(r *zng.Record) zng.Value {
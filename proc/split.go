@@ -1,9 +1,11 @@
 package proc
 
 import (
+	"context"
 	"sync"
 
 	"github.com/brimsec/zq/zbuf"
+	"github.com/brimsec/zq/zng"
 )
 
 // Split splits its input into multiple proc outputs.  Since procs run from the
@@ -13,32 +15,63 @@ import (
 // This scheme implements flow control since the SplitProc prevents any of
 // the downstream from running ahead, esentially running the parallel paths
 // at the rate of the slowest consumer.
+//
+// A Split created with NewPartition sends each record to just one output,
+// as chosen by its route function, instead of sending every batch to
+// every output.
 type Split struct {
 	Base
 	once     sync.Once
 	n        int
-	requests chan (chan<- Result)
+	nchan    int
+	route    func(*zng.Record) int
+	requests chan splitRequest
+}
+
+// A splitRequest is sent by a SplitChannel to request the next batch.
+// A nil ch indicates that the SplitChannel is done.
+type splitRequest struct {
+	index int
+	ch    chan<- Result
 }
 
 func NewSplit(c *Context, parent Proc) *Split {
 	s := &Split{Base: Base{Context: c, Parent: parent}}
-	s.requests = make(chan (chan<- Result))
+	s.requests = make(chan splitRequest)
+	return s
+}
+
+// NewPartition returns a Split that sends each record to the output whose
+// index is returned by route, where outputs are numbered in the order
+// their SplitChannels were created.  If route returns an index out of
+// range, the record is dropped.
+func NewPartition(c *Context, parent Proc, route func(*zng.Record) int) *Split {
+	s := NewSplit(c, parent)
+	s.route = route
 	return s
 }
 
-func (s *Split) Add(p *SplitChannel) chan (chan<- Result) {
+func (s *Split) Add(p *SplitChannel) chan splitRequest {
+	p.index = s.nchan
+	s.nchan++
 	s.n++
 	return s.requests
 }
 
-func (s *Split) gather(strip []chan<- Result) []chan<- Result {
-	flight := strip[:0]
-	for len(flight) < s.n {
-		ch := <-s.requests
-		if ch == nil {
+// gather waits for a request from each active SplitChannel and returns the
+// requesting channels indexed by SplitChannel, with nil entries for
+// SplitChannels that are done.
+func (s *Split) gather(flight []chan<- Result) []chan<- Result {
+	for k := range flight {
+		flight[k] = nil
+	}
+	for n := 0; n < s.n; {
+		req := <-s.requests
+		if req.ch == nil {
 			s.n--
 		} else {
-			flight = append(flight, ch)
+			flight[req.index] = req.ch
+			n++
 		}
 	}
 	return flight
@@ -53,11 +86,20 @@ func (s *Split) run() {
 	// indicating the downstream proc is done), then data is pulled from
 	// the upstream path and a reference-counted batch is transmitted to
 	// each requesting entity.
-	strip := make([]chan<- Result, 0, s.n)
+	flight := make([]chan<- Result, s.nchan)
 	for s.n > 0 {
-		flight := s.gather(strip)
+		flight = s.gather(flight)
+		if s.n == 0 {
+			// Every SplitChannel finished while we gathered, so
+			// don't pull a batch that no one will receive.
+			break
+		}
 		batch, err := s.Get()
-		s.send(flight, Result{batch, err})
+		if s.route != nil && batch != nil {
+			s.partition(flight, batch)
+		} else {
+			s.send(flight, Result{batch, err})
+		}
 		if batch != nil {
 			batch.Unref()
 		}
@@ -67,6 +109,9 @@ func (s *Split) run() {
 
 func (s *Split) send(flight []chan<- Result, result Result) {
 	for _, ch := range flight {
+		if ch == nil {
+			continue
+		}
 		if result.Batch != nil {
 			result.Batch.Ref()
 		}
@@ -74,15 +119,35 @@ func (s *Split) send(flight []chan<- Result, result Result) {
 	}
 }
 
+// partition sends each requesting channel a batch holding the records
+// of batch routed to it, which may be empty.  Records routed to channels
+// that are done are dropped.
+func (s *Split) partition(flight []chan<- Result, batch zbuf.Batch) {
+	outs := make([][]*zng.Record, len(flight))
+	for k := 0; k < batch.Length(); k++ {
+		r := batch.Index(k)
+		i := s.route(r)
+		if i >= 0 && i < len(flight) && flight[i] != nil {
+			outs[i] = append(outs[i], r.Keep())
+		}
+	}
+	for i, ch := range flight {
+		if ch != nil {
+			ch <- Result{zbuf.NewArray(outs[i], batch.Span()), nil}
+		}
+	}
+}
+
 func (s *Split) Pull() (zbuf.Batch, error) {
 	// never called
 	return nil, nil
 }
 
 type SplitChannel struct {
-	request chan chan<- Result
+	request chan splitRequest
 	ch      chan Result
 	parent  *Split
+	index   int
 }
 
 func NewSplitChannel(parent *Split) *SplitChannel {
@@ -111,7 +176,7 @@ func (s *SplitChannel) Pull() (zbuf.Batch, error) {
 	// are gone.  We don't want both SplitProc and SplitChannel listening
 	// on context canceled as that could lead to deadlock.
 	var err error
-	s.request <- s.ch
+	s.request <- splitRequest{s.index, s.ch}
 	select {
 	case result := <-s.ch:
 		if result.Batch == nil && result.Err == nil {
@@ -120,6 +185,15 @@ func (s *SplitChannel) Pull() (zbuf.Batch, error) {
 		return result.Batch, result.Err
 	case <-s.parent.Context.Done():
 		err = s.parent.Context.Err()
+		if err == context.DeadlineExceeded {
+			// The scanner ends the stream when the deadline
+			// passes, so wait for the rest of what it scanned.
+			result := <-s.ch
+			if result.Batch == nil && result.Err == nil {
+				s.Done()
+			}
+			return result.Batch, result.Err
+		}
 	}
 	s.Done()
 	return nil, err
@@ -131,8 +205,7 @@ func (s *SplitChannel) Done() {
 	// it's channel to nil in case a spurious Pull() is called, but this
 	// should not happen.
 	if s.ch != nil {
-		var null chan Result
-		s.request <- null
+		s.request <- splitRequest{index: s.index}
 		s.ch = nil
 	}
 }
@@ -1,6 +1,8 @@
 package proc
 
 import (
+	"sort"
+
 	"github.com/brimsec/zq/pkg/nano"
 	"github.com/brimsec/zq/zbuf"
 	"github.com/brimsec/zq/zng"
@@ -23,9 +25,15 @@ func (t *Tail) tail() zbuf.Batch {
 	if t.count <= 0 {
 		return nil
 	}
-	out := make([]*zng.Record, t.limit)
-	for k := 0; k < t.limit; k++ {
-		out[k] = t.q[(t.off+k)%t.limit]
+	// The queue is full only if count is limit, in which case the
+	// oldest record is at off.
+	start := t.off - t.count + t.limit
+	out := make([]*zng.Record, t.count)
+	for k := range out {
+		i := (start + k) % t.limit
+		out[k] = t.q[i]
+		t.q[i] = nil
+		t.Memory.Release(recordSize(out[k]))
 	}
 	t.off = 0
 	t.count = 0
@@ -33,6 +41,11 @@ func (t *Tail) tail() zbuf.Batch {
 
 }
 
+// Buffered returns the number of records held in the tail's queue.
+func (t *Tail) Buffered() int {
+	return t.count
+}
+
 func (t *Tail) Pull() (zbuf.Batch, error) {
 	for {
 		batch, err := t.Get()
@@ -40,7 +53,15 @@ func (t *Tail) Pull() (zbuf.Batch, error) {
 			return t.tail(), nil
 		}
 		for k := 0; k < batch.Length(); k++ {
-			t.q[t.off] = batch.Index(k).Keep()
+			rec := batch.Index(k).Keep()
+			if err := t.Memory.Charge(recordSize(rec)); err != nil {
+				batch.Unref()
+				return nil, err
+			}
+			if old := t.q[t.off]; old != nil {
+				t.Memory.Release(recordSize(old))
+			}
+			t.q[t.off] = rec
 			t.off = (t.off + 1) % t.limit
 			t.count++
 			if t.count >= t.limit {
@@ -50,3 +71,113 @@ func (t *Tail) Pull() (zbuf.Batch, error) {
 		batch.Unref()
 	}
 }
+
+// TailBy reads all its records from its input and transmits the final
+// limit records of each group of records sharing the same group-by key
+// values.  The transmitted records retain their relative input order.
+// Records that do not have all of the keys are discarded.
+type TailBy struct {
+	Base
+	limit    int
+	keyMaker *keyMaker
+	tables   map[string]*tailQueue
+	seq      int
+	nrecs    int
+}
+
+// tailQueue is a ring buffer holding the most recent records of a group
+// along with each record's position in the input.
+type tailQueue struct {
+	recs []*zng.Record
+	seqs []int
+	off  int
+}
+
+func NewTailBy(c *Context, parent Proc, limit int, keyMaker *keyMaker) *TailBy {
+	return &TailBy{
+		Base:     Base{Context: c, Parent: parent},
+		limit:    limit,
+		keyMaker: keyMaker,
+		tables:   make(map[string]*tailQueue),
+	}
+}
+
+func (t *TailBy) consume(r *zng.Record) error {
+	keyCols, keyBytes := t.keyMaker.lookup(r)
+	if keyCols.columns == nil {
+		return nil
+	}
+	q, ok := t.tables[string(keyBytes)]
+	if !ok {
+		if len(t.tables) >= defaultGroupByLimit {
+			return errTooBig(defaultGroupByLimit)
+		}
+		q = &tailQueue{}
+		t.tables[string(keyBytes)] = q
+	}
+	rec := r.Keep()
+	if err := t.Memory.Charge(recordSize(rec)); err != nil {
+		return err
+	}
+	if len(q.recs) < t.limit {
+		q.recs = append(q.recs, rec)
+		q.seqs = append(q.seqs, t.seq)
+		t.nrecs++
+	} else {
+		t.Memory.Release(recordSize(q.recs[q.off]))
+		q.recs[q.off] = rec
+		q.seqs[q.off] = t.seq
+		q.off = (q.off + 1) % t.limit
+	}
+	t.seq++
+	return nil
+}
+
+func (t *TailBy) tail() zbuf.Batch {
+	if len(t.tables) == 0 {
+		return nil
+	}
+	type entry struct {
+		seq int
+		rec *zng.Record
+	}
+	var entries []entry
+	for _, q := range t.tables {
+		for k, rec := range q.recs {
+			entries = append(entries, entry{q.seqs[k], rec})
+			t.Memory.Release(recordSize(rec))
+		}
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].seq < entries[j].seq })
+	out := make([]*zng.Record, len(entries))
+	for k, e := range entries {
+		out[k] = e.rec
+	}
+	t.tables = make(map[string]*tailQueue)
+	t.nrecs = 0
+	return zbuf.NewArray(out, nano.NewSpanTs(t.MinTs, t.MaxTs))
+}
+
+// Buffered returns the number of records held in the queues of all groups.
+func (t *TailBy) Buffered() int {
+	return t.nrecs
+}
+
+func (t *TailBy) Pull() (zbuf.Batch, error) {
+	for {
+		batch, err := t.Get()
+		if err != nil {
+			return nil, err
+		}
+		if batch == nil {
+			return t.tail(), nil
+		}
+		for k := 0; k < batch.Length(); k++ {
+			if err := t.consume(batch.Index(k)); err != nil {
+				batch.Unref()
+				return nil, err
+			}
+		}
+		batch.Unref()
+	}
+}
@@ -0,0 +1,39 @@
+package proc_test
+
+import (
+	"testing"
+
+	"github.com/brimsec/zq/proc"
+)
+
+const tailIn = `
+#0:record[foo:uint64]
+0:[1;]
+0:[2;]
+0:[3;]
+0:[4;]
+0:[5;]
+`
+
+func TestTail(t *testing.T) {
+	const out = `
+#0:record[foo:uint64]
+0:[4;]
+0:[5;]
+`
+	proc.TestOneProc(t, tailIn, out, "tail 2")
+}
+
+func TestTailFewerRecords(t *testing.T) {
+	// A tail longer than its input outputs every record in order
+	// instead of padding its output with nil records.
+	const out = `
+#0:record[foo:uint64]
+0:[1;]
+0:[2;]
+0:[3;]
+0:[4;]
+0:[5;]
+`
+	proc.TestOneProc(t, tailIn, out, "tail 10")
+}
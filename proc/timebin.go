@@ -0,0 +1,170 @@
+package proc
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/brimsec/zq/ast"
+	"github.com/brimsec/zq/pkg/nano"
+)
+
+// A timeBinner assigns record timestamps to the time bins of a time-binned
+// group-by.  Each bin is identified by its start time.
+type timeBinner interface {
+	// bins appends to dst the start times, in increasing order, of each
+	// bin containing ts and returns the extended slice.
+	bins(dst []nano.Ts, ts nano.Ts) []nano.Ts
+	// end returns the (exclusive) end of the bin starting at start.
+	end(start nano.Ts) nano.Ts
+}
+
+// newTimeBinner returns the timeBinner for a group-by with the given
+// duration and window, or nil if the group-by is not time-binned.
+func newTimeBinner(duration ast.Duration, window ast.TimeWindow) (timeBinner, error) {
+	dur := duration.Nanoseconds
+	if dur < 0 {
+		return nil, errors.New("time window duration cannot be negative")
+	}
+	switch window.Kind {
+	case "", ast.WindowTumbling:
+		if dur == 0 {
+			return nil, nil
+		}
+		return &tumblingBinner{dur}, nil
+	case ast.WindowSliding:
+		slide := window.Slide.Nanoseconds
+		if dur == 0 || slide <= 0 {
+			return nil, errors.New("sliding time window requires a positive duration and slide")
+		}
+		return &slidingBinner{dur, slide}, nil
+	case ast.WindowCalendar:
+		return newCalendarBinner(window)
+	}
+	return nil, fmt.Errorf("unknown time window kind: %s", window.Kind)
+}
+
+// tumblingBinner partitions time into consecutive bins of equal duration.
+type tumblingBinner struct {
+	dur int64
+}
+
+func (t *tumblingBinner) bins(dst []nano.Ts, ts nano.Ts) []nano.Ts {
+	return append(dst, ts.Trunc(t.dur))
+}
+
+func (t *tumblingBinner) end(start nano.Ts) nano.Ts {
+	return start.Add(t.dur)
+}
+
+// slidingBinner divides time into bins of equal duration that begin at
+// every multiple of the slide interval.  When the slide is smaller than
+// the duration, the bins overlap and each timestamp falls into several
+// bins.
+type slidingBinner struct {
+	dur   int64
+	slide int64
+}
+
+func (s *slidingBinner) bins(dst []nano.Ts, ts nano.Ts) []nano.Ts {
+	first := len(dst)
+	for b := ts.Trunc(s.slide); b.Add(s.dur) > ts; b = b.Sub(s.slide) {
+		dst = append(dst, b)
+	}
+	// The bins were appended latest first so reverse them.
+	for i, j := first, len(dst)-1; i < j; i, j = i+1, j-1 {
+		dst[i], dst[j] = dst[j], dst[i]
+	}
+	return dst
+}
+
+func (s *slidingBinner) end(start nano.Ts) nano.Ts {
+	return start.Add(s.dur)
+}
+
+// calendarBinner divides time into bins spanning a number of calendar
+// days, weeks, months, or years in a particular time zone.  Multi-unit
+// bins are aligned to multiples of the unit counted from the Unix epoch
+// (or from year zero for years) and weeks begin on Monday.
+type calendarBinner struct {
+	unit  string
+	count int
+	loc   *time.Location
+}
+
+func newCalendarBinner(window ast.TimeWindow) (*calendarBinner, error) {
+	switch window.Unit {
+	case "day", "week", "month", "year":
+	default:
+		return nil, fmt.Errorf("unknown calendar unit: %s", window.Unit)
+	}
+	count := window.Count
+	if count == 0 {
+		count = 1
+	}
+	if count < 0 {
+		return nil, errors.New("calendar time window count cannot be negative")
+	}
+	loc := time.UTC
+	if window.TimeZone != "" {
+		var err error
+		loc, err = time.LoadLocation(window.TimeZone)
+		if err != nil {
+			return nil, fmt.Errorf("calendar time window: %w", err)
+		}
+	}
+	return &calendarBinner{window.Unit, count, loc}, nil
+}
+
+// floorMod returns x modulo n rounded toward negative infinity.
+func floorMod(x, n int) int {
+	m := x % n
+	if m < 0 {
+		m += n
+	}
+	return m
+}
+
+// epochMonday is the day number, counted from 1970-01-01, of the first
+// Monday after the Unix epoch.
+const epochMonday = 4
+
+func (c *calendarBinner) bins(dst []nano.Ts, ts nano.Ts) []nano.Ts {
+	t := ts.Time().In(c.loc)
+	year, month, day := t.Date()
+	var start time.Time
+	switch c.unit {
+	case "day", "week":
+		// Count days in the local calendar so that the count is not
+		// disturbed by daylight saving time transitions.
+		n := int(time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Unix() / 86400)
+		if c.unit == "day" {
+			n -= floorMod(n, c.count)
+		} else {
+			n -= floorMod(n-epochMonday, 7*c.count)
+		}
+		start = time.Date(1970, 1, 1+n, 0, 0, 0, 0, c.loc)
+	case "month":
+		n := year*12 + int(month) - 1
+		n -= floorMod(n, c.count)
+		start = time.Date(n/12, time.Month(n%12+1), 1, 0, 0, 0, 0, c.loc)
+	case "year":
+		start = time.Date(year-floorMod(year, c.count), 1, 1, 0, 0, 0, 0, c.loc)
+	}
+	return append(dst, nano.TimeToTs(start))
+}
+
+func (c *calendarBinner) end(start nano.Ts) nano.Ts {
+	t := start.Time().In(c.loc)
+	switch c.unit {
+	case "day":
+		t = t.AddDate(0, 0, c.count)
+	case "week":
+		t = t.AddDate(0, 0, 7*c.count)
+	case "month":
+		t = t.AddDate(0, c.count, 0)
+	case "year":
+		t = t.AddDate(c.count, 0, 0)
+	}
+	return nano.TimeToTs(t)
+}
@@ -37,6 +37,14 @@ func NewTop(c *Context, parent Proc, limit int, fields []expr.FieldExprResolver,
 	}
 }
 
+// Buffered returns the number of records held in the heap.
+func (t *Top) Buffered() int {
+	if t.records == nil {
+		return 0
+	}
+	return t.records.Len()
+}
+
 func (t *Top) Pull() (zbuf.Batch, error) {
 	for {
 		batch, err := t.Get()
@@ -47,7 +55,10 @@ func (t *Top) Pull() (zbuf.Batch, error) {
 			return t.sorted(), nil
 		}
 		for k := 0; k < batch.Length(); k++ {
-			t.consume(batch.Index(k))
+			if err := t.consume(batch.Index(k)); err != nil {
+				batch.Unref()
+				return nil, err
+			}
 		}
 		batch.Unref()
 		if t.flushEvery {
@@ -56,7 +67,7 @@ func (t *Top) Pull() (zbuf.Batch, error) {
 	}
 }
 
-func (t *Top) consume(rec *zng.Record) {
+func (t *Top) consume(rec *zng.Record) error {
 	if t.fields == nil {
 		fld := guessSortField(rec)
 		resolver := func(r *zng.Record) zng.Value {
@@ -74,11 +85,16 @@ func (t *Top) consume(rec *zng.Record) {
 		heap.Init(t.records)
 	}
 	if t.records.Len() < t.limit || t.sorter(t.records.Index(0), rec) < 0 {
-		heap.Push(t.records, rec.Keep())
+		rec = rec.Keep()
+		if err := t.Memory.Charge(recordSize(rec)); err != nil {
+			return err
+		}
+		heap.Push(t.records, rec)
 	}
 	if t.records.Len() > t.limit {
-		heap.Pop(t.records)
+		t.Memory.Release(recordSize(heap.Pop(t.records).(*zng.Record)))
 	}
+	return nil
 }
 
 func (t *Top) sorted() zbuf.Batch {
@@ -88,6 +104,7 @@ func (t *Top) sorted() zbuf.Batch {
 	out := make([]*zng.Record, t.records.Len())
 	for i := t.records.Len() - 1; i >= 0; i-- {
 		rec := heap.Pop(t.records).(*zng.Record)
+		t.Memory.Release(recordSize(rec))
 		out[i] = rec
 	}
 	// clear records
@@ -2,6 +2,7 @@ package proc
 
 import (
 	"bytes"
+	"container/list"
 
 	"github.com/brimsec/zq/pkg/nano"
 	"github.com/brimsec/zq/zbuf"
@@ -72,3 +73,73 @@ func (u *Uniq) Pull() (zbuf.Batch, error) {
 	}
 	return zbuf.NewArray(out, span), nil
 }
+
+const defaultUniqLimit = 1000000
+
+// UniqBy discards any record whose group-by key values match those of a
+// previously transmitted record.  Keys are remembered in a least-recently-used
+// cache bounded by limit so that a duplicate is detected only if its key was
+// seen among the most recent limit distinct keys.  Records that do not have
+// all of the keys are passed through unchanged.
+type UniqBy struct {
+	Base
+	keyMaker *keyMaker
+	limit    int
+	lru      *list.List
+	seen     map[string]*list.Element
+}
+
+func NewUniqBy(c *Context, parent Proc, keyMaker *keyMaker, limit int) *UniqBy {
+	if limit == 0 {
+		limit = defaultUniqLimit
+	}
+	return &UniqBy{
+		Base:     Base{Context: c, Parent: parent},
+		keyMaker: keyMaker,
+		limit:    limit,
+		lru:      list.New(),
+		seen:     make(map[string]*list.Element),
+	}
+}
+
+// isDup returns true if the key of r has been seen recently and otherwise
+// remembers it, evicting the least recently seen key if the cache is full.
+func (u *UniqBy) isDup(r *zng.Record) bool {
+	keyCols, keyBytes := u.keyMaker.lookup(r)
+	if keyCols.columns == nil {
+		return false
+	}
+	if e, ok := u.seen[string(keyBytes)]; ok {
+		u.lru.MoveToFront(e)
+		return true
+	}
+	if u.lru.Len() >= u.limit {
+		oldest := u.lru.Back()
+		delete(u.seen, oldest.Value.(string))
+		u.lru.Remove(oldest)
+	}
+	key := string(keyBytes)
+	u.seen[key] = u.lru.PushFront(key)
+	return false
+}
+
+// Buffered returns the number of keys remembered in the cache.
+func (u *UniqBy) Buffered() int {
+	return u.lru.Len()
+}
+
+func (u *UniqBy) Pull() (zbuf.Batch, error) {
+	batch, err := u.Get()
+	if EOS(batch, err) {
+		return nil, err
+	}
+	defer batch.Unref()
+	out := make([]*zng.Record, 0, batch.Length())
+	for k := 0; k < batch.Length(); k++ {
+		r := batch.Index(k)
+		if !u.isDup(r) {
+			out = append(out, r.Keep())
+		}
+	}
+	return zbuf.NewArray(out, batch.Span()), nil
+}
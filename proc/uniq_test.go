@@ -0,0 +1,73 @@
+package proc_test
+
+import (
+	"testing"
+
+	"github.com/brimsec/zq/proc"
+)
+
+const uniqByIn = `
+#0:record[uid:string,n:int32]
+0:[a;1;]
+0:[b;2;]
+0:[a;3;]
+0:[c;4;]
+0:[b;5;]
+#1:record[n:int32]
+1:[6;]
+`
+
+func TestUniqBy(t *testing.T) {
+	const out = `
+#0:record[uid:string,n:int32]
+0:[a;1;]
+0:[b;2;]
+0:[c;4;]
+#1:record[n:int32]
+1:[6;]
+`
+	proc.TestOneProc(t, uniqByIn, out, "uniq -by uid")
+
+	// With room for only one key, a key evicted from the cache
+	// is no longer detected as a duplicate.
+	const outLimit = `
+#0:record[uid:string,n:int32]
+0:[a;1;]
+0:[b;2;]
+0:[a;3;]
+0:[c;4;]
+0:[b;5;]
+#1:record[n:int32]
+1:[6;]
+`
+	proc.TestOneProc(t, uniqByIn, outLimit, "uniq -by uid -limit 1")
+}
+
+func TestHeadTailBy(t *testing.T) {
+	const in = `
+#0:record[uid:string,n:int32]
+0:[a;1;]
+0:[b;2;]
+0:[a;3;]
+0:[a;4;]
+0:[c;5;]
+0:[b;6;]
+`
+	const headOut = `
+#0:record[uid:string,n:int32]
+0:[a;1;]
+0:[b;2;]
+0:[a;3;]
+0:[c;5;]
+0:[b;6;]
+`
+	proc.TestOneProc(t, in, headOut, "head 2 by uid")
+
+	const tailOut = `
+#0:record[uid:string,n:int32]
+0:[a;4;]
+0:[c;5;]
+0:[b;6;]
+`
+	proc.TestOneProc(t, in, tailOut, "tail 1 by uid")
+}
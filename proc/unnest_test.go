@@ -0,0 +1,36 @@
+package proc_test
+
+import (
+	"testing"
+
+	"github.com/brimsec/zq/proc"
+)
+
+const unnestIn = `
+#0:record[ts:time,meta:string]
+0:[1;{"user":{"name":"bob","id":7},"tags":["a","b"],"ok":true};]
+0:[2;not json;]
+0:[3;-;]
+`
+
+func TestUnnest(t *testing.T) {
+	const out = `
+#0:record[ts:time,meta:record[ok:bool,tags:array[string],user:record[id:float64,name:string]]]
+0:[1;[T;[a;b;][7;bob;]]]
+#1:record[ts:time,meta:string]
+1:[2;not json;]
+1:[3;-;]
+`
+	proc.TestOneProc(t, unnestIn, out, "unnest meta")
+}
+
+func TestParseJSON(t *testing.T) {
+	const out = `
+#0:record[ts:time,meta:string,m:record[ok:bool,tags:array[string],user:record[id:float64,name:string]]]
+0:[1;{"user":{"name":"bob","id":7},"tags":["a","b"],"ok":true};[T;[a;b;][7;bob;]]]
+#1:record[ts:time,meta:string]
+1:[2;not json;]
+1:[3;-;]
+`
+	proc.TestOneProc(t, unnestIn, out, "put m = parseJSON(meta)")
+}
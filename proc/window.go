@@ -0,0 +1,478 @@
+package proc
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/brimsec/zq/ast"
+	"github.com/brimsec/zq/expr"
+	"github.com/brimsec/zq/pkg/nano"
+	"github.com/brimsec/zq/reducer"
+	"github.com/brimsec/zq/reducer/compile"
+	"github.com/brimsec/zq/zbuf"
+	"github.com/brimsec/zq/zng"
+	"github.com/brimsec/zq/zngnative"
+)
+
+type windowFunc int
+
+const (
+	windowLag windowFunc = iota
+	windowLead
+	windowDelta
+	windowMovAvg
+	windowReducer
+)
+
+// windowReducers maps the names of the reducers that may be called as
+// window functions to their reducer ops.  When called from a window
+// proc, a reducer computes its running value over the records of the
+// group up to and including the current record.
+var windowReducers = map[string]string{
+	"count":         "Count",
+	"sum":           "Sum",
+	"avg":           "Avg",
+	"min":           "Min",
+	"max":           "Max",
+	"first":         "First",
+	"last":          "Last",
+	"countdistinct": "CountDistinct",
+}
+
+// A windowTerm is a call to a window function appearing in one of the
+// assignments of a window proc.  Each term is evaluated once per record
+// and its value is then referenced by the assignment expressions.
+type windowTerm struct {
+	fn      windowFunc
+	arg     expr.ExpressionEvaluator
+	n       int // Offset for lag and lead, window size for movavg.
+	reducer compile.CompiledReducer
+}
+
+// windowState holds the state of one term for one group of records.
+type windowState struct {
+	// vals holds the n most recent argument values (lag, delta, movavg).
+	vals []zng.Value
+	// pending holds the entries awaiting the value of a lead.
+	pending []*windowEntry
+	red     reducer.Interface
+}
+
+// windowEntry is an input record waiting to be transmitted along with
+// the values of the window terms computed for it.  An entry cannot be
+// transmitted until each of its leads have been resolved.
+type windowEntry struct {
+	rec        *zng.Record
+	vals       []zng.Value
+	unresolved int
+}
+
+// Window transmits each input record augmented with the values of a list
+// of assignments whose expressions may call window functions.  Window
+// function state is kept per group of records sharing the same group-by
+// key values, and records are transmitted in the order received.  Records
+// that do not have all of the keys are transmitted unchanged.  The queued
+// records are charged to the Memory budget of the Context.
+type Window struct {
+	Base
+	keyMaker *keyMaker
+	terms    []windowTerm
+	targets  []string
+	evals    []expr.ExpressionEvaluator
+	groups   map[string][]windowState
+	queue    []*windowEntry
+	cur      []zng.Value // Term values of the entry being transmitted.
+	// contextFuncs compiles calls to functions that are not window
+	// functions but need the type context.
+	contextFuncs expr.FunctionHook
+}
+
+func CompileWindowProc(c *Context, parent Proc, node *ast.WindowProc) (*Window, error) {
+	w := &Window{
+		Base:         Base{Context: c, Parent: parent},
+		groups:       make(map[string][]windowState),
+		contextFuncs: expr.ContextFunctions(c.TypeContext),
+	}
+	if len(node.Keys) > 0 {
+		keyMaker, err := compileKeyMaker(c.TypeContext, node.Keys)
+		if err != nil {
+			return nil, fmt.Errorf("compiling window: %w", err)
+		}
+		w.keyMaker = keyMaker
+	}
+	for _, a := range node.Assignments {
+		eval, err := expr.CompileExprWithHook(a.Expr, w.compileTerm)
+		if err != nil {
+			return nil, fmt.Errorf("compiling window: %w", err)
+		}
+		w.targets = append(w.targets, a.Target)
+		w.evals = append(w.evals, eval)
+	}
+	return w, nil
+}
+
+// compileTerm is the expr.FunctionHook that compiles calls to window
+// functions into references to the corresponding term values.
+func (w *Window) compileTerm(call *ast.FunctionCall) (expr.NativeEvaluator, error) {
+	name := strings.ToLower(call.Function)
+	var term windowTerm
+	switch name {
+	case "lag", "lead":
+		if len(call.Args) < 1 || len(call.Args) > 2 {
+			return nil, fmt.Errorf("%s: expected an expression and an optional offset", name)
+		}
+		term.fn = windowLag
+		if name == "lead" {
+			term.fn = windowLead
+		}
+		term.n = 1
+		if len(call.Args) == 2 {
+			n, err := windowCount(call.Args[1])
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", name, err)
+			}
+			term.n = n
+		}
+	case "delta":
+		if len(call.Args) != 1 {
+			return nil, fmt.Errorf("%s: expected one argument", name)
+		}
+		term.fn = windowDelta
+		term.n = 1
+	case "movavg":
+		if len(call.Args) != 2 {
+			return nil, fmt.Errorf("%s: expected an expression and a window size", name)
+		}
+		n, err := windowCount(call.Args[1])
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		term.fn = windowMovAvg
+		term.n = n
+	default:
+		op, ok := windowReducers[name]
+		if !ok {
+			// Not a window function.
+			return w.contextFuncs(call)
+		}
+		node := ast.Reducer{Node: ast.Node{Op: op}}
+		switch len(call.Args) {
+		case 0:
+		case 1:
+			field, ok := windowField(call.Args[0])
+			if !ok {
+				return nil, fmt.Errorf("%s: argument must be a field", name)
+			}
+			node.Field = field
+		default:
+			return nil, fmt.Errorf("%s: expected one argument", name)
+		}
+		red, err := compile.Compile(node)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		term.fn = windowReducer
+		term.reducer = red
+	}
+	if term.fn != windowReducer {
+		arg, err := expr.CompileExpr(call.Args[0])
+		if err != nil {
+			return nil, err
+		}
+		term.arg = arg
+	}
+	k := len(w.terms)
+	w.terms = append(w.terms, term)
+	return func(*zng.Record) (zngnative.Value, error) {
+		v := w.cur[k]
+		if v.Type == nil || v.Bytes == nil {
+			return zngnative.Value{}, expr.ErrNoSuchField
+		}
+		return zngnative.ToNativeValue(v)
+	}, nil
+}
+
+// windowCount returns the value of an expression that must be a
+// positive integer literal.
+func windowCount(e ast.Expression) (int, error) {
+	lit, ok := e.(*ast.Literal)
+	if !ok || lit.Type != "int64" {
+		return 0, fmt.Errorf("%w: expected an integer", expr.ErrBadArgument)
+	}
+	v, err := zng.Parse(*lit)
+	if err != nil {
+		return 0, err
+	}
+	n, ok := zngnative.CoerceToInt(v)
+	if !ok || n <= 0 {
+		return 0, fmt.Errorf("%w: expected a positive integer", expr.ErrBadArgument)
+	}
+	return int(n), nil
+}
+
+// windowField converts a field reference parsed as an expression (e.g.,
+// "a" or "a.b") into the field expression expected by a reducer.
+func windowField(e ast.Expression) (ast.FieldExpr, bool) {
+	switch e := e.(type) {
+	case *ast.FieldRead:
+		return e, true
+	case *ast.BinaryExpression:
+		if e.Operator != "." {
+			return nil, false
+		}
+		lhs, ok := windowField(e.LHS)
+		if !ok {
+			return nil, false
+		}
+		rhs, ok := e.RHS.(*ast.Literal)
+		if !ok || rhs.Type != "string" {
+			return nil, false
+		}
+		return &ast.FieldCall{
+			Node:  ast.Node{Op: "FieldCall"},
+			Fn:    "RecordFieldRead",
+			Field: lhs,
+			Param: rhs.Value,
+		}, true
+	}
+	return nil, false
+}
+
+func (w *Window) evalArg(term windowTerm, r *zng.Record) zng.Value {
+	v, err := term.arg(r)
+	if err != nil {
+		return zng.Value{}
+	}
+	return v
+}
+
+// lookupGroup returns the term states of the group of r or nil if r
+// does not have all of the group-by keys.
+func (w *Window) lookupGroup(r *zng.Record) ([]windowState, error) {
+	var key string
+	if w.keyMaker != nil {
+		keyCols, keyBytes := w.keyMaker.lookup(r)
+		if keyCols.columns == nil {
+			return nil, nil
+		}
+		key = string(keyBytes)
+	}
+	states, ok := w.groups[key]
+	if !ok {
+		if len(w.groups) >= defaultGroupByLimit {
+			return nil, errTooBig(defaultGroupByLimit)
+		}
+		states = make([]windowState, len(w.terms))
+		w.groups[key] = states
+	}
+	return states, nil
+}
+
+func (w *Window) consume(r *zng.Record) error {
+	r = r.Keep()
+	states, err := w.lookupGroup(r)
+	if err != nil {
+		return err
+	}
+	if err := w.Memory.Charge(recordSize(r)); err != nil {
+		return err
+	}
+	if states == nil {
+		w.queue = append(w.queue, &windowEntry{rec: r})
+		return nil
+	}
+	entry := &windowEntry{rec: r, vals: make([]zng.Value, len(w.terms))}
+	for k, term := range w.terms {
+		state := &states[k]
+		switch term.fn {
+		case windowLag:
+			if len(state.vals) == term.n {
+				entry.vals[k] = state.vals[0]
+			}
+			state.push(w.evalArg(term, r), term.n)
+		case windowDelta:
+			v := w.evalArg(term, r)
+			if len(state.vals) > 0 {
+				entry.vals[k] = windowSubtract(v, state.vals[0])
+			}
+			state.push(v, 1)
+		case windowMovAvg:
+			state.push(w.evalArg(term, r), term.n)
+			entry.vals[k] = windowAverage(state.vals)
+		case windowReducer:
+			if state.red == nil {
+				state.red = term.reducer.Instantiate(r)
+			}
+			state.red.Consume(r)
+			entry.vals[k] = state.red.Result()
+		case windowLead:
+			v := w.evalArg(term, r)
+			if len(state.pending) == term.n {
+				p := state.pending[0]
+				p.vals[k] = v
+				p.unresolved--
+				state.pending = state.pending[1:]
+			}
+			state.pending = append(state.pending, entry)
+			entry.unresolved++
+		}
+	}
+	w.queue = append(w.queue, entry)
+	return nil
+}
+
+// push appends v to the list of recent values keeping at most n values.
+func (s *windowState) push(v zng.Value, n int) {
+	if len(s.vals) == n {
+		s.vals = s.vals[1:]
+	}
+	s.vals = append(s.vals, v)
+}
+
+// windowSubtract returns the difference between two numeric values.
+// The difference between two times is given as a float64 number of
+// seconds so that it may be used as the denominator of a rate.
+func windowSubtract(a, b zng.Value) zng.Value {
+	if a.Type == nil || b.Type == nil || a.Bytes == nil || b.Bytes == nil {
+		return zng.Value{}
+	}
+	switch a.Type.ID() {
+	case zng.IdTime:
+		at, ok1 := zngnative.CoerceToTime(a)
+		bt, ok2 := zngnative.CoerceToTime(b)
+		if !ok1 || !ok2 {
+			return zng.Value{}
+		}
+		return zng.NewFloat64(float64(at-bt) / 1e9)
+	case zng.IdDuration:
+		ad, ok1 := zngnative.CoerceToDuration(a)
+		bd, ok2 := zngnative.CoerceToDuration(b)
+		if !ok1 || !ok2 {
+			return zng.Value{}
+		}
+		return zng.NewDuration(ad - bd)
+	case zng.IdFloat64:
+		af, ok1 := zngnative.CoerceToFloat64(a)
+		bf, ok2 := zngnative.CoerceToFloat64(b)
+		if !ok1 || !ok2 {
+			return zng.Value{}
+		}
+		return zng.NewFloat64(af - bf)
+	case zng.IdByte, zng.IdUint16, zng.IdUint32, zng.IdUint64:
+		au, ok1 := zngnative.CoerceToUint(a)
+		bu, ok2 := zngnative.CoerceToUint(b)
+		if !ok1 || !ok2 {
+			return zng.Value{}
+		}
+		return zng.NewInt64(int64(au - bu))
+	case zng.IdInt16, zng.IdInt32, zng.IdInt64:
+		ai, ok1 := zngnative.CoerceToInt(a)
+		bi, ok2 := zngnative.CoerceToInt(b)
+		if !ok1 || !ok2 {
+			return zng.Value{}
+		}
+		return zng.NewInt64(ai - bi)
+	}
+	return zng.Value{}
+}
+
+// windowAverage returns the average of the numeric values in vals
+// ignoring any values that are unset or not numeric.
+func windowAverage(vals []zng.Value) zng.Value {
+	var sum float64
+	var n int
+	for _, v := range vals {
+		if v.Type == nil || v.Bytes == nil {
+			continue
+		}
+		f, ok := zngnative.CoerceToFloat64(v)
+		if !ok {
+			continue
+		}
+		sum += f
+		n++
+	}
+	if n == 0 {
+		return zng.Value{}
+	}
+	return zng.NewFloat64(sum / float64(n))
+}
+
+// wrap returns the record of entry augmented with the assignment values.
+// An assignment whose value cannot be computed, e.g., because a lag
+// refers to a record before the first record of the group, is given
+// a null value.
+func (w *Window) wrap(entry *windowEntry) (*zng.Record, error) {
+	if entry.vals == nil {
+		return entry.rec, nil
+	}
+	w.cur = entry.vals
+	cols := make([]zng.Column, len(w.evals))
+	vals := make([]zng.Value, len(w.evals))
+	for k, eval := range w.evals {
+		v, err := eval(entry.rec)
+		if err != nil || v.Type == nil {
+			v = zng.Value{Type: zng.TypeNull}
+		}
+		cols[k] = zng.NewColumn(w.targets[k], v.Type)
+		vals[k] = v
+	}
+	return w.TypeContext.AddColumns(entry.rec, cols, vals)
+}
+
+// flush returns the records at the head of the queue whose leads have
+// all been resolved.  At EOS, any unresolved leads are left unset and
+// all of the queued records are returned.
+func (w *Window) flush(eos bool) ([]*zng.Record, error) {
+	var out []*zng.Record
+	for len(w.queue) > 0 {
+		entry := w.queue[0]
+		if entry.unresolved > 0 && !eos {
+			break
+		}
+		rec, err := w.wrap(entry)
+		if err != nil {
+			return nil, fmt.Errorf("window: %w", err)
+		}
+		out = append(out, rec)
+		w.queue = w.queue[1:]
+		w.Memory.Release(recordSize(entry.rec))
+	}
+	return out, nil
+}
+
+// Buffered returns the number of records queued awaiting their leads.
+func (w *Window) Buffered() int {
+	return len(w.queue)
+}
+
+func (w *Window) Pull() (zbuf.Batch, error) {
+	for {
+		batch, err := w.Get()
+		if err != nil {
+			return nil, err
+		}
+		if batch == nil {
+			out, err := w.flush(true)
+			if err != nil || len(out) == 0 {
+				return nil, err
+			}
+			return zbuf.NewArray(out, nano.NewSpanTs(w.MinTs, w.MaxTs)), nil
+		}
+		for k := 0; k < batch.Length(); k++ {
+			if err := w.consume(batch.Index(k)); err != nil {
+				batch.Unref()
+				return nil, err
+			}
+		}
+		span := batch.Span()
+		batch.Unref()
+		out, err := w.flush(false)
+		if err != nil {
+			return nil, err
+		}
+		if len(out) > 0 {
+			return zbuf.NewArray(out, span), nil
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package proc_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/brimsec/zq/pkg/nano"
+	"github.com/brimsec/zq/proc"
+	"github.com/brimsec/zq/zbuf"
+	"github.com/brimsec/zq/zio/detector"
+	"github.com/brimsec/zq/zng"
+	"github.com/brimsec/zq/zng/resolver"
+	"github.com/stretchr/testify/require"
+)
+
+const windowIn = `
+#0:record[host:string,ts:time,bytes:int64]
+0:[a;1;10;]
+0:[b;2;100;]
+0:[a;3;30;]
+0:[a;5;70;]
+0:[b;6;300;]
+`
+
+func TestWindowLagDelta(t *testing.T) {
+	const out = `
+#0:record[host:string,ts:time,bytes:int64,prev:null,rate:null]
+0:[a;1;10;-;-;]
+#1:record[host:string,ts:time,bytes:int64,prev:null,rate:null]
+1:[b;2;100;-;-;]
+#2:record[host:string,ts:time,bytes:int64,prev:int64,rate:float64]
+2:[a;3;30;10;10;]
+2:[a;5;70;30;20;]
+2:[b;6;300;100;50;]
+`
+	proc.TestOneProc(t, windowIn, out, "window prev=lag(bytes), rate=delta(bytes)/delta(ts) by host")
+}
+
+func parseBatch(t *testing.T, zctx *resolver.Context, src string) zbuf.Batch {
+	reader, err := detector.LookupReader("zng", strings.NewReader(src), zctx)
+	require.NoError(t, err)
+	var recs []*zng.Record
+	for {
+		rec, err := reader.Read()
+		require.NoError(t, err)
+		if rec == nil {
+			break
+		}
+		recs = append(recs, rec)
+	}
+	return zbuf.NewArray(recs, nano.MaxSpan)
+}
+
+func TestWindowLeadRunning(t *testing.T) {
+	// A record with a lead is held back until the next record of its
+	// group arrives, so the last record of each group is transmitted
+	// only at EOS.
+	const out1 = `
+#0:record[host:string,ts:time,bytes:int64,next:int64,total:int64,avg:float64]
+0:[a;1;10;30;10;10;]
+0:[b;2;100;300;100;100;]
+0:[a;3;30;70;40;20;]
+`
+	const out2 = `
+#1:record[host:string,ts:time,bytes:int64,next:null,total:int64,avg:float64]
+1:[a;5;70;-;110;50;]
+1:[b;6;300;-;400;200;]
+`
+	zctx := resolver.NewContext()
+	in := parseBatch(t, zctx, windowIn)
+	test, err := proc.NewProcTestFromSource("window next=lead(bytes), total=sum(bytes), avg=movavg(bytes, 2) by host", zctx, []zbuf.Batch{in})
+	require.NoError(t, err)
+	require.NoError(t, test.Expect(parseBatch(t, zctx, out1)))
+	require.NoError(t, test.Expect(parseBatch(t, zctx, out2)))
+	require.NoError(t, test.ExpectEOS())
+	require.NoError(t, test.Finish())
+}
@@ -2,9 +2,14 @@ package scanner
 
 import (
 	"errors"
+	"io"
 	"os"
 
+	"github.com/brimsec/zq/ast"
+	"github.com/brimsec/zq/pkg/nano"
+	"github.com/brimsec/zq/pkg/slicer"
 	"github.com/brimsec/zq/zbuf"
+	"github.com/brimsec/zq/zio/bzngio"
 	"github.com/brimsec/zq/zio/detector"
 	"github.com/brimsec/zq/zng/resolver"
 )
@@ -39,6 +44,94 @@ func OpenFile(zctx *resolver.Context, path, ifmt string) (*File, error) {
 	return &File{zr, f}, nil
 }
 
+// OpenBzngFile opens the bzng file at path to read the records within
+// span that may match filter, which may be nil.  If span is not empty or
+// filter compares values in a way that a zbuf.Pruner can rule out, and the
+// file has an up-to-date index at bzngio.IndexPath(path), only the slices
+// of the file that the index finds for span and filter are read.
+// Otherwise, the whole file is read.
+func OpenBzngFile(zctx *resolver.Context, path string, span nano.Span, filter ast.BooleanExpr) (*File, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	r, err := sliceBzng(f, path, span, zbuf.NewPruner(filter))
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	return &File{bzngio.NewReader(r, zctx), f}, nil
+}
+
+// OpenReverseBzngFile opens the bzng file at path to read its records
+// from last to first with a bzngio.ReverseReader restricted to span.  If
+// the file has an up-to-date index at bzngio.IndexPath(path), the reader
+// takes its blocks from the index and skips those that cannot match
+// filter, which may be nil.  Otherwise, the reader scans the whole file
+// for its blocks.
+func OpenReverseBzngFile(zctx *resolver.Context, path string, span nano.Span, filter ast.BooleanExpr) (*File, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	index, err := loadIndex(f, path)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	r := bzngio.NewReverseReader(f, zctx)
+	r.SetSpan(span)
+	if index != nil {
+		r.SetIndex(index, zbuf.NewPruner(filter))
+	}
+	return &File{r, f}, nil
+}
+
+// sliceBzng returns a reader of the slices of f that hold the records
+// within span that may match the filter of p or f itself if it has no
+// index.
+func sliceBzng(f *os.File, path string, span nano.Span, p *zbuf.Pruner) (io.Reader, error) {
+	if span.Dur == 0 && p == nil {
+		return f, nil
+	}
+	index, err := loadIndex(f, path)
+	if err != nil {
+		return nil, err
+	}
+	if index == nil {
+		return f, nil
+	}
+	return slicer.NewReader(f, index.Slices(span, p))
+}
+
+// loadIndex returns the index of the bzng file f at path or nil if it has
+// none or the size or fingerprint of f shows that it has changed since
+// the index was created.
+func loadIndex(f *os.File, path string) (*bzngio.Index, error) {
+	index, err := bzngio.LoadIndex(bzngio.IndexPath(path))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	if info.Size() != index.Size {
+		return nil, nil
+	}
+	fp, err := bzngio.Fingerprint(f, info.Size())
+	if err != nil {
+		return nil, err
+	}
+	if fp != index.Fingerprint {
+		return nil, nil
+	}
+	return index, nil
+}
+
 func (r *File) Close() error {
 	return r.file.Close()
 }
@@ -0,0 +1,130 @@
+package scanner
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/brimsec/zq/pkg/nano"
+	"github.com/brimsec/zq/zbuf"
+	"github.com/brimsec/zq/zio/bzngio"
+	"github.com/brimsec/zq/zio/zngio"
+	"github.com/brimsec/zq/zng/resolver"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// bzng returns the records ts=n for each n in ns as a bzng stream.
+func bzng(t *testing.T, ns []int) []byte {
+	var src strings.Builder
+	src.WriteString("#0:record[ts:time]\n")
+	for _, n := range ns {
+		fmt.Fprintf(&src, "0:[%d;]\n", n)
+	}
+	var buf bytes.Buffer
+	r := zngio.NewReader(strings.NewReader(src.String()), resolver.NewContext())
+	require.NoError(t, zbuf.Copy(zbuf.NopFlusher(bzngio.NewWriter(&buf)), r))
+	return buf.Bytes()
+}
+
+func TestOpenBzngFileStaleIndex(t *testing.T) {
+	dir, err := ioutil.TempDir("", "scanner")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "all.bzng")
+
+	var ns []int
+	for n := 1000; n < 2000; n++ {
+		ns = append(ns, n)
+	}
+	b := bzng(t, ns)
+	index, err := bzngio.CreateIndex(bytes.NewReader(b), 100)
+	require.NoError(t, err)
+	idx, err := json.Marshal(index)
+	require.NoError(t, err)
+	require.NoError(t, ioutil.WriteFile(bzngio.IndexPath(path), idx, 0644))
+
+	// Rewrite the file in reverse order, which keeps its size but moves
+	// the records that the index locates.
+	for i, j := 0, len(ns)-1; i < j; i, j = i+1, j-1 {
+		ns[i], ns[j] = ns[j], ns[i]
+	}
+	reversed := bzng(t, ns)
+	require.Len(t, reversed, len(b))
+	require.NoError(t, ioutil.WriteFile(path, reversed, 0644))
+
+	span := nano.NewSpanTs(nano.Unix(1990, 0), nano.Unix(2000, 0))
+	f, err := OpenBzngFile(resolver.NewContext(), path, span, nil)
+	require.NoError(t, err)
+	defer f.Close()
+	var n int
+	for {
+		rec, err := f.Read()
+		require.NoError(t, err)
+		if rec == nil {
+			break
+		}
+		if span.Contains(rec.Ts) {
+			n++
+		}
+	}
+	assert.Equal(t, 10, n)
+}
+
+func TestOpenReverseBzngFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "scanner")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "all.bzng")
+
+	var ns []int
+	for n := 2000; n > 1000; n-- {
+		ns = append(ns, n)
+	}
+	b := bzng(t, ns)
+	require.NoError(t, ioutil.WriteFile(path, b, 0644))
+	index, err := bzngio.CreateIndex(bytes.NewReader(b), 100)
+	require.NoError(t, err)
+	idx, err := json.Marshal(index)
+	require.NoError(t, err)
+	require.NoError(t, ioutil.WriteFile(bzngio.IndexPath(path), idx, 0644))
+
+	read := func() []nano.Ts {
+		span := nano.NewSpanTs(nano.Unix(1990, 0), nano.Unix(2000, 0))
+		f, err := OpenReverseBzngFile(resolver.NewContext(), path, span, nil)
+		require.NoError(t, err)
+		defer f.Close()
+		var ts []nano.Ts
+		for {
+			rec, err := f.Read()
+			require.NoError(t, err)
+			if rec == nil {
+				break
+			}
+			if span.Contains(rec.Ts) {
+				ts = append(ts, rec.Ts)
+			}
+		}
+		return ts
+	}
+	var expected []nano.Ts
+	for n := 1990; n < 2000; n++ {
+		expected = append(expected, nano.Unix(int64(n), 0))
+	}
+	assert.Equal(t, expected, read())
+
+	// A stale index of a file of the same size is ignored.
+	for i, j := 0, len(ns)-1; i < j; i, j = i+1, j-1 {
+		ns[i], ns[j] = ns[j], ns[i]
+	}
+	reversed := bzng(t, ns)
+	require.Len(t, reversed, len(b))
+	require.NoError(t, ioutil.WriteFile(path, reversed, 0644))
+	ts := read()
+	assert.Len(t, ts, len(expected))
+}
@@ -1,6 +1,8 @@
 package scanner
 
 import (
+	"sync"
+
 	"github.com/brimsec/zq/zbuf"
 	"github.com/brimsec/zq/zng"
 	"github.com/brimsec/zq/zng/resolver"
@@ -8,6 +10,8 @@ import (
 
 type Mapper struct {
 	zbuf.Reader
+	// mu protects mapper when chunks are decoded concurrently.
+	mu     sync.Mutex
 	mapper *resolver.Mapper
 }
 
@@ -26,11 +30,50 @@ func (m *Mapper) Read() (*zng.Record, error) {
 	if rec == nil {
 		return nil, nil
 	}
-	id := rec.Type.ID()
+	rec.Type = m.mapType(rec.Type)
+	return rec, nil
+}
+
+func (m *Mapper) mapType(typ *zng.TypeRecord) *zng.TypeRecord {
+	id := typ.ID()
 	sharedType := m.mapper.Map(id)
 	if sharedType == nil {
-		sharedType = m.mapper.Enter(id, rec.Type)
+		sharedType = m.mapper.Enter(id, typ)
 	}
-	rec.Type = sharedType
-	return rec, nil
+	return sharedType
+}
+
+// ReadChunk implements zbuf.Chunker.  The records of a chunk are mapped
+// as it is decoded.
+func (m *Mapper) ReadChunk() (zbuf.Chunk, error) {
+	chunk, err := zbuf.ReadChunk(m.Reader)
+	if chunk == nil || err != nil {
+		return nil, err
+	}
+	return &mappedChunk{chunk, m}, nil
+}
+
+type mappedChunk struct {
+	zbuf.Chunk
+	m *Mapper
+}
+
+func (c *mappedChunk) Decode(p *zbuf.Prefilter) ([]*zng.Record, error) {
+	recs, err := c.Chunk.Decode(p)
+	if err != nil {
+		return nil, err
+	}
+	// Most chunks hold few types so look each up in the Mapper once.
+	types := make(map[*zng.TypeRecord]*zng.TypeRecord)
+	for _, rec := range recs {
+		typ, ok := types[rec.Type]
+		if !ok {
+			c.m.mu.Lock()
+			typ = c.m.mapType(rec.Type)
+			c.m.mu.Unlock()
+			types[rec.Type] = typ
+		}
+		rec.Type = typ
+	}
+	return recs, nil
 }
@@ -0,0 +1,194 @@
+package scanner
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+
+	"github.com/brimsec/zq/filter"
+	"github.com/brimsec/zq/pkg/nano"
+	"github.com/brimsec/zq/proc"
+	"github.com/brimsec/zq/zbuf"
+	"github.com/brimsec/zq/zng"
+)
+
+// ParallelScanner is a Scanner that decodes and filters the records of
+// its reader on several goroutines.  The reader is split into chunks by
+// zbuf.ReadChunk, which are handed out to the workers, and the matching
+// records of each chunk are output as a batch in the order the chunks
+// were read, so the records come out in the same order as they would
+// from a Scanner.
+type ParallelScanner struct {
+	reader    zbuf.Reader
+	filter    filter.Filter
+	prefilter *zbuf.Prefilter
+	span      nano.Span
+	workers   int
+	ctx       context.Context
+	once      sync.Once
+	stop      sync.Once
+	// stopped is set to 1 when the deadline of ctx ends the scan.
+	stopped int32
+	// pending holds the result channels of chunks in the order they
+	// were read.
+	pending chan chan result
+	done    chan struct{}
+}
+
+type job struct {
+	chunk zbuf.Chunk
+	out   chan<- result
+}
+
+type result struct {
+	batch zbuf.Batch
+	err   error
+}
+
+// NewParallelScanner returns a ParallelScanner that runs f on workers
+// goroutines.  Since f is called concurrently, it must not modify shared
+// state, which is true of a filter.Filter returned by filter.Compile.
+func NewParallelScanner(reader zbuf.Reader, f filter.Filter, workers int) *ParallelScanner {
+	if workers < 1 {
+		workers = 1
+	}
+	return &ParallelScanner{
+		reader:  reader,
+		filter:  f,
+		workers: workers,
+		pending: make(chan chan result, 2*workers),
+		done:    make(chan struct{}),
+	}
+}
+
+func (s *ParallelScanner) SetSpan(span nano.Span) {
+	s.span = span
+}
+
+// SetPrefilter sets a Prefilter derived from the scanner's filter with
+// which chunks are decoded.
+func (s *ParallelScanner) SetPrefilter(p *zbuf.Prefilter) {
+	s.prefilter = p
+}
+
+// SetContext sets a context whose cancellation stops the reading of
+// chunks as with Scanner.SetContext.
+func (s *ParallelScanner) SetContext(ctx context.Context) {
+	s.ctx = ctx
+}
+
+// Stopped returns true if the deadline of the scanner's context ended the
+// scan before the end of its reader as with Scanner.Stopped.
+func (s *ParallelScanner) Stopped() bool {
+	return atomic.LoadInt32(&s.stopped) != 0
+}
+
+func (s *ParallelScanner) Pull() (zbuf.Batch, error) {
+	s.once.Do(s.start)
+	for {
+		select {
+		case <-s.done:
+			return nil, nil
+		default:
+		}
+		var ch chan result
+		select {
+		case ch = <-s.pending:
+		case <-s.done:
+			return nil, nil
+		}
+		if ch == nil {
+			return nil, nil
+		}
+		r := <-ch
+		if r.err != nil || r.batch != nil {
+			return r.batch, r.err
+		}
+	}
+}
+
+func (s *ParallelScanner) start() {
+	jobs := make(chan job)
+	for k := 0; k < s.workers; k++ {
+		go s.work(jobs)
+	}
+	go s.read(jobs)
+}
+
+// read reads chunks until the end of the reader, an error, a call to
+// Done, or the cancellation of the scanner's context and queues each for
+// a worker.
+func (s *ParallelScanner) read(jobs chan<- job) {
+	defer close(s.pending)
+	defer close(jobs)
+	for {
+		stop, err := stopped(s.ctx)
+		var chunk zbuf.Chunk
+		if !stop {
+			chunk, err = zbuf.ReadChunk(s.reader)
+		} else if err == nil {
+			atomic.StoreInt32(&s.stopped, 1)
+		}
+		if chunk == nil && err == nil {
+			return
+		}
+		ch := make(chan result, 1)
+		select {
+		case s.pending <- ch:
+		case <-s.done:
+			return
+		}
+		if err != nil {
+			ch <- result{err: err}
+			return
+		}
+		jobs <- job{chunk, ch}
+	}
+}
+
+func (s *ParallelScanner) work(jobs <-chan job) {
+	for j := range jobs {
+		batch, err := s.scan(j.chunk)
+		j.out <- result{batch, err}
+	}
+}
+
+// scan decodes chunk and returns a batch of its records that match the
+// scanner's filter and span or nil if none match.
+func (s *ParallelScanner) scan(chunk zbuf.Chunk) (zbuf.Batch, error) {
+	recs, err := chunk.Decode(s.prefilter)
+	if err != nil {
+		return nil, err
+	}
+	minTs, maxTs := nano.MaxTs, nano.MinTs
+	var arr []*zng.Record
+	for _, rec := range recs {
+		if s.filter != nil && !s.filter(rec) {
+			continue
+		}
+		if s.span.Dur != 0 && !s.span.Contains(rec.Ts) {
+			continue
+		}
+		if rec.Ts < minTs {
+			minTs = rec.Ts
+		}
+		if rec.Ts > maxTs {
+			maxTs = rec.Ts
+		}
+		arr = append(arr, rec)
+	}
+	if arr == nil {
+		return nil, nil
+	}
+	return zbuf.NewArray(arr, nano.NewSpanTs(minTs, maxTs)), nil
+}
+
+// Done stops the reading of chunks.  Chunks already handed to workers
+// are finished and dropped.
+func (s *ParallelScanner) Done() {
+	s.stop.Do(func() { close(s.done) })
+}
+
+// Parents is required to implement proc.Proc interface.  Like Scanner,
+// a ParallelScanner is always the head of a flowgraph.
+func (s *ParallelScanner) Parents() []proc.Proc { return nil }
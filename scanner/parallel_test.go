@@ -0,0 +1,203 @@
+package scanner
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/brimsec/zq/ast"
+	"github.com/brimsec/zq/filter"
+	"github.com/brimsec/zq/pkg/nano"
+	"github.com/brimsec/zq/proc"
+	"github.com/brimsec/zq/zbuf"
+	"github.com/brimsec/zq/zio/bzngio"
+	"github.com/brimsec/zq/zio/detector"
+	"github.com/brimsec/zq/zio/zngio"
+	"github.com/brimsec/zq/zng/resolver"
+	"github.com/brimsec/zq/zql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// scan returns the records output by p as tzng.
+func scan(t *testing.T, p proc.Proc) string {
+	var out bytes.Buffer
+	w := zngio.NewWriter(&out)
+	for {
+		batch, err := p.Pull()
+		require.NoError(t, err)
+		if batch == nil {
+			return out.String()
+		}
+		for k := 0; k < batch.Length(); k++ {
+			require.NoError(t, w.Write(batch.Index(k)))
+		}
+	}
+}
+
+// testParallel checks that a ParallelScanner outputs the same records as
+// a Scanner for the given input, format, filter, and span and returns
+// them.
+func testParallel(t *testing.T, format, input, query string, span nano.Span) string {
+	var f filter.Filter
+	var prefilter *zbuf.Prefilter
+	if query != "" {
+		p, err := zql.ParseProc(query)
+		require.NoError(t, err)
+		e := p.(*ast.FilterProc).Filter
+		f, err = filter.Compile(e)
+		require.NoError(t, err)
+		prefilter = zbuf.NewPrefilter(e)
+	}
+	open := func() zbuf.Reader {
+		r, err := detector.LookupReader(format, strings.NewReader(input), resolver.NewContext())
+		require.NoError(t, err)
+		return r
+	}
+	s := NewScanner(open(), f)
+	s.SetSpan(span)
+	expected := scan(t, s)
+	for _, workers := range []int{1, 3, 8} {
+		ps := NewParallelScanner(open(), f, workers)
+		ps.SetSpan(span)
+		ps.SetPrefilter(prefilter)
+		assert.Equal(t, expected, scan(t, ps), "format %s, query %q, %d workers", format, query, workers)
+	}
+	return expected
+}
+
+func TestParallelScanner(t *testing.T) {
+	// Enough records for several chunks with a change of descriptor in
+	// the middle of one and some values escaped so that they can't be
+	// found in the text of the record.
+	var zeek, ndjson, tzng strings.Builder
+	zeek.WriteString("#separator \\x09\n#path\tconn\n#fields\tts\tn\n#types\ttime\tcount\n")
+	tzng.WriteString("#0:record[ts:time,n:uint64]\n#1:record[_path:string,ts:time,s:string,a:ip]\n")
+	split := zbuf.ChunkLen + 300
+	for k := 0; k < 2*zbuf.ChunkLen+500; k++ {
+		if k == split {
+			zeek.WriteString("#path\tdns\n#fields\tts\ts\ta\n#types\ttime\tstring\taddr\n")
+		}
+		s, js := fmt.Sprintf("s%d", k%7), fmt.Sprintf("s%d", k%7)
+		if k%100 == 1 {
+			s, js = fmt.Sprintf("\\x73%d", k%7), fmt.Sprintf("\\u0073%d", k%7)
+		}
+		if k < split {
+			fmt.Fprintf(&zeek, "%d\t%d\n", k, k%7)
+		} else {
+			fmt.Fprintf(&zeek, "%d\t%s\t10.0.%d.1\n", k, s, k%3)
+		}
+		if k%2 == 0 {
+			fmt.Fprintf(&tzng, "0:[%d;%d;]\n", k, k%7)
+			fmt.Fprintf(&ndjson, "{\"ts\":%d,\"n\":%d}\n", k, k%7)
+		} else {
+			fmt.Fprintf(&tzng, "1:[dns;%d;s%d;10.0.%d.1;]\n", k, k%7, k%3)
+			fmt.Fprintf(&ndjson, "{\"_path\":\"dns\",\"ts\":%d,\"s\":\"%s\",\"a\":\"10.0.%d.1\"}\n\n", k, js, k%3)
+		}
+	}
+	var bzng bytes.Buffer
+	r := zngio.NewReader(strings.NewReader(tzng.String()), resolver.NewContext())
+	require.NoError(t, zbuf.Copy(zbuf.NopFlusher(bzngio.NewWriter(&bzng)), r))
+
+	// The ts field of ndjson is a number, not a time, so its records
+	// have no timestamp to restrict to a span.
+	span := nano.NewSpanTs(nano.Unix(100, 0), nano.Unix(2000, 0))
+	queries := []string{
+		"",
+		"n=3 or s=s3",
+		"s5",
+		"s3 _path=dns",
+		"dns 10.0.2.1",
+		"3",
+		"not s3",
+		"a=10.0.1.1 or n=2",
+	}
+	for _, query := range queries {
+		for _, out := range []string{
+			testParallel(t, "zeek", zeek.String(), query, span),
+			testParallel(t, "ndjson", ndjson.String(), query, nano.Span{}),
+			testParallel(t, "zng", tzng.String(), query, span),
+			testParallel(t, "bzng", bzng.String(), query, span),
+		} {
+			assert.NotEmpty(t, out, "query %q", query)
+		}
+	}
+}
+
+func TestParallelScannerDone(t *testing.T) {
+	var tzng strings.Builder
+	tzng.WriteString("#0:record[n:uint64]\n")
+	for k := 0; k < 10*zbuf.ChunkLen; k++ {
+		fmt.Fprintf(&tzng, "0:[%d;]\n", k)
+	}
+	r := zngio.NewReader(strings.NewReader(tzng.String()), resolver.NewContext())
+	s := NewParallelScanner(r, nil, 4)
+	batch, err := s.Pull()
+	require.NoError(t, err)
+	assert.Equal(t, zbuf.ChunkLen, batch.Length())
+	s.Done()
+	batch, err = s.Pull()
+	assert.NoError(t, err)
+	assert.Nil(t, batch)
+}
+
+// BenchmarkParallelScanner scans large zeek and bzng inputs for a value
+// found in few records with and without a Prefilter to measure what
+// skipping the decoding of chunks that can't match saves.
+func BenchmarkParallelScanner(b *testing.B) {
+	var zeek, tzng strings.Builder
+	zeek.WriteString("#separator \\x09\n#path\tdns\n#fields\tts\tquery\tanswer\n#types\ttime\tstring\taddr\n")
+	tzng.WriteString("#0:record[_path:string,ts:time,query:string,answer:ip]\n")
+	for k := 0; k < 200000; k++ {
+		query := fmt.Sprintf("host%d.example.com", k%5000)
+		if k%50000 == 0 {
+			query = "needle.example.com"
+		}
+		fmt.Fprintf(&zeek, "%d\t%s\t10.%d.%d.1\n", k, query, k%256, k%7)
+		fmt.Fprintf(&tzng, "0:[dns;%d;%s;10.%d.%d.1;]\n", k, query, k%256, k%7)
+	}
+	var bzng bytes.Buffer
+	r := zngio.NewReader(strings.NewReader(tzng.String()), resolver.NewContext())
+	require.NoError(b, zbuf.Copy(zbuf.NopFlusher(bzngio.NewWriter(&bzng)), r))
+
+	p, err := zql.ParseProc("query=needle.example.com")
+	require.NoError(b, err)
+	e := p.(*ast.FilterProc).Filter
+	f, err := filter.Compile(e)
+	require.NoError(b, err)
+	inputs := []struct {
+		format string
+		input  []byte
+	}{
+		{"zeek", []byte(zeek.String())},
+		{"bzng", bzng.Bytes()},
+	}
+	for _, in := range inputs {
+		for _, prefilter := range []*zbuf.Prefilter{nil, zbuf.NewPrefilter(e)} {
+			name := in.format
+			if prefilter != nil {
+				name += "/prefilter"
+			}
+			b.Run(name, func(b *testing.B) {
+				b.SetBytes(int64(len(in.input)))
+				for i := 0; i < b.N; i++ {
+					r, err := detector.LookupReader(in.format, bytes.NewReader(in.input), resolver.NewContext())
+					require.NoError(b, err)
+					s := NewParallelScanner(r, f, 4)
+					s.SetPrefilter(prefilter)
+					var n int
+					for {
+						batch, err := s.Pull()
+						require.NoError(b, err)
+						if batch == nil {
+							break
+						}
+						n += batch.Length()
+					}
+					require.Equal(b, 4, n)
+				}
+			})
+		}
+	}
+}
@@ -1,6 +1,9 @@
 package scanner
 
 import (
+	"context"
+	"sync/atomic"
+
 	"github.com/brimsec/zq/filter"
 	"github.com/brimsec/zq/pkg/nano"
 	"github.com/brimsec/zq/proc"
@@ -12,6 +15,10 @@ type Scanner struct {
 	reader zbuf.Reader
 	filter filter.Filter
 	span   nano.Span
+	ctx    context.Context
+	nread  int
+	// stopped is set to 1 when the deadline of ctx ends the scan.
+	stopped int32
 }
 
 func NewScanner(reader zbuf.Reader, f filter.Filter) *Scanner {
@@ -25,13 +32,53 @@ func (s *Scanner) SetSpan(span nano.Span) {
 	s.span = span
 }
 
+// SetContext sets a context whose cancellation stops the scan.  If the
+// context's deadline passes, the scan ends as if the reader were at its
+// end so that the procs downstream output results for the records
+// scanned so far.  If it is canceled, Pull returns its error.
+func (s *Scanner) SetContext(ctx context.Context) {
+	s.ctx = ctx
+}
+
 const batchSize = 100
 
+// checkInterval is the number of records read between checks of the
+// scanner's context.
+const checkInterval = 1000
+
+// stopped reports whether the scan was stopped by its context and
+// returns the context's error if that was not for its deadline.  Only a
+// scanner that stops before the end of its reader ends its scan early;
+// one that has read every record when the deadline passes does not.
+func stopped(ctx context.Context) (bool, error) {
+	if ctx == nil {
+		return false, nil
+	}
+	switch err := ctx.Err(); err {
+	case nil:
+		return false, nil
+	case context.DeadlineExceeded:
+		return true, nil
+	default:
+		return true, err
+	}
+}
+
 func (s *Scanner) Pull() (zbuf.Batch, error) {
 	minTs, maxTs := nano.MaxTs, nano.MinTs
 	var arr []*zng.Record
 	match := s.filter
 	for len(arr) < batchSize {
+		if s.nread%checkInterval == 0 {
+			if stop, err := stopped(s.ctx); stop {
+				if err != nil {
+					return nil, err
+				}
+				atomic.StoreInt32(&s.stopped, 1)
+				break
+			}
+		}
+		s.nread++
 		rec, err := s.reader.Read()
 		if err != nil {
 			return nil, err
@@ -63,6 +110,13 @@ func (s *Scanner) Pull() (zbuf.Batch, error) {
 	return zbuf.NewArray(arr, span), nil
 }
 
+// Stopped returns true if the deadline of the scanner's context ended the
+// scan before the end of its reader so that the records scanned are only
+// some of those within its span.
+func (s *Scanner) Stopped() bool {
+	return atomic.LoadInt32(&s.stopped) != 0
+}
+
 // Done is required to implement proc.Proc interface. Ignore for now.
 func (s *Scanner) Done() {}
 
@@ -0,0 +1,57 @@
+package zbuf
+
+import (
+	"github.com/brimsec/zq/zng"
+)
+
+// ChunkLen is the number of records a Chunker puts in a chunk.
+const ChunkLen = 1000
+
+// A Chunker is a Reader that can also split its input into chunks whose
+// records are decoded separately.  ReadChunk must be called from one
+// goroutine at a time and returns nil at the end of the input, but the
+// chunks it returns may be decoded concurrently with each other and with
+// subsequent calls to ReadChunk.  Calls to Read and ReadChunk should not
+// be mixed.
+type Chunker interface {
+	Reader
+	ReadChunk() (Chunk, error)
+}
+
+// A Chunk is a run of consecutive records read by a Chunker.
+type Chunk interface {
+	// Decode returns the records of the chunk in the order they were
+	// read.  The records are not volatile.  Records that p finds cannot
+	// match its filter may be left out without being decoded.
+	Decode(p *Prefilter) ([]*zng.Record, error)
+}
+
+// Records is a Chunk of records that have already been decoded.
+type Records []*zng.Record
+
+func (r Records) Decode(*Prefilter) ([]*zng.Record, error) {
+	return r, nil
+}
+
+// ReadChunk returns the next chunk of r.  If r is not a Chunker, the
+// records of the chunk are read and decoded by ReadChunk itself.
+func ReadChunk(r Reader) (Chunk, error) {
+	if c, ok := r.(Chunker); ok {
+		return c.ReadChunk()
+	}
+	var recs Records
+	for len(recs) < ChunkLen {
+		rec, err := r.Read()
+		if err != nil {
+			return nil, err
+		}
+		if rec == nil {
+			break
+		}
+		recs = append(recs, rec.Keep())
+	}
+	if recs == nil {
+		return nil, nil
+	}
+	return recs, nil
+}
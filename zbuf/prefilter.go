@@ -0,0 +1,213 @@
+package zbuf
+
+import (
+	"bytes"
+	"math"
+	"net"
+	"strconv"
+
+	"github.com/brimsec/zq/ast"
+	"github.com/brimsec/zq/zng"
+)
+
+// Encoding identifies how the records examined by a Prefilter are encoded.
+type Encoding int
+
+const (
+	// BZNG is the encoding of the bodies of bzng values.
+	BZNG Encoding = iota
+	// ZeekTSV is the encoding of the lines of a Zeek log.
+	ZeekTSV
+	// NDJSON is the encoding of the lines of an ndjson file.
+	NDJSON
+	numEncodings
+)
+
+// maxZeekInt is the largest magnitude of a number that Zeek writes
+// without an exponent in every type.  Zeek writes a larger double in
+// scientific notation.
+const maxZeekInt = math.MaxInt32
+
+// A Prefilter is derived from a filter to find encoded records that
+// cannot match the filter before they are decoded.  It looks for the
+// literal values searched for by the filter in the encoded bytes of
+// records, each of which can hold a match only if it holds the values
+// that a match requires.  A Prefilter is safe for concurrent use.
+type Prefilter struct {
+	terms [numEncodings]*term
+}
+
+// A term is a condition on encoded bytes.  A term with needles is true if
+// any of them is in the bytes, and one without combines its left and
+// right terms with op.
+type term struct {
+	op          string
+	left, right *term
+	needles     [][]byte
+}
+
+// NewPrefilter returns a Prefilter for the filter e or nil if e does not
+// require a match to hold any literal value that a Prefilter can find.
+func NewPrefilter(e ast.BooleanExpr) *Prefilter {
+	var p Prefilter
+	var ok bool
+	for enc := range p.terms {
+		p.terms[enc] = prefilter(e, Encoding(enc))
+		if p.terms[enc] != nil {
+			ok = true
+		}
+	}
+	if !ok {
+		return nil
+	}
+	return &p
+}
+
+// prefilter returns a term that is true of the encoding of each record
+// matching e or nil if no such term is known.
+func prefilter(e ast.BooleanExpr, enc Encoding) *term {
+	switch e := e.(type) {
+	case *ast.LogicalAnd:
+		left, right := prefilter(e.Left, enc), prefilter(e.Right, enc)
+		if left == nil {
+			return right
+		}
+		if right == nil {
+			return left
+		}
+		return &term{op: "and", left: left, right: right}
+	case *ast.LogicalOr:
+		left, right := prefilter(e.Left, enc), prefilter(e.Right, enc)
+		if left == nil || right == nil {
+			return nil
+		}
+		return &term{op: "or", left: left, right: right}
+	case *ast.CompareAny:
+		return needles(e.Comparator, e.Value, enc)
+	case *ast.CompareField:
+		if stored(e.Field) {
+			return needles(e.Comparator, e.Value, enc)
+		}
+	}
+	return nil
+}
+
+// stored returns true if the value of e is stored in a record rather
+// than computed from one, as with len().
+func stored(e ast.FieldExpr) bool {
+	switch e := e.(type) {
+	case *ast.FieldRead:
+		return true
+	case *ast.FieldCall:
+		switch e.Fn {
+		case "RecordFieldRead", "Index":
+			return stored(e.Field)
+		}
+	}
+	return false
+}
+
+// needles returns a term that is true of the encoding of each record
+// holding a value that compares with literal under op or nil if there is
+// no such term.
+func needles(op string, literal ast.Literal, enc Encoding) *term {
+	switch op {
+	case "eql", "in", "search", "searchin":
+	default:
+		return nil
+	}
+	if literal.Type == "regexp" {
+		return nil
+	}
+	v, err := zng.ParseLiteral(literal)
+	if err != nil {
+		return nil
+	}
+	var alts [][]byte
+	switch v := v.(type) {
+	case zng.Bstring:
+		if len(v) > 0 {
+			alts = [][]byte{[]byte(v)}
+		}
+	case net.IP:
+		ip4 := v.To4()
+		switch {
+		case enc == BZNG && ip4 != nil:
+			alts = [][]byte{ip4, v.To16()}
+		case enc == BZNG:
+			alts = [][]byte{v.To16()}
+		case ip4 != nil:
+			// Text encodings of IPv6 addresses vary too much to
+			// search for.
+			alts = [][]byte{[]byte(ip4.String())}
+		}
+	case int64:
+		// A number may be encoded in bzng as any of several types and
+		// in ndjson with an exponent or a fraction, but a Zeek log
+		// holds it in decimal whatever its type.
+		if enc == ZeekTSV && v >= -maxZeekInt && v <= maxZeekInt {
+			alts = [][]byte{[]byte(strconv.FormatInt(v, 10))}
+		}
+	}
+	if alts == nil {
+		return nil
+	}
+	return &term{needles: alts}
+}
+
+func (t *term) match(b []byte) bool {
+	switch t.op {
+	case "and":
+		return t.left.match(b) && t.right.match(b)
+	case "or":
+		return t.left.match(b) || t.right.match(b)
+	}
+	for _, needle := range t.needles {
+		if bytes.Contains(b, needle) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchWith is like match but is true if a needle is in either a or b.
+func (t *term) matchWith(a, b []byte) bool {
+	switch t.op {
+	case "and":
+		return t.left.matchWith(a, b) && t.right.matchWith(a, b)
+	case "or":
+		return t.left.matchWith(a, b) || t.right.matchWith(a, b)
+	}
+	for _, needle := range t.needles {
+		if bytes.Contains(a, needle) || bytes.Contains(b, needle) {
+			return true
+		}
+	}
+	return false
+}
+
+// escaped returns true if b is text that may hold an escape sequence,
+// which could stand for a needle that b does not hold literally.
+func escaped(enc Encoding, b []byte) bool {
+	return enc != BZNG && bytes.IndexByte(b, '\\') >= 0
+}
+
+// Match returns false if no record encoded in b with encoding enc can
+// match the filter from which p was derived.  It returns true for a nil
+// Prefilter.
+func (p *Prefilter) Match(enc Encoding, b []byte) bool {
+	if p == nil || p.terms[enc] == nil || escaped(enc, b) {
+		return true
+	}
+	return p.terms[enc].match(b)
+}
+
+// MatchWith is like Match but for records whose values are encoded in
+// both with and b, such as the lines of a Zeek log whose _path is given
+// by a directive.
+func (p *Prefilter) MatchWith(enc Encoding, with, b []byte) bool {
+	if p == nil || p.terms[enc] == nil || escaped(enc, with) || escaped(enc, b) {
+		return true
+	}
+	return p.terms[enc].matchWith(with, b)
+}
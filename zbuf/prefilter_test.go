@@ -0,0 +1,44 @@
+package zbuf_test
+
+import (
+	"testing"
+
+	"github.com/brimsec/zq/ast"
+	"github.com/brimsec/zq/zbuf"
+	"github.com/brimsec/zq/zql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func prefilter(t *testing.T, query string) *zbuf.Prefilter {
+	p, err := zql.ParseProc(query)
+	require.NoError(t, err)
+	return zbuf.NewPrefilter(p.(*ast.FilterProc).Filter)
+}
+
+func TestPrefilter(t *testing.T) {
+	for _, query := range []string{"*", "not foo", "foo or x>1", "/fo+/", "len(x)=3", "1.5", ":53"} {
+		assert.Nil(t, prefilter(t, query), "query %q", query)
+	}
+
+	p := prefilter(t, "foo 10.0.0.1")
+	assert.True(t, p.Match(zbuf.ZeekTSV, []byte("foo\t10.0.0.1")))
+	assert.False(t, p.Match(zbuf.ZeekTSV, []byte("foo\t10.0.0.2")))
+	assert.True(t, p.Match(zbuf.ZeekTSV, []byte(`\x66oo\t10.0.0.1`)), "escaped")
+	assert.True(t, p.MatchWith(zbuf.ZeekTSV, []byte("foo"), []byte("10.0.0.1")))
+	assert.True(t, p.Match(zbuf.BZNG, []byte("foo\x0a\x00\x00\x01")))
+	assert.False(t, p.Match(zbuf.BZNG, []byte(`foo\x0a\x00\x00\x02`)), "not escaped")
+
+	p = prefilter(t, "_path=conn or 443")
+	assert.True(t, p.Match(zbuf.ZeekTSV, []byte("1\t443")))
+	assert.False(t, p.Match(zbuf.ZeekTSV, []byte("1\t53")))
+	assert.True(t, p.Match(zbuf.NDJSON, []byte(`{"port":4.43e2}`)), "numbers in ndjson")
+	assert.True(t, p.Match(zbuf.BZNG, []byte("\x01\xbb")), "numbers in bzng")
+
+	p = prefilter(t, "_path=conn")
+	assert.True(t, p.Match(zbuf.NDJSON, []byte(`{"_path":"conn"}`)))
+	assert.False(t, p.Match(zbuf.NDJSON, []byte(`{"_path":"dns"}`)))
+
+	var nilp *zbuf.Prefilter
+	assert.True(t, nilp.Match(zbuf.BZNG, nil))
+}
@@ -0,0 +1,416 @@
+package zbuf
+
+import (
+	"math"
+	"net"
+	"strconv"
+
+	"github.com/brimsec/zq/ast"
+	"github.com/brimsec/zq/pkg/bloom"
+	"github.com/brimsec/zq/zcode"
+	"github.com/brimsec/zq/zng"
+)
+
+// maxKeyInt is the largest magnitude of a whole number that is keyed in
+// the Bloom filter of a Summary.  A filter compares a time or duration
+// with a number n as n seconds, which is representable in nanoseconds
+// only up to this magnitude, and every number up to it is exact in a
+// float64.
+const maxKeyInt = math.MaxInt64 / 1000000000
+
+// A Summary describes the values of a block of records so that a Pruner
+// can tell whether any of the records may match a filter without reading
+// them.
+type Summary struct {
+	// Bloom holds a key for each string, address, port, and whole number
+	// in the records, including those in nested records, sets, and
+	// arrays.
+	Bloom *bloom.Filter
+	// Numbers holds the range of the values of each field of a numeric
+	// type other than time and duration by dotted field name.
+	Numbers map[string]*Range `json:",omitempty"`
+	// Times holds the range in nanoseconds of the values of each field
+	// of type time or duration by dotted field name.
+	Times map[string]*TimeRange `json:",omitempty"`
+}
+
+type Range struct {
+	Min float64
+	Max float64
+}
+
+type TimeRange struct {
+	Min int64
+	Max int64
+}
+
+// A Summarizer builds the Summary of the records added to it.
+type Summarizer struct {
+	keys    map[string]struct{}
+	numbers map[string]*Range
+	times   map[string]*TimeRange
+}
+
+func NewSummarizer() *Summarizer {
+	return &Summarizer{
+		keys:    make(map[string]struct{}),
+		numbers: make(map[string]*Range),
+		times:   make(map[string]*TimeRange),
+	}
+}
+
+func (s *Summarizer) Add(rec *zng.Record) error {
+	return s.addRecord("", true, rec.Type.Columns, rec.Raw)
+}
+
+// Summary returns the Summary of the records added to s.
+func (s *Summarizer) Summary() *Summary {
+	f := bloom.New(len(s.keys))
+	for key := range s.keys {
+		f.Add([]byte(key))
+	}
+	sum := &Summary{Bloom: f}
+	if len(s.numbers) > 0 {
+		sum.Numbers = s.numbers
+	}
+	if len(s.times) > 0 {
+		sum.Times = s.times
+	}
+	return sum
+}
+
+// addRecord adds the values of a record whose columns are named within
+// the record named prefix or, if prefix is empty, are top-level fields.
+// The ranges of the values are kept if ranged is true.
+func (s *Summarizer) addRecord(prefix string, ranged bool, cols []zng.Column, zv zcode.Bytes) error {
+	it := zv.Iter()
+	for _, c := range cols {
+		val, _, err := it.Next()
+		if err != nil {
+			return err
+		}
+		name := c.Name
+		if prefix != "" {
+			name = prefix + "." + name
+		}
+		if err := s.addValue(name, ranged, c.Type, val); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// addValue adds a value of the field named name.  Its range is kept if
+// ranged is true, which is not so for the elements of sets and arrays and
+// the values within them.
+func (s *Summarizer) addValue(name string, ranged bool, typ zng.Type, zv zcode.Bytes) error {
+	if zv == nil {
+		return nil
+	}
+	switch typ := zng.AliasedType(typ).(type) {
+	case *zng.TypeRecord:
+		return s.addRecord(name, ranged, typ.Columns, zv)
+	case *zng.TypeSet, *zng.TypeArray:
+		inner := zng.InnerType(typ)
+		for it := zv.Iter(); !it.Done(); {
+			el, _, err := it.Next()
+			if err != nil {
+				return err
+			}
+			if err := s.addValue(name, false, inner, el); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	switch typ.ID() {
+	case zng.IdString, zng.IdBstring:
+		s.addKey('s', zv)
+	case zng.IdIP:
+		ip, err := zng.DecodeIP(zv)
+		if err != nil {
+			return err
+		}
+		s.addKey('a', ip.To16())
+	case zng.IdPort:
+		p, err := zng.DecodePort(zv)
+		if err != nil {
+			return err
+		}
+		s.addKey('p', []byte(strconv.FormatUint(uint64(p), 10)))
+		s.addInt(name, ranged, int64(p))
+	case zng.IdByte:
+		b, err := zng.DecodeByte(zv)
+		if err != nil {
+			return err
+		}
+		s.addInt(name, ranged, int64(b))
+	case zng.IdInt16, zng.IdInt32, zng.IdInt64:
+		v, err := zng.DecodeInt(zv)
+		if err != nil {
+			return err
+		}
+		s.addInt(name, ranged, v)
+	case zng.IdUint16, zng.IdUint32, zng.IdUint64:
+		v, err := zng.DecodeUint(zv)
+		if err != nil {
+			return err
+		}
+		if v <= math.MaxInt64 {
+			s.addInt(name, ranged, int64(v))
+		} else if ranged {
+			s.addNumber(name, float64(v))
+		}
+	case zng.IdFloat64:
+		v, err := zng.DecodeFloat64(zv)
+		if err != nil {
+			return err
+		}
+		if v == math.Trunc(v) && math.Abs(v) <= maxKeyInt {
+			s.addKey('n', []byte(strconv.FormatInt(int64(v), 10)))
+		}
+		if ranged {
+			s.addNumber(name, v)
+		}
+	case zng.IdTime, zng.IdDuration:
+		v, err := zng.DecodeInt(zv)
+		if err != nil {
+			return err
+		}
+		if v%1e9 == 0 {
+			s.addKey('n', []byte(strconv.FormatInt(v/1e9, 10)))
+		}
+		if ranged {
+			s.addTime(name, v)
+		}
+	}
+	return nil
+}
+
+func (s *Summarizer) addKey(kind byte, b []byte) {
+	key := make([]byte, 0, len(b)+1)
+	key = append(append(key, kind), b...)
+	s.keys[string(key)] = struct{}{}
+}
+
+func (s *Summarizer) addInt(name string, ranged bool, v int64) {
+	s.addKey('n', []byte(strconv.FormatInt(v, 10)))
+	if ranged {
+		s.addNumber(name, float64(v))
+	}
+}
+
+func (s *Summarizer) addNumber(name string, v float64) {
+	if math.IsNaN(v) {
+		return
+	}
+	r := s.numbers[name]
+	if r == nil {
+		s.numbers[name] = &Range{v, v}
+		return
+	}
+	if v < r.Min {
+		r.Min = v
+	}
+	if v > r.Max {
+		r.Max = v
+	}
+}
+
+func (s *Summarizer) addTime(name string, v int64) {
+	r := s.times[name]
+	if r == nil {
+		s.times[name] = &TimeRange{v, v}
+		return
+	}
+	if v < r.Min {
+		r.Min = v
+	}
+	if v > r.Max {
+		r.Max = v
+	}
+}
+
+// A Pruner is derived from a filter to find blocks of records that cannot
+// match the filter from their Summary.  It is safe for concurrent use.
+type Pruner struct {
+	match pruneFunc
+}
+
+// A pruneFunc returns false if no record described by a Summary can
+// satisfy a condition.
+type pruneFunc func(*Summary) bool
+
+// NewPruner returns a Pruner for the filter e or nil if e does not
+// compare values in a way that a Summary can rule out.
+func NewPruner(e ast.BooleanExpr) *Pruner {
+	match := pruner(e)
+	if match == nil {
+		return nil
+	}
+	return &Pruner{match}
+}
+
+// Match returns false if no record described by s can match the filter
+// from which p was derived.  It returns true for a nil Pruner or Summary.
+func (p *Pruner) Match(s *Summary) bool {
+	if p == nil || s == nil {
+		return true
+	}
+	return p.match(s)
+}
+
+func pruner(e ast.BooleanExpr) pruneFunc {
+	switch e := e.(type) {
+	case *ast.LogicalAnd:
+		return and(pruner(e.Left), pruner(e.Right))
+	case *ast.LogicalOr:
+		left, right := pruner(e.Left), pruner(e.Right)
+		if left == nil || right == nil {
+			return nil
+		}
+		return func(s *Summary) bool { return left(s) || right(s) }
+	case *ast.CompareAny:
+		switch e.Comparator {
+		case "eql", "in":
+			return keyPruner(e.Value)
+		}
+	case *ast.CompareField:
+		if !stored(e.Field) {
+			return nil
+		}
+		var key, rng pruneFunc
+		if e.Comparator == "eql" || e.Comparator == "in" {
+			key = keyPruner(e.Value)
+		}
+		if name, ok := dottedName(e.Field); ok && e.Comparator != "in" {
+			rng = rangePruner(name, e.Comparator, e.Value)
+		}
+		return and(key, rng)
+	}
+	return nil
+}
+
+func and(left, right pruneFunc) pruneFunc {
+	if left == nil {
+		return right
+	}
+	if right == nil {
+		return left
+	}
+	return func(s *Summary) bool { return left(s) && right(s) }
+}
+
+// dottedName returns the dotted name of a field of a record or of a
+// record nested within it.
+func dottedName(e ast.FieldExpr) (string, bool) {
+	switch e := e.(type) {
+	case *ast.FieldRead:
+		return e.Field, true
+	case *ast.FieldCall:
+		if e.Fn == "RecordFieldRead" {
+			if base, ok := dottedName(e.Field); ok {
+				return base + "." + e.Param, true
+			}
+		}
+	}
+	return "", false
+}
+
+// keyPruner returns a pruneFunc that is false of a Summary whose Bloom
+// filter does not hold the key of literal or nil if literal has no key.
+func keyPruner(literal ast.Literal) pruneFunc {
+	if literal.Type == "regexp" {
+		return nil
+	}
+	v, err := zng.ParseLiteral(literal)
+	if err != nil {
+		return nil
+	}
+	var key []byte
+	switch v := v.(type) {
+	case zng.Bstring:
+		// An unset string compares equal to the empty string.
+		if len(v) > 0 {
+			key = append([]byte{'s'}, v...)
+		}
+	case net.IP:
+		key = append([]byte{'a'}, v.To16()...)
+	case zng.Port:
+		key = append([]byte{'p'}, strconv.FormatUint(uint64(v), 10)...)
+	case int64:
+		if v >= -maxKeyInt && v <= maxKeyInt {
+			key = append([]byte{'n'}, strconv.FormatInt(v, 10)...)
+		}
+	}
+	if key == nil {
+		return nil
+	}
+	return func(s *Summary) bool {
+		return s.Bloom == nil || s.Bloom.Test(key)
+	}
+}
+
+// rangePruner returns a pruneFunc that is false of a Summary whose ranges
+// for the field name hold no value that compares with literal under op or
+// nil if there is no such pruneFunc.  The ranges of numbers converted to
+// floats may be rounded so the comparison is made as if op included
+// equality.
+func rangePruner(name, op string, literal ast.Literal) pruneFunc {
+	switch op {
+	case "eql", "lt", "lte", "gt", "gte":
+	default:
+		return nil
+	}
+	if literal.Type == "regexp" {
+		return nil
+	}
+	v, err := zng.ParseLiteral(literal)
+	if err != nil {
+		return nil
+	}
+	var x float64
+	var inTime func(*TimeRange) bool
+	switch v := v.(type) {
+	case int64:
+		if v < -maxKeyInt || v > maxKeyInt {
+			return nil
+		}
+		x = float64(v)
+		ns := v * 1e9
+		inTime = func(r *TimeRange) bool {
+			return overlaps(op, r.Min <= ns, r.Max >= ns)
+		}
+	case float64:
+		x = v
+		inTime = func(r *TimeRange) bool {
+			min, max := float64(r.Min)/1e9, float64(r.Max)/1e9
+			return overlaps(op, min <= x, max >= x)
+		}
+	default:
+		return nil
+	}
+	return func(s *Summary) bool {
+		nr, tr := s.Numbers[name], s.Times[name]
+		if nr == nil && tr == nil {
+			return true
+		}
+		if nr != nil && overlaps(op, nr.Min <= x, nr.Max >= x) {
+			return true
+		}
+		return tr != nil && inTime(tr)
+	}
+}
+
+// overlaps returns true if a value in a range may compare with a value x
+// under op given whether the least value of the range is at most x and
+// the greatest is at least x.
+func overlaps(op string, minLeq, maxGeq bool) bool {
+	switch op {
+	case "eql":
+		return minLeq && maxGeq
+	case "lt", "lte":
+		return minLeq
+	}
+	return maxGeq
+}
@@ -0,0 +1,111 @@
+package zbuf_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/brimsec/zq/ast"
+	"github.com/brimsec/zq/filter"
+	"github.com/brimsec/zq/zbuf"
+	"github.com/brimsec/zq/zio/zngio"
+	"github.com/brimsec/zq/zng"
+	"github.com/brimsec/zq/zng/resolver"
+	"github.com/brimsec/zq/zql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func pruner(t *testing.T, query string) (*zbuf.Pruner, filter.Filter) {
+	p, err := zql.ParseProc(query)
+	require.NoError(t, err)
+	e := p.(*ast.FilterProc).Filter
+	f, err := filter.Compile(e)
+	require.NoError(t, err)
+	return zbuf.NewPruner(e), f
+}
+
+func summarize(t *testing.T, src string) (*zbuf.Summary, []*zng.Record) {
+	r := zngio.NewReader(strings.NewReader(src), resolver.NewContext())
+	s := zbuf.NewSummarizer()
+	var recs []*zng.Record
+	for {
+		rec, err := r.Read()
+		require.NoError(t, err)
+		if rec == nil {
+			break
+		}
+		require.NoError(t, s.Add(rec))
+		recs = append(recs, rec.Keep())
+	}
+	return s.Summary(), recs
+}
+
+func TestPruner(t *testing.T) {
+	// Searches for a bare value also look for it within strings, which
+	// a Summary cannot rule out.
+	for _, query := range []string{"*", "not uid=C1", "foo", "uid=C1 or x!=1", "/C1/", "len(s)=3", "s=a or foo", "10.0.0.1"} {
+		p, _ := pruner(t, query)
+		assert.Nil(t, p, "query %q", query)
+	}
+
+	blocks := []string{`
+#0:record[ts:time,uid:bstring,id:record[orig_h:ip,resp_p:port],n:int64,s:set[string],d:duration,x:float64]
+0:[10;C1;[10.0.0.1;80;]2;[a;b;]5;1.5;]
+0:[11;C2;[10.0.0.2;443;]3;[c;]7;2.5;]
+`, `
+#0:record[ts:time,uid:bstring,id:record[orig_h:ip,resp_p:port],n:int64,s:set[string],d:duration,x:float64]
+0:[20;C3;[10.0.0.3;53;]100;[d;]-;-;]
+#1:record[ts:time,n:uint64,a:array[int64]]
+1:[21;200;[9;10;]]
+`}
+	// A field that is missing from a block has no range, so comparisons
+	// with it are not ruled out.
+	queries := map[string][]bool{
+		"uid=C1":                 {true, false},
+		"uid=C4":                 {false, false},
+		"*=10.0.0.3":             {false, true},
+		"id.orig_h=10.0.0.2":     {true, false},
+		"*=:443":                 {true, false},
+		"*=443":                  {true, false},
+		"id.resp_p=53":           {false, true},
+		"n=3":                    {true, false},
+		"n>=100":                 {false, true},
+		"n<3":                    {true, false},
+		"n=200":                  {false, true},
+		"n>1000 or uid=C2":       {true, false},
+		"uid=C1 and n=100":       {false, false},
+		"c in s":                 {true, false},
+		"d in s":                 {false, true},
+		"9 in a":                 {false, true},
+		"**=7":                   {true, false},
+		"d=7":                    {true, false},
+		"d>6":                    {true, true},
+		"d>8":                    {false, true},
+		"ts>20":                  {false, true},
+		"ts<10.5":                {true, false},
+		"x=2.5":                  {true, true},
+		"x>3":                    {false, true},
+		"x>3 and uid=C1":         {false, false},
+		"uid=C3 and not n=100":   {false, true},
+		"id.orig_h=10.0.0.3 a":   {false, true},
+		"s=a and *=10.0.0.1":     {true, false},
+		"n=1.5 or uid=C2":        {true, false},
+		"10.0.0.0/8 or uid=C1":   {true, true},
+		"x=1.5 or id.resp_p=443": {true, true},
+	}
+	for query, expected := range queries {
+		p, f := pruner(t, query)
+		for k, src := range blocks {
+			s, recs := summarize(t, src)
+			var matched bool
+			for _, rec := range recs {
+				matched = matched || f(rec)
+			}
+			assert.Equal(t, expected[k], p.Match(s), "query %q block %d", query, k)
+			if matched {
+				// A block with a match must never be pruned.
+				assert.True(t, p.Match(s), "query %q block %d", query, k)
+			}
+		}
+	}
+}
@@ -0,0 +1,278 @@
+package bzngio
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+
+	"github.com/brimsec/zq/pkg/nano"
+	"github.com/brimsec/zq/pkg/ranger"
+	"github.com/brimsec/zq/pkg/slicer"
+	"github.com/brimsec/zq/zbuf"
+	"github.com/brimsec/zq/zng/resolver"
+)
+
+// Index is a time index of a bzng file.  Like a pcap.Index, it is a
+// ranger.Envelope whose X values are the seek offsets of the values of the
+// file and whose Y values are their timestamps, so it finds the part of the
+// file that holds the values within a time span.  Since those values may
+// refer to types defined earlier in the file, the index also locates
+// every typedef of the file.  The file is further divided into blocks of
+// up to zbuf.ChunkLen values, each with a zbuf.Summary of its values, so
+// that blocks that cannot match a filter are not read either.
+type Index struct {
+	// Size and Fingerprint are the size and fingerprint of the indexed
+	// file, which tell whether the index is still up to date.
+	Size        int64
+	Fingerprint string
+	TypeDefs    []slicer.Slice
+	Envelope    ranger.Envelope
+	Blocks      []Block
+}
+
+// A Block is a slice of a bzng file that runs from the start of a value to
+// the start of the value after the last one it summarizes.
+type Block struct {
+	slicer.Slice
+	Summary *zbuf.Summary
+}
+
+// fingerprintLen is the number of bytes at each end of a file that its
+// fingerprint covers.
+const fingerprintLen = 4096
+
+// Fingerprint returns a hash of the first and last bytes of the first size
+// bytes of r.  Unlike a hash of the whole file, it can be checked without
+// reading the whole file, and unlike a modification time, it does not
+// change when the file is copied.
+func Fingerprint(r io.ReaderAt, size int64) (string, error) {
+	head := make([]byte, min64(size, fingerprintLen))
+	if _, err := r.ReadAt(head, 0); err != nil {
+		return "", err
+	}
+	tail := make([]byte, min64(size, fingerprintLen))
+	if _, err := r.ReadAt(tail, size-int64(len(tail))); err != nil {
+		return "", err
+	}
+	return fingerprint(head, tail), nil
+}
+
+func fingerprint(head, tail []byte) string {
+	h := sha256.New()
+	h.Write(head)
+	h.Write(tail)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func min64(a, b int64) int64 {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// fingerprinter is a counter that keeps the first and last bytes it reads
+// to compute the fingerprint of what it has read.
+type fingerprinter struct {
+	counter
+	head []byte
+	tail []byte
+}
+
+func (f *fingerprinter) Read(b []byte) (int, error) {
+	n, err := f.counter.Read(b)
+	if k := fingerprintLen - len(f.head); k > 0 {
+		f.head = append(f.head, b[:min64(int64(n), int64(k))]...)
+	}
+	f.tail = append(f.tail, b[:n]...)
+	if len(f.tail) > 2*fingerprintLen {
+		f.tail = append(f.tail[:0], f.tail[len(f.tail)-fingerprintLen:]...)
+	}
+	return n, err
+}
+
+func (f *fingerprinter) fingerprint() string {
+	tail := f.tail
+	if len(tail) > fingerprintLen {
+		tail = tail[len(tail)-fingerprintLen:]
+	}
+	return fingerprint(f.head, tail)
+}
+
+// IndexPath returns the path of the time index of the bzng file at path.
+func IndexPath(path string) string {
+	return path + ".idx.json"
+}
+
+// CreateIndex creates an index for a bzng stream presented as an io.Reader.
+// The size parameter bounds the number of bins of the index's envelope.
+func CreateIndex(r io.Reader, size int) (*Index, error) {
+	c := &fingerprinter{counter: counter{Reader: r}}
+	reader := NewReader(c, resolver.NewContext())
+	offset := func() uint64 {
+		return uint64(c.n - int64(reader.peeker.Buffered()))
+	}
+	var index Index
+	var points []ranger.Point
+	var summarizer *zbuf.Summarizer
+	var n int
+	endBlock := func(off uint64) {
+		b := &index.Blocks[len(index.Blocks)-1]
+		b.Length = off - b.Offset
+		b.Summary = summarizer.Summary()
+	}
+	for {
+		off := offset()
+		b, err := reader.peeker.Peek(1)
+		if err == io.EOF || len(b) == 0 {
+			break
+		}
+		if code := b[0]; code&0x80 != 0 {
+			if _, err := reader.peeker.Read(1); err != nil {
+				return nil, err
+			}
+			ok, err := reader.readTypeDef(code)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				index.addTypeDef(off, offset()-off)
+				continue
+			}
+			// Skip a control payload as readPayload would.
+			len, err := reader.readUvarint()
+			if err != nil {
+				return nil, err
+			}
+			if _, err := reader.peeker.Read(len); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		rec, _, err := reader.readPayload()
+		if err != nil {
+			return nil, err
+		}
+		if rec == nil {
+			break
+		}
+		ts, err := rec.AccessTime("ts")
+		if err != nil {
+			ts = nano.MinTs
+		}
+		points = append(points, ranger.Point{X: off, Y: uint64(ts)})
+		if n == zbuf.ChunkLen {
+			endBlock(off)
+			n = 0
+		}
+		if n == 0 {
+			index.Blocks = append(index.Blocks, Block{Slice: slicer.Slice{Offset: off}})
+			summarizer = zbuf.NewSummarizer()
+		}
+		if err := summarizer.Add(rec); err != nil {
+			return nil, err
+		}
+		n++
+	}
+	index.Size = int64(offset())
+	index.Fingerprint = c.fingerprint()
+	if n > 0 {
+		endBlock(uint64(index.Size))
+	}
+	if len(points) > 0 {
+		index.Envelope = ranger.NewEnvelope(points, size)
+	}
+	return &index, nil
+}
+
+// addTypeDef adds the typedef of length n at off to the index, merging it
+// with the previous typedef if the two are adjacent.
+func (i *Index) addTypeDef(off, n uint64) {
+	if k := len(i.TypeDefs) - 1; k >= 0 {
+		prev := &i.TypeDefs[k]
+		if prev.Offset+prev.Length == off {
+			prev.Length += n
+			return
+		}
+	}
+	i.TypeDefs = append(i.TypeDefs, slicer.Slice{Offset: off, Length: n})
+}
+
+// Slices returns the slices of the indexed file that hold a valid bzng
+// stream with every value of the file within span that may match the
+// filter from which p was derived.  If span is empty, values at any time
+// are included.  As with pcap.GenerateSlices, other values may appear in
+// the stream too, so its values still need to be filtered.
+func (i *Index) Slices(span nano.Span, p *zbuf.Pruner) []slicer.Slice {
+	x0, x1 := uint64(0), uint64(i.Size)
+	if span.Dur != 0 {
+		if len(i.Envelope) == 0 {
+			return nil
+		}
+		d := i.Envelope.FindSmallestDomain(ranger.Range{Y0: uint64(span.Ts), Y1: uint64(span.End())})
+		if d.X1 == d.X0 {
+			return nil
+		}
+		x0 = d.X0
+		if d.X1 < x1 {
+			x1 = d.X1
+		}
+	}
+	if x1 <= x0 {
+		return nil
+	}
+	var ranges []slicer.Slice
+	if p == nil || len(i.Blocks) == 0 {
+		ranges = []slicer.Slice{{Offset: x0, Length: x1 - x0}}
+	} else {
+		for _, b := range i.Blocks {
+			lo, hi := b.Offset, b.Offset+b.Length
+			if lo < x0 {
+				lo = x0
+			}
+			if hi > x1 {
+				hi = x1
+			}
+			if lo < hi && p.Match(b.Summary) {
+				ranges = appendSlice(ranges, slicer.Slice{Offset: lo, Length: hi - lo})
+			}
+		}
+	}
+	// Add the typedefs that precede each range and are not in another.
+	var slices []slicer.Slice
+	var k int
+	for _, r := range ranges {
+		for ; k < len(i.TypeDefs) && i.TypeDefs[k].Offset < r.Offset; k++ {
+			slices = appendSlice(slices, i.TypeDefs[k])
+		}
+		for k < len(i.TypeDefs) && i.TypeDefs[k].Offset < r.Offset+r.Length {
+			k++
+		}
+		slices = appendSlice(slices, r)
+	}
+	return slices
+}
+
+// appendSlice appends s to slices, merging it with the last slice if the
+// two are adjacent.
+func appendSlice(slices []slicer.Slice, s slicer.Slice) []slicer.Slice {
+	if k := len(slices) - 1; k >= 0 && slices[k].Offset+slices[k].Length == s.Offset {
+		slices[k].Length += s.Length
+		return slices
+	}
+	return append(slices, s)
+}
+
+func LoadIndex(path string) (*Index, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var index Index
+	if err := json.Unmarshal(b, &index); err != nil {
+		return nil, err
+	}
+	return &index, nil
+}
@@ -0,0 +1,239 @@
+package bzngio_test
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/brimsec/zq/ast"
+	"github.com/brimsec/zq/filter"
+	"github.com/brimsec/zq/pkg/nano"
+	"github.com/brimsec/zq/pkg/slicer"
+	"github.com/brimsec/zq/zbuf"
+	"github.com/brimsec/zq/zio/bzngio"
+	"github.com/brimsec/zq/zio/zngio"
+	"github.com/brimsec/zq/zng/resolver"
+	"github.com/brimsec/zq/zql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// readSpan returns the records of the bzng stream r within span as zng text.
+func readSpan(t *testing.T, r io.Reader, span nano.Span) string {
+	return readMatches(t, r, span, nil)
+}
+
+// readMatches returns the records of the bzng stream r within span that
+// match f, which may be nil, as zng text.
+func readMatches(t *testing.T, r io.Reader, span nano.Span, f filter.Filter) string {
+	reader := bzngio.NewReader(r, resolver.NewContext())
+	var out bytes.Buffer
+	w := zngio.NewWriter(&out)
+	for {
+		rec, err := reader.Read()
+		require.NoError(t, err)
+		if rec == nil {
+			break
+		}
+		if (span.Dur == 0 || span.Contains(rec.Ts)) && (f == nil || f(rec)) {
+			require.NoError(t, w.Write(rec))
+		}
+	}
+	return out.String()
+}
+
+func TestIndex(t *testing.T) {
+	// Records written newest first whose second type is defined midway
+	// through the stream, after which both types are used.
+	var src strings.Builder
+	src.WriteString("#0:record[ts:time,n:int64]\n")
+	for n := 3000; n > 0; n-- {
+		if n == 2000 {
+			src.WriteString("#1:record[ts:time,s:string]\n")
+		}
+		if n < 2000 && n%2 == 0 {
+			fmt.Fprintf(&src, "1:[%d;s%d;]\n", n, n)
+		} else {
+			fmt.Fprintf(&src, "0:[%d;%d;]\n", n, n)
+		}
+	}
+	var buf bytes.Buffer
+	r := zngio.NewReader(strings.NewReader(src.String()), resolver.NewContext())
+	require.NoError(t, zbuf.Copy(zbuf.NopFlusher(bzngio.NewWriter(&buf)), r))
+	b := buf.Bytes()
+
+	index, err := bzngio.CreateIndex(bytes.NewReader(b), 100)
+	require.NoError(t, err)
+	assert.EqualValues(t, len(b), index.Size)
+	assert.Len(t, index.TypeDefs, 2)
+	fp, err := bzngio.Fingerprint(bytes.NewReader(b), int64(len(b)))
+	require.NoError(t, err)
+	assert.Equal(t, index.Fingerprint, fp)
+	// A change at either end of a file of the same size changes its
+	// fingerprint.
+	for _, off := range []int{1, len(b) - 2} {
+		changed := append([]byte(nil), b...)
+		changed[off]++
+		fp, err := bzngio.Fingerprint(bytes.NewReader(changed), int64(len(changed)))
+		require.NoError(t, err)
+		assert.NotEqual(t, index.Fingerprint, fp, "offset %d", off)
+	}
+
+	spans := []nano.Span{
+		nano.NewSpanTs(nano.Unix(10, 0), nano.Unix(20, 0)),
+		nano.NewSpanTs(nano.Unix(1500, 0), nano.Unix(1510, 0)),
+		nano.NewSpanTs(nano.Unix(1990, 0), nano.Unix(2010, 0)),
+		nano.NewSpanTs(nano.Unix(2990, 0), nano.Unix(4000, 0)),
+		nano.NewSpanTs(nano.Unix(5000, 0), nano.Unix(6000, 0)),
+		nano.MaxSpan,
+	}
+	for _, span := range spans {
+		slices := index.Slices(span, nil)
+		var n uint64
+		for _, s := range slices {
+			n += s.Length
+		}
+		if span != nano.MaxSpan {
+			assert.Less(t, n, uint64(len(b)/10), "span %s", span)
+		}
+		sr, err := slicer.NewReader(bytes.NewReader(b), slices)
+		require.NoError(t, err)
+		expected := readSpan(t, bytes.NewReader(b), span)
+		assert.Equal(t, expected, readSpan(t, sr, span), "span %s", span)
+	}
+}
+
+func TestIndexPruning(t *testing.T) {
+	var src strings.Builder
+	src.WriteString("#0:record[ts:time,uid:bstring,n:int64]\n")
+	for n := 0; n < 5000; n++ {
+		if n == 2500 {
+			src.WriteString("#1:record[ts:time,uid:bstring,a:array[int64]]\n")
+		}
+		if n >= 2500 && n%3 == 0 {
+			fmt.Fprintf(&src, "1:[%d;C%d;[%d;]]\n", n, n, n)
+		} else {
+			fmt.Fprintf(&src, "0:[%d;C%d;%d;]\n", n, n, n)
+		}
+	}
+	var buf bytes.Buffer
+	r := zngio.NewReader(strings.NewReader(src.String()), resolver.NewContext())
+	require.NoError(t, zbuf.Copy(zbuf.NopFlusher(bzngio.NewWriter(&buf)), r))
+	b := buf.Bytes()
+
+	index, err := bzngio.CreateIndex(bytes.NewReader(b), 100)
+	require.NoError(t, err)
+	assert.Len(t, index.Blocks, 5)
+
+	span := nano.NewSpanTs(nano.Unix(1000, 0), nano.Unix(4000, 0))
+	for _, query := range []string{"uid=C1500", "uid=C10 or uid=C4998", "n>=4990", "3003 in a", "a[0]=3006 or n=3"} {
+		proc, err := zql.ParseProc(query)
+		require.NoError(t, err)
+		e := proc.(*ast.FilterProc).Filter
+		f, err := filter.Compile(e)
+		require.NoError(t, err)
+		p := zbuf.NewPruner(e)
+		require.NotNil(t, p, "query %q", query)
+		for _, span := range []nano.Span{{}, span} {
+			slices := index.Slices(span, p)
+			var n uint64
+			for _, s := range slices {
+				n += s.Length
+			}
+			assert.Less(t, n, uint64(len(b)/2), "query %q span %s", query, span)
+			sr, err := slicer.NewReader(bytes.NewReader(b), slices)
+			require.NoError(t, err)
+			expected := readMatches(t, bytes.NewReader(b), span, f)
+			assert.Equal(t, expected, readMatches(t, sr, span, f), "query %q span %s", query, span)
+		}
+	}
+}
+
+// countingReader counts the bytes read from a bytes.Reader.
+type countingReader struct {
+	*bytes.Reader
+	n int
+}
+
+func (c *countingReader) Read(b []byte) (int, error) {
+	n, err := c.Reader.Read(b)
+	c.n += n
+	return n, err
+}
+
+// readReverse returns the records within span that match f, which may be
+// nil, read by a ReverseReader of b given index and p, as zng text, and
+// the number of bytes of b it read.
+func readReverse(t *testing.T, b []byte, span nano.Span, index *bzngio.Index, p *zbuf.Pruner, f filter.Filter) (string, int) {
+	c := &countingReader{Reader: bytes.NewReader(b)}
+	reader := bzngio.NewReverseReader(c, resolver.NewContext())
+	reader.SetSpan(span)
+	if index != nil {
+		reader.SetIndex(index, p)
+	}
+	var out bytes.Buffer
+	w := zngio.NewWriter(&out)
+	for {
+		rec, err := reader.Read()
+		require.NoError(t, err)
+		if rec == nil {
+			break
+		}
+		if (span.Dur == 0 || span.Contains(rec.Ts)) && (f == nil || f(rec)) {
+			require.NoError(t, w.Write(rec))
+		}
+	}
+	return out.String(), c.n
+}
+
+func TestReverseReaderIndex(t *testing.T) {
+	var src strings.Builder
+	src.WriteString("#0:record[ts:time,uid:bstring]\n")
+	for n := 5000; n > 0; n-- {
+		if n == 2500 {
+			src.WriteString("#1:record[ts:time,uid:bstring,n:int64]\n")
+		}
+		if n < 2500 && n%2 == 0 {
+			fmt.Fprintf(&src, "1:[%d;C%d;%d;]\n", n, n, n)
+		} else {
+			fmt.Fprintf(&src, "0:[%d;C%d;]\n", n, n)
+		}
+	}
+	var buf bytes.Buffer
+	r := zngio.NewReader(strings.NewReader(src.String()), resolver.NewContext())
+	require.NoError(t, zbuf.Copy(zbuf.NopFlusher(bzngio.NewWriter(&buf)), r))
+	b := buf.Bytes()
+
+	index, err := bzngio.CreateIndex(bytes.NewReader(b), 100)
+	require.NoError(t, err)
+
+	spans := []nano.Span{
+		nano.NewSpanTs(nano.Unix(10, 0), nano.Unix(20, 0)),
+		nano.NewSpanTs(nano.Unix(2490, 0), nano.Unix(2510, 0)),
+		nano.NewSpanTs(nano.Unix(6000, 0), nano.Unix(7000, 0)),
+		{},
+	}
+	for _, span := range spans {
+		expected, _ := readReverse(t, b, span, nil, nil, nil)
+		actual, n := readReverse(t, b, span, index, nil, nil)
+		assert.Equal(t, expected, actual, "span %s", span)
+		if span.Dur != 0 {
+			assert.Less(t, n, len(b)/4, "span %s", span)
+		}
+	}
+	full, _ := readReverse(t, b, nano.Span{}, nil, nil, nil)
+	assert.True(t, strings.HasPrefix(full, "#0:record[ts:time,uid:bstring]\n0:[1;C1;]\n"), "records not reversed")
+
+	proc, err := zql.ParseProc("uid=C1200")
+	require.NoError(t, err)
+	e := proc.(*ast.FilterProc).Filter
+	f, err := filter.Compile(e)
+	require.NoError(t, err)
+	expected, _ := readReverse(t, b, nano.Span{}, nil, nil, f)
+	assert.Equal(t, "#0:record[ts:time,uid:bstring,n:int64]\n0:[1200;C1200;1200;]\n", expected)
+	actual, n := readReverse(t, b, nano.Span{}, index, zbuf.NewPruner(e), f)
+	assert.Equal(t, expected, actual)
+	assert.Less(t, n, len(b)/4)
+}
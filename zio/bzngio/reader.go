@@ -8,6 +8,7 @@ import (
 
 	"github.com/brimsec/zq/pkg/nano"
 	"github.com/brimsec/zq/pkg/peeker"
+	"github.com/brimsec/zq/zbuf"
 	"github.com/brimsec/zq/zng"
 	"github.com/brimsec/zq/zng/resolver"
 )
@@ -43,14 +44,27 @@ func (r *Reader) Read() (*zng.Record, error) {
 		if rec == nil {
 			return nil, err
 		}
-		id := rec.Type.ID()
-		sharedType := r.mapper.Map(id)
-		if sharedType == nil {
-			sharedType = r.mapper.Enter(id, rec.Type)
+		sharedType, err := r.sharedType(rec.Type)
+		if err != nil {
+			return nil, err
 		}
 		rec.Type = sharedType
-		return rec, err
+		return rec, nil
+	}
+}
+
+// sharedType returns the type in the reader's shared context that
+// corresponds to typ.
+func (r *Reader) sharedType(typ *zng.TypeRecord) (*zng.TypeRecord, error) {
+	id := typ.ID()
+	sharedType := r.mapper.Map(id)
+	if sharedType == nil {
+		sharedType = r.mapper.Enter(id, typ)
+		if sharedType == nil {
+			return nil, zng.ErrDescriptorInvalid
+		}
 	}
+	return sharedType, nil
 }
 
 // ReadPayload returns either data values as zbuf.Record or control payloads
@@ -58,6 +72,20 @@ func (r *Reader) Read() (*zng.Record, error) {
 // copied (via copy for byte slice or zbuf.Record.Keep()) before any subsequent
 // calls to Read or ReadPayload can be made.
 func (r *Reader) ReadPayload() (*zng.Record, []byte, error) {
+	rec, b, err := r.readPayload()
+	if rec == nil || err != nil {
+		return nil, b, err
+	}
+	rec, err = newRecord(rec)
+	if err != nil {
+		return nil, nil, err
+	}
+	return rec, nil, nil
+}
+
+// readPayload is like ReadPayload but does not check the body of a data
+// value against its type or set its timestamp.
+func (r *Reader) readPayload() (*zng.Record, []byte, error) {
 again:
 	b, err := r.peeker.Read(1)
 	if err == io.EOF || len(b) == 0 {
@@ -65,31 +93,20 @@ again:
 	}
 	code := b[0]
 	if code&0x80 != 0 {
-		switch code {
-		case zng.TypeDefRecord:
-			err = r.readTypeRecord()
-		case zng.TypeDefSet:
-			err = r.readTypeSet()
-		case zng.TypeDefArray:
-			err = r.readTypeArray()
-		case zng.TypeDefUnion:
-			err = r.readTypeUnion()
-		case zng.TypeDefAlias:
-			err = r.readTypeAlias()
-		default:
-			// XXX we should return the control code
-			len, err := r.readUvarint()
-			if err != nil {
-				return nil, nil, zng.ErrBadFormat
-			}
-			b, err = r.peeker.Read(len)
-			return nil, b, err
-		}
+		ok, err := r.readTypeDef(code)
 		if err != nil {
 			return nil, nil, err
 		}
-		goto again
-
+		if ok {
+			goto again
+		}
+		// XXX we should return the control code
+		len, err := r.readUvarint()
+		if err != nil {
+			return nil, nil, zng.ErrBadFormat
+		}
+		b, err = r.peeker.Read(len)
+		return nil, b, err
 	}
 	// read uvarint7 encoding of type ID
 	var id int
@@ -110,11 +127,95 @@ again:
 	if err != nil && err != io.EOF {
 		return nil, nil, zng.ErrBadFormat
 	}
-	rec, err := r.parseValue(int(id), b)
-	if err != nil {
-		return nil, nil, err
+	typ := r.zctx.Lookup(int(id))
+	if typ == nil {
+		return nil, nil, zng.ErrDescriptorInvalid
 	}
-	return rec, nil, nil
+	return zng.NewVolatileRecord(typ, nano.MinTs, b), nil, nil
+}
+
+// readTypeDef reads the typedef following code and returns true or
+// returns false if code is not a typedef.
+func (r *Reader) readTypeDef(code byte) (bool, error) {
+	switch code {
+	case zng.TypeDefRecord:
+		return true, r.readTypeRecord()
+	case zng.TypeDefSet:
+		return true, r.readTypeSet()
+	case zng.TypeDefArray:
+		return true, r.readTypeArray()
+	case zng.TypeDefUnion:
+		return true, r.readTypeUnion()
+	case zng.TypeDefAlias:
+		return true, r.readTypeAlias()
+	}
+	return false, nil
+}
+
+// ReadChunk implements zbuf.Chunker.  Type definitions and control
+// payloads are processed by ReadChunk so that each value of a chunk
+// carries its shared type and is checked against it when the chunk is
+// decoded.
+func (r *Reader) ReadChunk() (zbuf.Chunk, error) {
+	c := &chunk{}
+	for len(c.types) < zbuf.ChunkLen {
+		rec, b, err := r.readPayload()
+		if err != nil {
+			return nil, err
+		}
+		if b != nil {
+			continue
+		}
+		if rec == nil {
+			break
+		}
+		typ, err := r.sharedType(rec.Type)
+		if err != nil {
+			return nil, err
+		}
+		c.buf = append(c.buf, rec.Raw...)
+		c.types = append(c.types, typ)
+		c.ends = append(c.ends, len(c.buf))
+	}
+	if len(c.types) == 0 {
+		return nil, nil
+	}
+	return c, nil
+}
+
+// chunk holds the bodies of values and their shared types.  If reversed
+// is true, the chunk decodes to its records in reverse order.
+type chunk struct {
+	buf      []byte
+	types    []*zng.TypeRecord
+	ends     []int
+	reversed bool
+}
+
+func (c *chunk) Decode(p *zbuf.Prefilter) ([]*zng.Record, error) {
+	if !p.Match(zbuf.BZNG, c.buf) {
+		return nil, nil
+	}
+	recs := make([]*zng.Record, 0, len(c.types))
+	var off int
+	for k, typ := range c.types {
+		body := c.buf[off:c.ends[k]]
+		off = c.ends[k]
+		if !p.Match(zbuf.BZNG, body) {
+			continue
+		}
+		rec, err := newRecord(zng.NewRecordTs(typ, nano.MinTs, body))
+		if err != nil {
+			return nil, err
+		}
+		recs = append(recs, rec)
+	}
+	if c.reversed {
+		for i, j := 0, len(recs)-1; i < j; i, j = i+1, j-1 {
+			recs[i], recs[j] = recs[j], recs[i]
+		}
+	}
+	return recs, nil
 }
 
 func (r *Reader) readUvarint() (int, error) {
@@ -254,12 +355,9 @@ func (r *Reader) readTypeAlias() error {
 	return nil
 }
 
-func (r *Reader) parseValue(id int, b []byte) (*zng.Record, error) {
-	typ := r.zctx.Lookup(id)
-	if typ == nil {
-		return nil, zng.ErrDescriptorInvalid
-	}
-	record := zng.NewVolatileRecord(typ, nano.MinTs, b)
+// newRecord checks the body of record against its type and sets its
+// timestamp.
+func newRecord(record *zng.Record) (*zng.Record, error) {
 	if err := record.TypeCheck(); err != nil {
 		return nil, err
 	}
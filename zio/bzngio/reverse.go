@@ -0,0 +1,217 @@
+package bzngio
+
+import (
+	"io"
+
+	"github.com/brimsec/zq/pkg/nano"
+	"github.com/brimsec/zq/pkg/peeker"
+	"github.com/brimsec/zq/zbuf"
+	"github.com/brimsec/zq/zng"
+	"github.com/brimsec/zq/zng/resolver"
+)
+
+// ReverseReader reads the records of a bzng stream from last to first.
+// Before it returns its first record, it scans the stream once to build
+// an index of blocks of up to zbuf.ChunkLen values, which also defines
+// every type in the stream, and then reads the blocks from last to
+// first.  If the stream has an up-to-date Index, SetIndex takes the
+// blocks from it instead so that only the typedefs are read ahead of the
+// blocks.  Since a sorted stream is read back in the opposite order,
+// a ReverseReader lets a search stream its results in either time
+// order from a single sorted file.
+type ReverseReader struct {
+	reader  *Reader
+	seeker  io.ReadSeeker
+	span    nano.Span
+	blocks  []block
+	indexed bool
+	// index is the Index set by SetIndex, if any.
+	index *Index
+	recs  []*zng.Record
+}
+
+// A block is a run of payloads holding up to zbuf.ChunkLen values whose
+// timestamps are between minTs and maxTs.
+type block struct {
+	off   int64
+	len   int64
+	minTs nano.Ts
+	maxTs nano.Ts
+}
+
+func NewReverseReader(seeker io.ReadSeeker, zctx *resolver.Context) *ReverseReader {
+	return &ReverseReader{
+		reader: NewReader(nil, zctx),
+		seeker: seeker,
+	}
+}
+
+// SetSpan restricts the reader to blocks holding values within span.
+// Other values of those blocks are still returned.
+func (r *ReverseReader) SetSpan(span nano.Span) {
+	r.span = span
+}
+
+// SetIndex takes the blocks of the stream from index, which must be up to
+// date, rather than from a scan of the stream and skips the blocks whose
+// summaries p rules out.  It must be called before the first read.
+func (r *ReverseReader) SetIndex(index *Index, p *zbuf.Pruner) {
+	r.index = index
+	r.blocks = nil
+	for _, b := range index.Blocks {
+		if !p.Match(b.Summary) {
+			continue
+		}
+		// A block without a ts summary may hold records at any time.
+		blk := block{
+			off:   int64(b.Offset),
+			len:   int64(b.Length),
+			minTs: nano.MinTs,
+			maxTs: nano.MaxTs,
+		}
+		if b.Summary != nil {
+			if ts, ok := b.Summary.Times["ts"]; ok {
+				blk.minTs, blk.maxTs = nano.Ts(ts.Min), nano.Ts(ts.Max)
+			}
+		}
+		r.blocks = append(r.blocks, blk)
+	}
+}
+
+func (r *ReverseReader) Read() (*zng.Record, error) {
+	for len(r.recs) == 0 {
+		chunk, err := r.ReadChunk()
+		if chunk == nil || err != nil {
+			return nil, err
+		}
+		r.recs, err = chunk.Decode(nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+	rec := r.recs[0]
+	r.recs = r.recs[1:]
+	return rec, nil
+}
+
+// ReadChunk implements zbuf.Chunker.  Each chunk holds the values of a
+// block in reverse order.
+func (r *ReverseReader) ReadChunk() (zbuf.Chunk, error) {
+	if !r.indexed {
+		scan := r.scan
+		if r.index != nil {
+			scan = r.readTypeDefs
+		}
+		if err := scan(); err != nil {
+			return nil, err
+		}
+		r.indexed = true
+	}
+	for len(r.blocks) > 0 {
+		b := r.blocks[len(r.blocks)-1]
+		r.blocks = r.blocks[:len(r.blocks)-1]
+		if r.span.Dur != 0 && (b.maxTs < r.span.Ts || b.minTs >= r.span.End()) {
+			continue
+		}
+		if _, err := r.seeker.Seek(b.off, io.SeekStart); err != nil {
+			return nil, err
+		}
+		// The types of the block were defined while indexing so any
+		// definitions in the block are looked up again rather than
+		// added.
+		r.reader.peeker = peeker.NewReader(io.LimitReader(r.seeker, b.len), ReadSize, MaxSize)
+		c, err := r.reader.ReadChunk()
+		if err != nil {
+			return nil, err
+		}
+		if c != nil {
+			c.(*chunk).reversed = true
+			return c, nil
+		}
+	}
+	return nil, nil
+}
+
+// counter counts the bytes read from a reader.
+type counter struct {
+	io.Reader
+	n int64
+}
+
+func (c *counter) Read(b []byte) (int, error) {
+	n, err := c.Reader.Read(b)
+	c.n += int64(n)
+	return n, err
+}
+
+// readTypeDefs reads the typedefs located by the index so that every type
+// of the stream is defined before its blocks are read.
+func (r *ReverseReader) readTypeDefs() error {
+	for _, s := range r.index.TypeDefs {
+		if _, err := r.seeker.Seek(int64(s.Offset), io.SeekStart); err != nil {
+			return err
+		}
+		r.reader.peeker = peeker.NewReader(io.LimitReader(r.seeker, int64(s.Length)), ReadSize, MaxSize)
+		for {
+			rec, ctrl, err := r.reader.readPayload()
+			if err != nil {
+				return err
+			}
+			if rec == nil && ctrl == nil {
+				break
+			}
+		}
+	}
+	return nil
+}
+
+// scan reads the stream from its start to build its blocks.
+func (r *ReverseReader) scan() error {
+	if _, err := r.seeker.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	c := &counter{Reader: r.seeker}
+	r.reader.peeker = peeker.NewReader(c, ReadSize, MaxSize)
+	offset := func() int64 {
+		return c.n - int64(r.reader.peeker.Buffered())
+	}
+	var b block
+	var n int
+	for {
+		off := offset()
+		rec, ctrl, err := r.reader.readPayload()
+		if err != nil {
+			return err
+		}
+		if ctrl != nil {
+			continue
+		}
+		if rec == nil {
+			break
+		}
+		ts, err := rec.AccessTime("ts")
+		if err != nil {
+			ts = nano.MinTs
+		}
+		if n == 0 {
+			b = block{off: off, minTs: ts, maxTs: ts}
+		}
+		if ts < b.minTs {
+			b.minTs = ts
+		}
+		if ts > b.maxTs {
+			b.maxTs = ts
+		}
+		n++
+		if n == zbuf.ChunkLen {
+			b.len = offset() - b.off
+			r.blocks = append(r.blocks, b)
+			n = 0
+		}
+	}
+	if n > 0 {
+		b.len = offset() - b.off
+		r.blocks = append(r.blocks, b)
+	}
+	return nil
+}
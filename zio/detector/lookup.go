@@ -12,6 +12,7 @@ import (
 	"github.com/brimsec/zq/zio/zeekio"
 	"github.com/brimsec/zq/zio/zjsonio"
 	"github.com/brimsec/zq/zio/zngio"
+	"github.com/brimsec/zq/zio/zstio"
 	"github.com/brimsec/zq/zng"
 	"github.com/brimsec/zq/zng/resolver"
 )
@@ -47,6 +48,8 @@ func LookupWriter(format string, w io.WriteCloser, optionalFlags *zio.Flags) *zi
 		f = zbuf.NopFlusher(textio.NewWriter(w, flags))
 	case "table":
 		f = tableio.NewWriter(w, flags)
+	case "zst":
+		f = zstio.NewWriter(w)
 	}
 	return &zio.Writer{
 		WriteFlusher: f,
@@ -66,6 +69,8 @@ func LookupReader(format string, r io.Reader, zctx *resolver.Context) (zbuf.Read
 		return zjsonio.NewReader(r, zctx), nil
 	case "bzng":
 		return bzngio.NewReader(r, zctx), nil
+	case "zst":
+		return zstio.NewReader(r, zctx), nil
 	}
 	return nil, nil
 }
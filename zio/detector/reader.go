@@ -10,6 +10,7 @@ import (
 	"github.com/brimsec/zq/zio/zeekio"
 	"github.com/brimsec/zq/zio/zjsonio"
 	"github.com/brimsec/zq/zio/zngio"
+	"github.com/brimsec/zq/zio/zstio"
 	"github.com/brimsec/zq/zng/resolver"
 )
 
@@ -18,6 +19,12 @@ var ErrUnknown = errors.New("malformed input")
 func NewReader(r io.Reader, zctx *resolver.Context) (zbuf.Reader, error) {
 	recorder := NewRecorder(r)
 	track := NewTrack(recorder)
+	// zst is recognized by its magic rather than by reading a record,
+	// which may lie beyond the first of its column chunks.
+	if zstio.IsZst(track) {
+		return zstio.NewReader(recorder, zctx), nil
+	}
+	track.Reset()
 	if match(zngio.NewReader(track, resolver.NewContext())) {
 		return zngio.NewReader(recorder, zctx), nil
 	}
@@ -10,8 +10,10 @@ import (
 	"io"
 
 	"github.com/brimsec/zq/pkg/skim"
+	"github.com/brimsec/zq/zbuf"
 	"github.com/brimsec/zq/zio/zjsonio"
 	"github.com/brimsec/zq/zng"
+	"github.com/brimsec/zq/zng/inferjson"
 	"github.com/brimsec/zq/zng/resolver"
 	"github.com/buger/jsonparser"
 )
@@ -28,7 +30,7 @@ type ReadStats struct {
 
 type Reader struct {
 	scanner *skim.Scanner
-	inf     inferParser
+	inf     *inferjson.Parser
 	typ     *typeParser
 	zctx    *resolver.Context
 	stats   ReadStats
@@ -45,7 +47,7 @@ func NewReader(reader io.Reader, zctx *resolver.Context) (*Reader, error) {
 	return &Reader{
 		scanner: scanner,
 		stats:   ReadStats{Stats: &scanner.Stats, typeStats: &typeStats{}},
-		inf:     inferParser{zctx},
+		inf:     inferjson.NewParser(zctx),
 		zctx:    zctx,
 	}, nil
 }
@@ -76,8 +78,8 @@ type typeRules struct {
 
 // SetTypeConfig adds a TypeConfig to the reader. Its use is optional,
 // but if used, it should be called before records are processed.  In
-// the absence of a TypeConfig, records are all parsed with the
-// inferParser. If a TypeConfig is present, records are parsed
+// the absence of a TypeConfig, records are all parsed with an
+// inferjson.Parser. If a TypeConfig is present, records are parsed
 // with the typeParser.
 func (r *Reader) SetTypeConfig(tc TypeConfig) error {
 	tr := typeRules{
@@ -123,7 +125,7 @@ func (r *Reader) Parse(b []byte) (zng.Value, error) {
 	if r.typ != nil {
 		return r.typ.parseObject(val)
 	}
-	return r.inf.parseObject(val)
+	return r.inf.ParseObject(val)
 }
 
 func (r *Reader) Read() (*zng.Record, error) {
@@ -144,3 +146,85 @@ again:
 	outType := r.zctx.LookupTypeRecord(zv.Type.(*zng.TypeRecord).Columns)
 	return zng.NewRecordCheck(outType, 0, zv.Bytes)
 }
+
+// ReadChunk implements zbuf.Chunker.  The lines of a chunk are parsed when
+// it is decoded unless the reader has a TypeConfig, whose parser keeps
+// state across lines, in which case they are parsed by ReadChunk.
+func (r *Reader) ReadChunk() (zbuf.Chunk, error) {
+	if r.typ != nil {
+		var recs zbuf.Records
+		for len(recs) < zbuf.ChunkLen {
+			rec, err := r.Read()
+			if err != nil {
+				return nil, err
+			}
+			if rec == nil {
+				break
+			}
+			recs = append(recs, rec)
+		}
+		if recs == nil {
+			return nil, nil
+		}
+		return recs, nil
+	}
+	c := &chunk{zctx: r.zctx}
+	for len(c.ends) < zbuf.ChunkLen {
+		line, err := r.scanner.ScanLine()
+		if line == nil {
+			if err != nil {
+				return nil, err
+			}
+			break
+		}
+		line = bytes.TrimSpace(line)
+		// skip empty lines
+		if len(line) == 0 {
+			continue
+		}
+		c.buf = append(c.buf, line...)
+		c.ends = append(c.ends, len(c.buf))
+		c.lines = append(c.lines, r.scanner.Stats.Lines)
+	}
+	if len(c.ends) == 0 {
+		return nil, nil
+	}
+	return c, nil
+}
+
+// chunk holds lines of JSON objects whose types are inferred.
+type chunk struct {
+	zctx  *resolver.Context
+	buf   []byte
+	ends  []int
+	lines []int
+}
+
+func (c *chunk) Decode(p *zbuf.Prefilter) ([]*zng.Record, error) {
+	if !p.Match(zbuf.NDJSON, c.buf) {
+		return nil, nil
+	}
+	// Parse never uses the Reader's scanner or stats when it has no
+	// TypeConfig so a Reader of our own is safe to use here.
+	r := &Reader{inf: inferjson.NewParser(c.zctx), zctx: c.zctx}
+	recs := make([]*zng.Record, 0, len(c.ends))
+	var off int
+	for k, end := range c.ends {
+		line := c.buf[off:end]
+		off = end
+		if !p.Match(zbuf.NDJSON, line) {
+			continue
+		}
+		zv, err := r.Parse(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", c.lines[k], err)
+		}
+		outType := c.zctx.LookupTypeRecord(zv.Type.(*zng.TypeRecord).Columns)
+		rec, err := zng.NewRecordCheck(outType, 0, zv.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		recs = append(recs, rec)
+	}
+	return recs, nil
+}
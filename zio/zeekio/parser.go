@@ -164,62 +164,19 @@ func (p *Parser) ParseDirective(line []byte) error {
 
 // Unflatten() turns a set of columns from legacy zeek logs into a
 // zng-compatible format by creating nested records for any dotted
-// field names. If addpath is true, a _path column is added if not
-// already present. The columns are returned as a slice along with a
-// bool indicating if a _path column was added.
-// Note that according to the zng spec, all the fields for a nested
-// record must be adjacent which simplifies the logic here.
+// field names (see resolver.Context.Unflatten). If addpath is true, a
+// _path column is added if not already present. The columns are
+// returned as a slice along with a bool indicating if a _path column
+// was added.
 func Unflatten(zctx *resolver.Context, columns []zng.Column, addPath bool) ([]zng.Column, bool) {
 	hasPath := false
-	cols := make([]zng.Column, 0)
-	var nestedCols []zng.Column
-	var nestedField string
 	for _, col := range columns {
 		// XXX could validate field names here...
 		if col.Name == "_path" {
 			hasPath = true
 		}
-
-		var fld string
-		dot := strings.IndexByte(col.Name, '.')
-		if dot >= 0 {
-			fld = col.Name[:dot]
-		}
-
-		// Check if we're entering or leaving a nested record.
-		if fld != nestedField {
-			if len(nestedField) > 0 {
-				// We've reached the end of a nested record.
-				recType := zctx.LookupTypeRecord(nestedCols)
-				newcol := zng.NewColumn(nestedField, recType)
-				cols = append(cols, newcol)
-			}
-
-			if len(fld) > 0 {
-				// We're entering a new nested record.
-				nestedCols = make([]zng.Column, 0)
-			}
-			nestedField = fld
-		}
-
-		if len(fld) == 0 {
-			// Just a regular field.
-			cols = append(cols, col)
-		} else {
-			// Add to the nested record.
-			newcol := zng.NewColumn(col.Name[dot+1:], col.Type)
-			nestedCols = append(nestedCols, newcol)
-		}
 	}
-
-	// If we were in the midst of a nested record, make sure we
-	// account for it.
-	if len(nestedField) > 0 {
-		recType := zctx.LookupTypeRecord(nestedCols)
-		newcol := zng.NewColumn(nestedField, recType)
-		cols = append(cols, newcol)
-	}
-
+	cols := zctx.Unflatten(columns)
 	var needpath bool
 	if addPath && !hasPath {
 		pathcol := zng.NewColumn("_path", zng.TypeString)
@@ -258,10 +215,24 @@ func (p *Parser) Descriptor() (*zng.TypeRecord, bool) {
 }
 
 func (p *Parser) ParseValue(line []byte) (*zng.Record, error) {
+	typ, path, err := p.valueType()
+	if err != nil {
+		return nil, err
+	}
+	zv, err := zbuf.NewRawFromZeekTSV(p.builder, typ, path, line)
+	if err != nil {
+		return nil, err
+	}
+	return zng.NewRecord(typ, zv)
+}
+
+// valueType returns the descriptor of the values that follow the
+// directives parsed so far and the _path to add to each value, if any.
+func (p *Parser) valueType() (*zng.TypeRecord, []byte, error) {
 	if p.descriptor == nil {
 		err := p.setDescriptor()
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 	}
 	var path []byte
@@ -270,9 +241,5 @@ func (p *Parser) ParseValue(line []byte) (*zng.Record, error) {
 		// each time here
 		path = []byte(p.Path)
 	}
-	zv, err := zbuf.NewRawFromZeekTSV(p.builder, p.descriptor, path, line)
-	if err != nil {
-		return nil, err
-	}
-	return zng.NewRecord(p.descriptor, zv)
+	return p.descriptor, path, nil
 }
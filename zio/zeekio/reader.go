@@ -6,6 +6,8 @@ import (
 	"io"
 
 	"github.com/brimsec/zq/pkg/skim"
+	"github.com/brimsec/zq/zbuf"
+	"github.com/brimsec/zq/zcode"
 	"github.com/brimsec/zq/zng"
 	"github.com/brimsec/zq/zng/resolver"
 )
@@ -53,3 +55,75 @@ again:
 	}
 	return r.parser.ParseValue(line)
 }
+
+// ReadChunk implements zbuf.Chunker.  A chunk ends before a directive
+// that may change the descriptor of the values that follow it.
+func (r *Reader) ReadChunk() (zbuf.Chunk, error) {
+	var c *chunk
+	for c == nil || len(c.ends) < zbuf.ChunkLen {
+		line, err := r.scanner.ScanLine()
+		if line == nil {
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", r.scanner.Stats.Lines, err)
+			}
+			break
+		}
+		line = bytes.TrimSpace(line)
+		if line[0] == '#' {
+			if err := r.parser.ParseDirective(line); err != nil {
+				return nil, err
+			}
+			if c != nil {
+				break
+			}
+			continue
+		}
+		if c == nil {
+			typ, path, err := r.parser.valueType()
+			if err != nil {
+				return nil, err
+			}
+			c = &chunk{typ: typ, path: path}
+		}
+		c.buf = append(c.buf, line...)
+		c.ends = append(c.ends, len(c.buf))
+	}
+	if c == nil {
+		return nil, nil
+	}
+	return c, nil
+}
+
+// chunk holds lines of values that share a descriptor.
+type chunk struct {
+	typ  *zng.TypeRecord
+	path []byte
+	buf  []byte
+	ends []int
+}
+
+func (c *chunk) Decode(p *zbuf.Prefilter) ([]*zng.Record, error) {
+	if !p.MatchWith(zbuf.ZeekTSV, c.path, c.buf) {
+		return nil, nil
+	}
+	builder := zcode.NewBuilder()
+	recs := make([]*zng.Record, 0, len(c.ends))
+	var off int
+	for _, end := range c.ends {
+		line := c.buf[off:end]
+		off = end
+		if !p.MatchWith(zbuf.ZeekTSV, c.path, line) {
+			continue
+		}
+		zv, err := zbuf.NewRawFromZeekTSV(builder, c.typ, c.path, line)
+		if err != nil {
+			return nil, err
+		}
+		rec, err := zng.NewRecord(c.typ, zv)
+		if err != nil {
+			return nil, err
+		}
+		recs = append(recs, rec)
+	}
+	return recs, nil
+}
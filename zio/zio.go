@@ -50,6 +50,8 @@ func Extension(format string) string {
 		return ".tbl"
 	case "bzng":
 		return ".bzng"
+	case "zst":
+		return ".zst"
 	default:
 		return ""
 	}
@@ -0,0 +1,302 @@
+// Package zstio reads and writes zst, a columnar format for zng streams.
+//
+// A zst stream begins with the magic "ZST\x01" followed by a sequence of
+// segments, each holding a run of records.  A segment has a header and a
+// body.  The header is preceded by its length as a uvarint and holds:
+//
+//	the number of aliases, then the name and type of each alias
+//	the number of record types, then the type of each record type
+//	the number of records
+//	the length of the type column
+//	the length of each column chunk of each record type in order
+//
+// where each number and length is a uvarint and each name and type is a
+// string preceded by its length.  The aliases and record types form the
+// dictionary of the types of the segment, and each record type is known
+// within the segment by its position in the dictionary.  An alias precedes
+// any alias or record type that refers to it.
+//
+// The body holds the type column, which is the local type ID of each record
+// in order as a uvarint, followed by the column chunks.  The column chunk of
+// a column of a record type holds the zcode value of that column in each of
+// the records of that type in order.  A nested record is held in a single
+// column.
+//
+// A Reader whose fields are set reads only the column chunks of those
+// fields, skipping over the rest, so a query that touches few fields of
+// wide records reads a small part of the stream.
+package zstio
+
+import (
+	"encoding/binary"
+	"io"
+	"io/ioutil"
+
+	"github.com/brimsec/zq/zcode"
+	"github.com/brimsec/zq/zng"
+	"github.com/brimsec/zq/zng/resolver"
+)
+
+const magic = "ZST\x01"
+
+// IsZst returns true if the stream read by r begins with the zst magic.
+// It reads from r.
+func IsZst(r io.Reader) bool {
+	b := make([]byte, len(magic))
+	_, err := io.ReadFull(r, b)
+	return err == nil && string(b) == magic
+}
+
+// segmentType is a record type of the current segment.
+type segmentType struct {
+	// typ is the type of the records read, which has only the columns
+	// of the fields of the Reader.
+	typ *zng.TypeRecord
+	// columns holds the unread part of the column chunk of each
+	// column of typ.
+	columns []zcode.Iter
+}
+
+type Reader struct {
+	reader     io.Reader
+	zctx       *resolver.Context
+	fields     map[string]struct{}
+	readMagic  bool
+	types      []segmentType
+	order      []byte
+	nrecs      int
+	byteBuffer [1]byte
+}
+
+func NewReader(reader io.Reader, zctx *resolver.Context) *Reader {
+	return &Reader{
+		reader: reader,
+		zctx:   zctx,
+	}
+}
+
+// SetFields limits the records read to the top-level fields named by
+// fields and the ts field, which is kept since it orders records merged
+// from several inputs and places them within a span.  Records are read
+// whole if fields is nil.  SetFields must be called before the first Read.
+func (r *Reader) SetFields(fields []string) {
+	if fields == nil {
+		r.fields = nil
+		return
+	}
+	r.fields = map[string]struct{}{"ts": {}}
+	for _, f := range fields {
+		r.fields[f] = struct{}{}
+	}
+}
+
+func (r *Reader) Read() (*zng.Record, error) {
+	for r.nrecs == 0 {
+		err := r.readSegment()
+		if err == io.EOF {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	id, n := binary.Uvarint(r.order)
+	if n <= 0 || int(id) >= len(r.types) {
+		return nil, zng.ErrBadFormat
+	}
+	r.order = r.order[n:]
+	r.nrecs--
+	t := &r.types[id]
+	var raw zcode.Bytes
+	for k := range t.columns {
+		if t.columns[k].Done() {
+			return nil, zng.ErrBadFormat
+		}
+		val, _, err := t.columns[k].NextTagAndBody()
+		if err != nil {
+			return nil, err
+		}
+		raw = append(raw, val...)
+	}
+	return zng.NewRecord(t.typ, raw)
+}
+
+// readSegment reads the header of the next segment and the type column
+// and column chunks that the records read from it need.  It returns
+// io.EOF at the end of the stream.
+func (r *Reader) readSegment() error {
+	if !r.readMagic {
+		b := make([]byte, len(magic))
+		if _, err := io.ReadFull(r.reader, b); err != nil {
+			if err == io.EOF {
+				return err
+			}
+			return zng.ErrBadFormat
+		}
+		if string(b) != magic {
+			return zng.ErrBadFormat
+		}
+		r.readMagic = true
+	}
+	n, err := r.readUvarint()
+	if err != nil {
+		return err
+	}
+	hdr, err := r.readFull(n)
+	if err != nil {
+		return err
+	}
+	d := decoder(hdr)
+	naliases := d.uvarint()
+	for k := 0; k < naliases && d != nil; k++ {
+		name, target := d.string(), d.string()
+		typ, err := r.zctx.LookupByName(target)
+		if err != nil {
+			return err
+		}
+		if _, err := r.zctx.LookupTypeAlias(name, typ); err != nil {
+			return err
+		}
+	}
+	ntypes := d.uvarint()
+	var types []*zng.TypeRecord
+	for k := 0; k < ntypes && d != nil; k++ {
+		typ, err := r.zctx.LookupByName(d.string())
+		if err != nil {
+			return err
+		}
+		recType, ok := typ.(*zng.TypeRecord)
+		if !ok {
+			return zng.ErrBadFormat
+		}
+		types = append(types, recType)
+	}
+	nrecs := d.uvarint()
+	orderLen := d.uvarint()
+	lens := make([][]int, len(types))
+	for k, typ := range types {
+		lens[k] = make([]int, len(typ.Columns))
+		for c := range typ.Columns {
+			lens[k][c] = d.uvarint()
+		}
+	}
+	if d == nil || len(d) != 0 {
+		return zng.ErrBadFormat
+	}
+	if r.order, err = r.readFull(orderLen); err != nil {
+		return err
+	}
+	r.types = r.types[:0]
+	for k, typ := range types {
+		var cols []zng.Column
+		var chunks []zcode.Iter
+		for c, col := range typ.Columns {
+			if !r.wants(col.Name) {
+				if err := r.skip(lens[k][c]); err != nil {
+					return err
+				}
+				continue
+			}
+			chunk, err := r.readFull(lens[k][c])
+			if err != nil {
+				return err
+			}
+			cols = append(cols, col)
+			chunks = append(chunks, zcode.Iter(chunk))
+		}
+		outType := typ
+		if len(cols) != len(typ.Columns) {
+			outType = r.zctx.LookupTypeRecord(cols)
+		}
+		r.types = append(r.types, segmentType{outType, chunks})
+	}
+	r.nrecs = nrecs
+	return nil
+}
+
+func (r *Reader) wants(field string) bool {
+	if r.fields == nil {
+		return true
+	}
+	_, ok := r.fields[field]
+	return ok
+}
+
+// readUvarint reads a uvarint one byte at a time so that it does not read
+// past it.  It returns io.EOF if the stream ends before the uvarint.
+func (r *Reader) readUvarint() (int, error) {
+	var u64 uint64
+	for shift := uint(0); shift < 64; shift += 7 {
+		if _, err := io.ReadFull(r.reader, r.byteBuffer[:]); err != nil {
+			if err == io.EOF && shift == 0 {
+				return 0, io.EOF
+			}
+			return 0, zng.ErrBadFormat
+		}
+		b := r.byteBuffer[0]
+		u64 |= uint64(b&0x7f) << shift
+		if b < 0x80 {
+			return int(u64), nil
+		}
+	}
+	return 0, zng.ErrBadFormat
+}
+
+func (r *Reader) readFull(n int) ([]byte, error) {
+	if n < 0 {
+		return nil, zng.ErrBadFormat
+	}
+	b := make([]byte, n)
+	if _, err := io.ReadFull(r.reader, b); err != nil {
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return nil, zng.ErrBadFormat
+		}
+		return nil, err
+	}
+	return b, nil
+}
+
+// skip skips over the next n bytes of the stream, seeking past them if
+// the stream is seekable.
+func (r *Reader) skip(n int) error {
+	if n <= 0 {
+		if n < 0 {
+			return zng.ErrBadFormat
+		}
+		return nil
+	}
+	if s, ok := r.reader.(io.Seeker); ok {
+		_, err := s.Seek(int64(n), io.SeekCurrent)
+		return err
+	}
+	cc, err := io.CopyN(ioutil.Discard, r.reader, int64(n))
+	if cc < int64(n) && err == io.EOF {
+		return zng.ErrBadFormat
+	}
+	return err
+}
+
+// decoder decodes the fields of a segment header.  It becomes nil once a
+// field is malformed.
+type decoder []byte
+
+func (d *decoder) uvarint() int {
+	u64, n := binary.Uvarint(*d)
+	if n <= 0 {
+		*d = nil
+		return 0
+	}
+	*d = (*d)[n:]
+	return int(u64)
+}
+
+func (d *decoder) string() string {
+	n := d.uvarint()
+	if *d == nil || n > len(*d) {
+		*d = nil
+		return ""
+	}
+	s := string((*d)[:n])
+	*d = (*d)[n:]
+	return s
+}
@@ -0,0 +1,155 @@
+package zstio
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/brimsec/zq/zcode"
+	"github.com/brimsec/zq/zng"
+)
+
+// SegmentSize is the size of the record data that a Writer buffers before
+// writing it as a segment.
+const SegmentSize = 8 * 1024 * 1024
+
+// Writer writes records in zst format.  It buffers the records of each
+// segment so Flush must be called to write the last one.
+type Writer struct {
+	io.Writer
+	wroteMagic bool
+	// types holds the record types of the segment by local ID and ids
+	// maps each of them back to its local ID.
+	types []*zng.TypeRecord
+	ids   map[*zng.TypeRecord]int
+	// order holds the local type ID of each record of the segment as
+	// a uvarint and columns holds the column chunks of each type.
+	order   []byte
+	columns [][][]byte
+	nrecs   int
+	size    int
+}
+
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{
+		Writer: w,
+		ids:    make(map[*zng.TypeRecord]int),
+	}
+}
+
+func (w *Writer) Write(r *zng.Record) error {
+	id, ok := w.ids[r.Type]
+	if !ok {
+		id = len(w.types)
+		w.ids[r.Type] = id
+		w.types = append(w.types, r.Type)
+		w.columns = append(w.columns, make([][]byte, len(r.Type.Columns)))
+	}
+	w.order = zcode.AppendUvarint(w.order, uint64(id))
+	columns := w.columns[id]
+	it := r.Raw.Iter()
+	for k := range columns {
+		if it.Done() {
+			return fmt.Errorf("record has fewer values than type %s has columns", r.Type)
+		}
+		val, _, err := it.NextTagAndBody()
+		if err != nil {
+			return err
+		}
+		columns[k] = append(columns[k], val...)
+	}
+	if !it.Done() {
+		return fmt.Errorf("record has more values than type %s has columns", r.Type)
+	}
+	w.nrecs++
+	w.size += len(r.Raw) + 1
+	if w.size >= SegmentSize {
+		return w.writeSegment()
+	}
+	return nil
+}
+
+// Flush writes the buffered records.  A stream with no records consists of
+// its magic alone, which Flush writes if nothing else has been written.
+func (w *Writer) Flush() error {
+	if err := w.writeMagic(); err != nil {
+		return err
+	}
+	if w.nrecs == 0 {
+		return nil
+	}
+	return w.writeSegment()
+}
+
+func (w *Writer) writeMagic() error {
+	if w.wroteMagic {
+		return nil
+	}
+	w.wroteMagic = true
+	_, err := w.Writer.Write([]byte(magic))
+	return err
+}
+
+func (w *Writer) writeSegment() error {
+	if err := w.writeMagic(); err != nil {
+		return err
+	}
+	var aliases []*zng.TypeAlias
+	seen := make(map[string]bool)
+	for _, typ := range w.types {
+		for _, alias := range zng.AliasTypes(typ) {
+			if !seen[alias.Name] {
+				seen[alias.Name] = true
+				aliases = append(aliases, alias)
+			}
+		}
+	}
+	hdr := zcode.AppendUvarint(nil, uint64(len(aliases)))
+	for _, alias := range aliases {
+		hdr = appendString(hdr, alias.Name)
+		hdr = appendString(hdr, alias.Type.String())
+	}
+	hdr = zcode.AppendUvarint(hdr, uint64(len(w.types)))
+	for _, typ := range w.types {
+		hdr = appendString(hdr, typ.String())
+	}
+	hdr = zcode.AppendUvarint(hdr, uint64(w.nrecs))
+	hdr = zcode.AppendUvarint(hdr, uint64(len(w.order)))
+	for _, columns := range w.columns {
+		for _, chunk := range columns {
+			hdr = zcode.AppendUvarint(hdr, uint64(len(chunk)))
+		}
+	}
+	if err := w.write(zcode.AppendUvarint(nil, uint64(len(hdr)))); err != nil {
+		return err
+	}
+	if err := w.write(hdr); err != nil {
+		return err
+	}
+	if err := w.write(w.order); err != nil {
+		return err
+	}
+	for _, columns := range w.columns {
+		for _, chunk := range columns {
+			if err := w.write(chunk); err != nil {
+				return err
+			}
+		}
+	}
+	w.types = w.types[:0]
+	w.ids = make(map[*zng.TypeRecord]int)
+	w.order = w.order[:0]
+	w.columns = w.columns[:0]
+	w.nrecs = 0
+	w.size = 0
+	return nil
+}
+
+func (w *Writer) write(b []byte) error {
+	_, err := w.Writer.Write(b)
+	return err
+}
+
+func appendString(dst []byte, s string) []byte {
+	dst = zcode.AppendUvarint(dst, uint64(len(s)))
+	return append(dst, s...)
+}
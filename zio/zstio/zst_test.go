@@ -0,0 +1,149 @@
+package zstio_test
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/brimsec/zq/pkg/nano"
+	"github.com/brimsec/zq/scanner"
+	"github.com/brimsec/zq/zbuf"
+	"github.com/brimsec/zq/zio/zngio"
+	"github.com/brimsec/zq/zio/zstio"
+	"github.com/brimsec/zq/zng/resolver"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const src = `
+#ipaddr=ip
+#alias2=ipaddr
+#0:record[ts:time,uid:bstring,id:record[orig_h:alias2,resp_p:port],n:int64]
+0:[1;C1;[10.0.0.1;80;]5;]
+0:[2;C2;[10.0.0.2;443;]-;]
+#1:record[ts:time,s:set[string],a:array[ipaddr],u:union[int64,string]]
+1:[3;[a;b;][10.0.0.3;]0:7;]
+0:[4;C4;-;9;]
+1:[5;-;[]1:foo;]
+#2:record[n:int64,e:record[x:string]]
+2:[6;-;]
+`
+
+// zst writes src in zst format with a segment after each of the given
+// numbers of records.
+func zst(t *testing.T, src string, segments ...int) []byte {
+	r := zngio.NewReader(strings.NewReader(src), resolver.NewContext())
+	var buf bytes.Buffer
+	w := zstio.NewWriter(&buf)
+	for n := 0; ; n++ {
+		if len(segments) > 0 && n == segments[0] {
+			require.NoError(t, w.Flush())
+			segments = segments[1:]
+		}
+		rec, err := r.Read()
+		require.NoError(t, err)
+		if rec == nil {
+			break
+		}
+		require.NoError(t, w.Write(rec))
+	}
+	require.NoError(t, w.Flush())
+	return buf.Bytes()
+}
+
+func zng(t *testing.T, r zbuf.Reader) string {
+	var out bytes.Buffer
+	require.NoError(t, zbuf.Copy(zbuf.NopFlusher(zngio.NewWriter(&out)), r))
+	return out.String()
+}
+
+// reader hides the Seek method of a bytes.Reader.
+type reader struct {
+	io.Reader
+}
+
+func TestRoundTrip(t *testing.T) {
+	expected := zng(t, zngio.NewReader(strings.NewReader(src), resolver.NewContext()))
+	for _, segments := range [][]int{nil, {3}, {1, 2, 5}} {
+		b := zst(t, src, segments...)
+		assert.True(t, zstio.IsZst(bytes.NewReader(b)))
+		r := zstio.NewReader(bytes.NewReader(b), resolver.NewContext())
+		assert.Equal(t, expected, zng(t, r), "segments %v", segments)
+	}
+
+	b := zst(t, "")
+	assert.Equal(t, "", zng(t, zstio.NewReader(bytes.NewReader(b), resolver.NewContext())))
+}
+
+func TestFields(t *testing.T) {
+	b := zst(t, src, 2)
+	expected := `#0:record[ts:time,n:int64]
+0:[1;5;]
+0:[2;-;]
+#1:record[ts:time]
+1:[3;]
+0:[4;9;]
+1:[5;]
+#2:record[n:int64]
+2:[6;]
+`
+	for _, r := range []io.Reader{bytes.NewReader(b), reader{bytes.NewReader(b)}} {
+		zr := zstio.NewReader(r, resolver.NewContext())
+		zr.SetFields([]string{"ts", "n", "x"})
+		assert.Equal(t, expected, zng(t, zr))
+	}
+
+	// The ts field is kept even if no fields are named.
+	zr := zstio.NewReader(bytes.NewReader(b), resolver.NewContext())
+	zr.SetFields([]string{})
+	var n int
+	for {
+		rec, err := zr.Read()
+		require.NoError(t, err)
+		if rec == nil {
+			break
+		}
+		n++
+		if n < 6 {
+			require.Len(t, rec.Type.Columns, 1)
+			assert.Equal(t, "ts", rec.Type.Columns[0].Name)
+			assert.EqualValues(t, nano.Unix(int64(n), 0), rec.Ts)
+		} else {
+			assert.Len(t, rec.Type.Columns, 0)
+		}
+	}
+	assert.Equal(t, 6, n)
+}
+
+func TestFieldsMerge(t *testing.T) {
+	// Records merged from several inputs with only some fields read are
+	// ordered by their timestamps.
+	a := zst(t, "#0:record[ts:time,x:int64]\n0:[1;1;]\n0:[3;3;]\n")
+	b := zst(t, "#0:record[ts:time,x:int64]\n0:[2;2;]\n0:[4;4;]\n")
+	var readers []zbuf.Reader
+	for _, buf := range [][]byte{a, b} {
+		zr := zstio.NewReader(bytes.NewReader(buf), resolver.NewContext())
+		zr.SetFields([]string{"x"})
+		readers = append(readers, zr)
+	}
+	expected := `#0:record[ts:time,x:int64]
+0:[1;1;]
+0:[2;2;]
+0:[3;3;]
+0:[4;4;]
+`
+	assert.Equal(t, expected, zng(t, scanner.NewCombiner(readers)))
+}
+
+func TestBadFormat(t *testing.T) {
+	b := zst(t, src)
+	for _, bad := range [][]byte{[]byte("ZST"), []byte("ZSX\x01"), b[:len(b)-1], b[:10]} {
+		r := zstio.NewReader(bytes.NewReader(bad), resolver.NewContext())
+		var err error
+		for k := 0; k < 10 && err == nil; k++ {
+			_, err = r.Read()
+		}
+		assert.Error(t, err, "input %q", bad)
+	}
+}
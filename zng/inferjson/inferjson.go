@@ -1,4 +1,8 @@
-package ndjsonio
+// Package inferjson converts JSON values to zng values, inferring their
+// types: objects become records (with dotted keys moved into nested
+// records), arrays become arrays (of unions if their elements differ in
+// type), numbers become float64s, and null becomes an unset string.
+package inferjson
 
 import (
 	"bytes"
@@ -7,17 +11,32 @@ import (
 
 	"github.com/brimsec/zq/pkg/byteconv"
 	"github.com/brimsec/zq/zcode"
-	"github.com/brimsec/zq/zio/zeekio"
 	"github.com/brimsec/zq/zng"
 	"github.com/brimsec/zq/zng/resolver"
 	"github.com/buger/jsonparser"
 )
 
-type inferParser struct {
+// A Parser converts JSON values to zng values with types in its
+// context.
+type Parser struct {
 	zctx *resolver.Context
 }
 
-func (p *inferParser) parseObject(b []byte) (zng.Value, error) {
+func NewParser(zctx *resolver.Context) *Parser {
+	return &Parser{zctx}
+}
+
+// ParseValue returns the zng.Value of the JSON value in b.
+func ParseValue(zctx *resolver.Context, b []byte) (zng.Value, error) {
+	val, typ, _, err := jsonparser.Get(b)
+	if err != nil {
+		return zng.Value{}, err
+	}
+	return NewParser(zctx).ParseValue(val, typ)
+}
+
+// ParseObject returns the zng record value of the JSON object b.
+func (p *Parser) ParseObject(b []byte) (zng.Value, error) {
 	type kv struct {
 		key   []byte
 		value []byte
@@ -38,12 +57,12 @@ func (p *inferParser) parseObject(b []byte) (zng.Value, error) {
 	})
 
 	// Build the list of columns (without types yet) and then run them
-	// through Unflatten() to find nested records.
+	// through Unflatten to find nested records.
 	columns := make([]zng.Column, len(kvs))
 	for i, kv := range kvs {
 		columns[i] = zng.NewColumn(string(kv.key), zng.TypeString)
 	}
-	columns, _ = zeekio.Unflatten(p.zctx, columns, false)
+	columns = p.zctx.Unflatten(columns)
 
 	// Parse the actual values and fill in column types along the way,
 	// taking care to step into nested records as necessary.
@@ -51,7 +70,7 @@ func (p *inferParser) parseObject(b []byte) (zng.Value, error) {
 	nestedColno := 0
 	var vals, nestedVals []zng.Value
 	for _, kv := range kvs {
-		val, err := p.parseValue(kv.value, kv.typ)
+		val, err := p.ParseValue(kv.value, kv.typ)
 		if err != nil {
 			return zng.Value{}, err
 		}
@@ -82,12 +101,13 @@ func (p *inferParser) parseObject(b []byte) (zng.Value, error) {
 	return zng.Value{typ, encodeContainer(vals)}, nil
 }
 
-func (p *inferParser) parseValue(raw []byte, typ jsonparser.ValueType) (zng.Value, error) {
+// ParseValue returns the zng.Value of the JSON value raw of type typ.
+func (p *Parser) ParseValue(raw []byte, typ jsonparser.ValueType) (zng.Value, error) {
 	switch typ {
 	case jsonparser.Array:
 		return p.parseArray(raw)
 	case jsonparser.Object:
-		return p.parseObject(raw)
+		return p.ParseObject(raw)
 	case jsonparser.Boolean:
 		return p.parseBool(raw)
 	case jsonparser.Number:
@@ -110,7 +130,7 @@ func typeIndex(typs []zng.Type, typ zng.Type) int {
 	return -1
 }
 
-func (p *inferParser) unionType(vals []zng.Value) *zng.TypeUnion {
+func (p *Parser) unionType(vals []zng.Value) *zng.TypeUnion {
 	var typs []zng.Type
 	for i := range vals {
 		if index := typeIndex(typs, vals[i].Type); index == -1 {
@@ -153,7 +173,7 @@ func encodeContainer(vals []zng.Value) zcode.Bytes {
 	return b
 }
 
-func (p *inferParser) parseArray(raw []byte) (zng.Value, error) {
+func (p *Parser) parseArray(raw []byte) (zng.Value, error) {
 	var err error
 	var vals []zng.Value
 	jsonparser.ArrayEach(raw, func(el []byte, typ jsonparser.ValueType, offset int, elErr error) {
@@ -161,7 +181,7 @@ func (p *inferParser) parseArray(raw []byte) (zng.Value, error) {
 			err = elErr
 			return
 		}
-		val, err := p.parseValue(el, typ)
+		val, err := p.ParseValue(el, typ)
 		if err != nil {
 			return
 		}
@@ -184,7 +204,7 @@ func (p *inferParser) parseArray(raw []byte) (zng.Value, error) {
 	return zng.Value{typ, encodeContainer(vals)}, nil
 }
 
-func (p *inferParser) parseBool(b []byte) (zng.Value, error) {
+func (p *Parser) parseBool(b []byte) (zng.Value, error) {
 	boolean, err := jsonparser.GetBoolean(b)
 	if err != nil {
 		return zng.Value{}, err
@@ -192,7 +212,7 @@ func (p *inferParser) parseBool(b []byte) (zng.Value, error) {
 	return zng.NewBool(boolean), nil
 }
 
-func (p *inferParser) parseNumber(b []byte) (zng.Value, error) {
+func (p *Parser) parseNumber(b []byte) (zng.Value, error) {
 	d, err := byteconv.ParseFloat64(b)
 	if err != nil {
 		return zng.Value{}, err
@@ -200,7 +220,7 @@ func (p *inferParser) parseNumber(b []byte) (zng.Value, error) {
 	return zng.NewFloat64(d), nil
 }
 
-func (p *inferParser) parseString(b []byte) (zng.Value, error) {
+func (p *Parser) parseString(b []byte) (zng.Value, error) {
 	b, err := jsonparser.Unescape(b, nil)
 	if err != nil {
 		return zng.Value{}, err
@@ -212,6 +232,6 @@ func (p *inferParser) parseString(b []byte) (zng.Value, error) {
 	return zng.Value{zng.TypeString, s}, nil
 }
 
-func (p *inferParser) parseNull() (zng.Value, error) {
+func (p *Parser) parseNull() (zng.Value, error) {
 	return zng.Value{zng.TypeString, nil}, nil
 }
@@ -7,6 +7,10 @@ import (
 	"github.com/brimsec/zq/zcode"
 )
 
+func NewInt64(v int64) Value {
+	return Value{TypeInt64, EncodeInt(v)}
+}
+
 func NewUint64(v uint64) Value {
 	return Value{TypeUint64, EncodeUint(v)}
 }
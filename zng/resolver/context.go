@@ -291,6 +291,58 @@ func (c *Context) AddColumns(r *zng.Record, newCols []zng.Column, vals []zng.Val
 	return zng.NewRecord(typ, zv)
 }
 
+// Unflatten returns the columns with those whose names are dotted, such
+// as the fields of legacy zeek logs or the keys of JSON objects, moved
+// into nested records.  Note that according to the zng spec, all the
+// fields for a nested record must be adjacent which simplifies the logic
+// here.
+func (c *Context) Unflatten(columns []zng.Column) []zng.Column {
+	cols := make([]zng.Column, 0)
+	var nestedCols []zng.Column
+	var nestedField string
+	for _, col := range columns {
+		var fld string
+		dot := strings.IndexByte(col.Name, '.')
+		if dot >= 0 {
+			fld = col.Name[:dot]
+		}
+
+		// Check if we're entering or leaving a nested record.
+		if fld != nestedField {
+			if len(nestedField) > 0 {
+				// We've reached the end of a nested record.
+				recType := c.LookupTypeRecord(nestedCols)
+				newcol := zng.NewColumn(nestedField, recType)
+				cols = append(cols, newcol)
+			}
+
+			if len(fld) > 0 {
+				// We're entering a new nested record.
+				nestedCols = make([]zng.Column, 0)
+			}
+			nestedField = fld
+		}
+
+		if len(fld) == 0 {
+			// Just a regular field.
+			cols = append(cols, col)
+		} else {
+			// Add to the nested record.
+			newcol := zng.NewColumn(col.Name[dot+1:], col.Type)
+			nestedCols = append(nestedCols, newcol)
+		}
+	}
+
+	// If we were in the midst of a nested record, make sure we
+	// account for it.
+	if len(nestedField) > 0 {
+		recType := c.LookupTypeRecord(nestedCols)
+		newcol := zng.NewColumn(nestedField, recType)
+		cols = append(cols, newcol)
+	}
+	return cols
+}
+
 // NewValue creates a Value with the given type and value described
 // as simple strings.  The zng.Value's type is allocated in this
 // type context.
@@ -6,7 +6,9 @@ import (
 	"net"
 	"net/url"
 	"strconv"
+	"time"
 
+	"github.com/brimsec/zq/ast"
 	"github.com/brimsec/zq/pkg/nano"
 	"github.com/brimsec/zq/zio/zjsonio"
 )
@@ -40,11 +42,43 @@ type TaskEnd struct {
 	Error  *Error `json:"error,omitempty"`
 }
 
+// A SearchRequest describes a search of a space.  The search is given
+// either as a parsed AST in Proc or as ZQL text in Query, which the server
+// parses with the macro definitions of each library in Include and with
+// each placeholder $name in the query bound to the typed value Params[name].
 type SearchRequest struct {
-	Space string          `json:"space" validate:"required"`
-	Proc  json.RawMessage `json:"proc" validate:"required"`
-	Span  nano.Span       `json:"span"`
-	Dir   int             `json:"dir" validate:"required"`
+	Space   string                 `json:"space" validate:"required"`
+	Proc    json.RawMessage        `json:"proc,omitempty"`
+	Query   string                 `json:"query,omitempty"`
+	Include []string               `json:"include,omitempty"`
+	Params  map[string]ast.Literal `json:"params,omitempty"`
+	Span    nano.Span              `json:"span"`
+	// Dir is 1 to return results oldest first or -1 to return them
+	// newest first.
+	Dir int `json:"dir" validate:"required"`
+	// Explain asks for a SearchExplain describing how the search would
+	// run in place of running it.
+	Explain bool `json:"explain,omitempty"`
+	// Analyze asks for a record of statistics about each proc of the
+	// search in place of the search's results.
+	Analyze bool `json:"analyze,omitempty"`
+	// Timeout, if not zero, is the number of nanoseconds after which
+	// the search stops scanning and returns results for the records
+	// scanned so far with a SearchEnd whose Reason is "timeout".
+	Timeout time.Duration `json:"timeout,omitempty"`
+}
+
+// A FormatRequest asks the server to print a query in canonical ZQL.  The
+// query is given as in a SearchRequest.
+type FormatRequest struct {
+	Proc    json.RawMessage        `json:"proc,omitempty"`
+	Query   string                 `json:"query,omitempty"`
+	Include []string               `json:"include,omitempty"`
+	Params  map[string]ast.Literal `json:"params,omitempty"`
+}
+
+type FormatResponse struct {
+	Query string `json:"query"`
 }
 
 type SearchRecords struct {
@@ -64,6 +98,13 @@ type SearchEnd struct {
 	Reason    string `json:"reason"`
 }
 
+// A SearchExplain describes the optimized query and flowgraph of a search
+// requested with Explain.
+type SearchExplain struct {
+	Type        string `json:"type"`
+	Explanation string `json:"explanation"`
+}
+
 type SearchStats struct {
 	Type       string  `json:"type"`
 	StartTime  nano.Ts `json:"start_time"`
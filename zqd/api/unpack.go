@@ -36,6 +36,8 @@ func unpack(b []byte) (interface{}, error) {
 		out = &SearchStats{}
 	case "SearchEnd":
 		out = &SearchEnd{}
+	case "SearchExplain":
+		out = &SearchExplain{}
 	case "PacketPostStatus":
 		out = &PacketPostStatus{}
 	default:
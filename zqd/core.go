@@ -4,6 +4,7 @@ import (
 	"net/http"
 	"sync/atomic"
 
+	"github.com/brimsec/zq/proc"
 	"github.com/brimsec/zq/zqd/zeek"
 	"go.uber.org/zap"
 )
@@ -16,7 +17,16 @@ type Config struct {
 	// existence is only as a hook for testing.  Eventually zqd will sort an
 	// unlimited amount of logs and this can be taken out.
 	SortLimit int
-	Logger    *zap.Logger
+	// MemoryLimit bounds the memory that all searches together use to
+	// buffer records and QueryMemoryLimit bounds that used by each
+	// search.  Zero means no limit.
+	MemoryLimit      int64
+	QueryMemoryLimit int64
+	// FileRoot is the directory within which searches may name files,
+	// such as the tables of lookup procs.  If it is empty, searches may
+	// not name files.
+	FileRoot string
+	Logger   *zap.Logger
 }
 
 type VersionMessage struct {
@@ -34,6 +44,15 @@ type Core struct {
 	// existence is only as a hook for testing.  Eventually zqd will sort an
 	// unlimited amount of logs and this can be taken out.
 	SortLimit int
+	// QueryMemoryLimit bounds the memory that each search uses to
+	// buffer records.  Zero means no limit.
+	QueryMemoryLimit int64
+	// memory bounds the memory that all searches together use to buffer
+	// records.
+	memory *proc.Budget
+	// files restricts the files that searches read to those within
+	// the FileRoot of the Config.
+	files     *proc.FileRoot
 	taskCount int64
 	logger    *zap.Logger
 }
@@ -44,10 +63,13 @@ func NewCore(conf Config) *Core {
 		logger = zap.NewNop()
 	}
 	return &Core{
-		Root:         conf.Root,
-		ZeekLauncher: conf.ZeekLauncher,
-		SortLimit:    conf.SortLimit,
-		logger:       logger,
+		Root:             conf.Root,
+		ZeekLauncher:     conf.ZeekLauncher,
+		SortLimit:        conf.SortLimit,
+		QueryMemoryLimit: conf.QueryMemoryLimit,
+		memory:           proc.NewBudget("server", conf.MemoryLimit, nil),
+		files:            proc.NewFileRoot(conf.FileRoot),
+		logger:           logger,
 	}
 }
 
@@ -62,3 +84,9 @@ func (c *Core) requestLogger(r *http.Request) *zap.Logger {
 func (c *Core) getTaskID() int64 {
 	return atomic.AddInt64(&c.taskCount, 1)
 }
+
+// queryBudget returns the Budget of a search, which charges the server-wide
+// Budget as well.  It must be closed when the search is done.
+func (c *Core) queryBudget() *proc.Budget {
+	return proc.NewBudget("query", c.QueryMemoryLimit, c.memory)
+}
@@ -37,6 +37,7 @@ func NewHandlerWithLogger(core *Core, logger *zap.Logger) http.Handler {
 	h.Handle("/space/{space}/packet", handlePacketSearch).Methods("GET")
 	h.Handle("/space/{space}/packet", handlePacketPost).Methods("POST")
 	h.Handle("/search", handleSearch).Methods("POST")
+	h.Handle("/format", handleFormat).Methods("POST")
 	h.HandleFunc("/version", func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Add("Content-Type", "application/json")
 		json.NewEncoder(w).Encode(&Version)
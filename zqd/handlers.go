@@ -16,6 +16,7 @@ import (
 	"github.com/brimsec/zq/zqd/packet"
 	"github.com/brimsec/zq/zqd/search"
 	"github.com/brimsec/zq/zqd/space"
+	"github.com/brimsec/zq/zql"
 	"github.com/gorilla/mux"
 	"go.uber.org/zap"
 )
@@ -50,12 +51,54 @@ func handleSearch(c *Core, w http.ResponseWriter, r *http.Request) {
 	// XXX This always returns bad request but should return status codes
 	// that reflect the nature of the returned error.
 	w.Header().Set("Content-Type", "application/ndjson")
-	if err := search.Search(r.Context(), s, req, out); err != nil {
+	memory := c.queryBudget()
+	defer memory.Close()
+	if err := search.Search(r.Context(), s, req, out, memory, c.files); err != nil {
+		if aerr, ok := err.(*api.Error); ok {
+			// Errors in the query are described by an api.Error.
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(aerr)
+			return
+		}
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
 }
 
+func handleFormat(c *Core, w http.ResponseWriter, r *http.Request) {
+	var req api.FormatRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	query, err := search.UnpackQuery(api.SearchRequest{
+		Proc:    req.Proc,
+		Query:   req.Query,
+		Include: req.Include,
+		Params:  req.Params,
+	})
+	if err != nil {
+		if aerr, ok := err.(*api.Error); ok {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(aerr)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	s, err := zql.FormatProc(query.Proc)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(api.FormatResponse{Query: s}); err != nil {
+		c.requestLogger(r).Warn("Error writing response", zap.Error(err))
+	}
+}
+
 func handlePacketSearch(c *Core, w http.ResponseWriter, r *http.Request) {
 	s := extractSpace(c, w, r)
 	if s == nil {
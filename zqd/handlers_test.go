@@ -2,6 +2,7 @@ package zqd_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -13,7 +14,9 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/brimsec/zq/ast"
 	"github.com/brimsec/zq/pkg/nano"
 	"github.com/brimsec/zq/pkg/test"
 	"github.com/brimsec/zq/zbuf"
@@ -43,12 +46,464 @@ func TestSimpleSearch(t *testing.T) {
 	require.Equal(t, test.Trim(src), execSearch(t, c, space, "*"))
 }
 
+func TestSearchDirection(t *testing.T) {
+	// Enough records for several blocks of the reverse reader, written
+	// newest first as a space stores them.
+	var src strings.Builder
+	src.WriteString("#0:record[ts:time,n:int64]\n")
+	for n := 2500; n > 0; n-- {
+		fmt.Fprintf(&src, "0:[%d;%d;]\n", n, n)
+	}
+	space := "test"
+	c := newCore(t)
+	defer os.RemoveAll(c.Root)
+	createSpaceWithData(t, c, space, src.String())
+	search := func(query string, span nano.Span, dir int) string {
+		return execSearchRequest(t, c, api.SearchRequest{
+			Space: space,
+			Query: query,
+			Span:  span,
+			Dir:   dir,
+		})
+	}
+	assert.Equal(t, test.Trim(`
+#0:record[ts:time,n:int64]
+0:[1;1;]
+0:[2;2;]
+0:[3;3;]
+`), search("* | head 3", nano.MaxSpan, 1))
+	assert.Equal(t, test.Trim(`
+#0:record[ts:time,n:int64]
+0:[2500;2500;]
+0:[2499;2499;]
+`), search("* | head 2", nano.MaxSpan, -1))
+	span := nano.NewSpanTs(nano.Unix(1100, 0), nano.Unix(1200, 0))
+	assert.Equal(t, test.Trim(`
+#0:record[ts:time,n:int64]
+0:[1100;1100;]
+0:[1101;1101;]
+`), search("* | head 2", span, 1))
+	assert.Equal(t, test.Trim(`
+#0:record[ts:time,count:uint64]
+0:[0;999;]
+0:[1000;1000;]
+0:[2000;501;]
+`), search("every 1000s count()", nano.MaxSpan, 1))
+	assert.Equal(t, test.Trim(`
+#0:record[ts:time,count:uint64]
+0:[2000;501;]
+0:[1000;1000;]
+0:[0;999;]
+`), search("every 1000s count()", nano.MaxSpan, -1))
+}
+
+func TestSearchIndex(t *testing.T) {
+	records := func(dups int) string {
+		var src strings.Builder
+		src.WriteString("#0:record[ts:time,n:int64]\n")
+		for n := 2500; n > 0; n-- {
+			fmt.Fprintf(&src, "0:[%d;%d;]\n", n, n)
+			if n == 1150 {
+				for k := 0; k < dups; k++ {
+					fmt.Fprintf(&src, "0:[%d;%d;]\n", n, k)
+				}
+			}
+		}
+		return src.String()
+	}
+	spaceName := "test"
+	c := newCore(t)
+	defer os.RemoveAll(c.Root)
+	createSpaceWithData(t, c, spaceName, records(0))
+	s, err := space.Open(c.Root, spaceName)
+	require.NoError(t, err)
+	path := s.DataPath("all.bzng")
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	index, err := bzngio.CreateIndex(f, 100)
+	f.Close()
+	require.NoError(t, err)
+	b, err := json.Marshal(index)
+	require.NoError(t, err)
+	require.NoError(t, ioutil.WriteFile(bzngio.IndexPath(path), b, 0644))
+	search := func(query string) string {
+		return execSearchRequest(t, c, api.SearchRequest{
+			Space: spaceName,
+			Query: query,
+			Span:  nano.NewSpanTs(nano.Unix(1100, 0), nano.Unix(1200, 0)),
+			Dir:   -1,
+		})
+	}
+	assert.Equal(t, test.Trim(`
+#0:record[count:uint64]
+0:[100;]
+`), search("count()"))
+	assert.Equal(t, test.Trim(`
+#0:record[ts:time,n:int64]
+0:[1150;1150;]
+`), search("n=1150"))
+	// The index no longer matches all.bzng once it is rewritten and so
+	// is ignored.
+	writeToSpace(t, c, spaceName, records(50))
+	assert.Equal(t, test.Trim(`
+#0:record[count:uint64]
+0:[150;]
+`), search("count()"))
+}
+
 func TestSearchEmptySpace(t *testing.T) {
 	space := "test"
 	c := newCore(t)
 	defer os.RemoveAll(c.Root)
 	createSpace(t, c, space, "")
 	require.Equal(t, "", execSearch(t, c, space, "*"))
+	require.Equal(t, "", execSearchRequest(t, c, api.SearchRequest{
+		Space: space,
+		Query: "*",
+		Span:  nano.MaxSpan,
+		Dir:   1,
+	}))
+}
+
+func TestSearchQueryInclude(t *testing.T) {
+	src := `
+#0:record[_path:string,ts:time,uid:bstring]
+0:[conn;1521911723.205187;CBrzd94qfowOqJwCHa;]
+0:[conn;1521911721.255387;C8Tful1TvM3Zf5x8fl;]
+`
+	space := "test"
+	c := newCore(t)
+	defer os.RemoveAll(c.Root)
+	createSpaceWithData(t, c, space, src)
+	res := execSearchRequest(t, c, api.SearchRequest{
+		Space:   space,
+		Query:   "* | first | cut uid",
+		Include: []string{"def first = sort ts | head 1;"},
+		Span:    nano.MaxSpan,
+		Dir:     1,
+	})
+	expected := `
+#0:record[uid:bstring]
+0:[C8Tful1TvM3Zf5x8fl;]
+`
+	require.Equal(t, test.Trim(expected), res)
+}
+
+func TestSearchQueryParams(t *testing.T) {
+	src := `
+#0:record[_path:string,ts:time,uid:bstring]
+0:[conn;1521911723.205187;CBrzd94qfowOqJwCHa;]
+0:[conn;1521911721.255387;C8Tful1TvM3Zf5x8fl;]
+`
+	space := "test"
+	c := newCore(t)
+	defer os.RemoveAll(c.Root)
+	createSpaceWithData(t, c, space, src)
+	res := execSearchRequest(t, c, api.SearchRequest{
+		Space: space,
+		Query: "uid=$uid | cut ts",
+		Params: map[string]ast.Literal{
+			"uid": {Type: "bstring", Value: "CBrzd94qfowOqJwCHa"},
+		},
+		Span: nano.MaxSpan,
+		Dir:  1,
+	})
+	expected := `
+#0:record[ts:time]
+0:[1521911723.205187;]
+`
+	require.Equal(t, test.Trim(expected), res)
+}
+
+func TestSearchExplain(t *testing.T) {
+	space := "test"
+	c := newCore(t)
+	defer os.RemoveAll(c.Root)
+	createSpace(t, c, space, "")
+	req := api.SearchRequest{
+		Space:   space,
+		Query:   "* | sort ts | filter _path=conn",
+		Span:    nano.MaxSpan,
+		Dir:     1,
+		Explain: true,
+	}
+	res := httpRequest(t, zqd.NewHandler(c), "POST", "http://localhost:9867/search?format=json", req)
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	_, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream := api.NewStream(api.NewJSONPipeScanner(res.Body), cancel)
+	var explain *api.SearchExplain
+	for {
+		v, err := stream.Next()
+		require.NoError(t, err)
+		if v == nil {
+			break
+		}
+		if e, ok := v.(*api.SearchExplain); ok {
+			explain = e
+		}
+	}
+	require.NotNil(t, explain)
+	assert.Contains(t, explain.Explanation, `_path="conn" | sort ts`)
+	assert.Contains(t, explain.Explanation, "proc.Sort #1")
+}
+
+func TestSearchAnalyze(t *testing.T) {
+	src := `
+#0:record[_path:string,ts:time,uid:bstring]
+0:[conn;1521911723.205187;CBrzd94qfowOqJwCHa;]
+0:[conn;1521911721.255387;C8Tful1TvM3Zf5x8fl;]
+0:[dns;1521911720.000000;C8Tful1TvM3Zf5x8fl;]
+`
+	space := "test"
+	c := newCore(t)
+	defer os.RemoveAll(c.Root)
+	createSpaceWithData(t, c, space, src)
+	res := execSearchRequest(t, c, api.SearchRequest{
+		Space:   space,
+		Query:   "_path=conn | sort ts",
+		Span:    nano.MaxSpan,
+		Dir:     1,
+		Analyze: true,
+	})
+	// Wall times vary so check only the text and output of each proc.
+	r := zngio.NewReader(strings.NewReader(res), resolver.NewContext())
+	var procs []string
+	for {
+		rec, err := r.Read()
+		require.NoError(t, err)
+		if rec == nil {
+			break
+		}
+		s, err := rec.AccessString("proc")
+		require.NoError(t, err)
+		out, err := rec.AccessInt("records_out")
+		require.NoError(t, err)
+		procs = append(procs, fmt.Sprintf("%s %d", s, out))
+	}
+	assert.Equal(t, []string{`_path="conn" 2`, "sort ts 2"}, procs)
+}
+
+func TestSearchQueryError(t *testing.T) {
+	space := "test"
+	c := newCore(t)
+	defer os.RemoveAll(c.Root)
+	createSpace(t, c, space, "")
+	search := func(query string) api.Error {
+		req := api.SearchRequest{
+			Space: space,
+			Query: query,
+			Span:  nano.MaxSpan,
+			Dir:   1,
+		}
+		res := httpRequest(t, zqd.NewHandler(c), "POST", "http://localhost:9867/search?format=bzng", req)
+		require.Equal(t, http.StatusBadRequest, res.StatusCode)
+		require.Equal(t, "application/json", res.Header.Get("Content-Type"))
+		var aerr api.Error
+		require.NoError(t, json.NewDecoder(res.Body).Decode(&aerr))
+		return aerr
+	}
+	aerr := search("* | count() |")
+	assert.Equal(t, "ParseError", aerr.Type)
+	info := aerr.Info.(map[string]interface{})
+	assert.EqualValues(t, 1, info["line"])
+	assert.EqualValues(t, 14, info["column"])
+	assert.Equal(t, "* | count() |\n             ^", info["excerpt"])
+
+	aerr = search("* | put x = nosuchfunction(y)")
+	assert.Equal(t, "SemanticError", aerr.Type)
+	assert.Equal(t, map[string]interface{}{
+		"message": "nosuchfunction: no such function",
+		"proc":    "PutProc",
+	}, aerr.Info)
+}
+
+func TestSearchMemoryLimit(t *testing.T) {
+	src := `
+#0:record[_path:string,ts:time,uid:bstring]
+0:[conn;1521911723.205187;CBrzd94qfowOqJwCHa;]
+0:[conn;1521911721.255387;C8Tful1TvM3Zf5x8fl;]
+0:[dns;1521911720.000000;C8Tful1TvM3Zf5x8fl;]
+`
+	search := func(c *zqd.Core, query string) *api.TaskEnd {
+		req := api.SearchRequest{
+			Space: "test",
+			Query: query,
+			Span:  nano.MaxSpan,
+			Dir:   -1,
+		}
+		res := httpRequest(t, zqd.NewHandler(c), "POST", "http://localhost:9867/search?format=json", req)
+		require.Equal(t, http.StatusOK, res.StatusCode)
+		_, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		stream := api.NewStream(api.NewJSONPipeScanner(res.Body), cancel)
+		var end *api.TaskEnd
+		for {
+			v, err := stream.Next()
+			require.NoError(t, err)
+			if v == nil {
+				break
+			}
+			if e, ok := v.(*api.TaskEnd); ok {
+				end = e
+			}
+		}
+		require.NotNil(t, end)
+		return end
+	}
+	for _, conf := range []zqd.Config{{QueryMemoryLimit: 200}, {MemoryLimit: 200}} {
+		conf.Root = createTempDir(t)
+		defer os.RemoveAll(conf.Root)
+		c := zqd.NewCore(conf)
+		createSpaceWithData(t, c, "test", src)
+
+		end := search(c, "* | sort ts")
+		require.NotNil(t, end.Error)
+		assert.Equal(t, "MemoryLimitError", end.Error.Type)
+		budget := "query"
+		if conf.MemoryLimit != 0 {
+			budget = "server"
+		}
+		assert.Equal(t, map[string]interface{}{"budget": budget, "limit": 200.0}, end.Error.Info)
+
+		// What a failed search held is released, so each of these
+		// searches fits within the limit.
+		for k := 0; k < 2; k++ {
+			assert.Nil(t, search(c, "_path=dns | sort ts").Error)
+		}
+		assert.Nil(t, search(c, "count()").Error)
+	}
+}
+
+func TestSearchTimeout(t *testing.T) {
+	src := `
+#0:record[_path:string,ts:time,uid:bstring]
+0:[conn;1521911723.205187;CBrzd94qfowOqJwCHa;]
+0:[conn;1521911721.255387;C8Tful1TvM3Zf5x8fl;]
+0:[dns;1521911720.000000;C8Tful1TvM3Zf5x8fl;]
+`
+	c := newCore(t)
+	defer os.RemoveAll(c.Root)
+	createSpaceWithData(t, c, "test", src)
+	search := func(timeout time.Duration) []string {
+		req := api.SearchRequest{
+			Space:   "test",
+			Query:   "count() by _path",
+			Span:    nano.MaxSpan,
+			Dir:     -1,
+			Timeout: timeout,
+		}
+		res := httpRequest(t, zqd.NewHandler(c), "POST", "http://localhost:9867/search?format=json", req)
+		require.Equal(t, http.StatusOK, res.StatusCode)
+		_, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		stream := api.NewStream(api.NewJSONPipeScanner(res.Body), cancel)
+		var reasons []string
+		for {
+			v, err := stream.Next()
+			require.NoError(t, err)
+			if v == nil {
+				break
+			}
+			switch v := v.(type) {
+			case *api.SearchEnd:
+				reasons = append(reasons, v.Reason)
+			case *api.TaskEnd:
+				require.Nil(t, v.Error)
+			}
+		}
+		return reasons
+	}
+	// The deadline of a search with a nanosecond timeout passes before
+	// its scan so the search ends with no results but without error.
+	assert.Equal(t, []string{"timeout"}, search(time.Nanosecond))
+	assert.Equal(t, []string{"eof"}, search(time.Minute))
+}
+
+func TestSearchFiles(t *testing.T) {
+	src := `
+#0:record[_path:string,ts:time,uid:bstring]
+0:[conn;1521911723.205187;CBrzd94qfowOqJwCHa;]
+`
+	files := createTempDir(t)
+	defer os.RemoveAll(files)
+	require.NoError(t, ioutil.WriteFile(filepath.Join(files, "paths.csv"), []byte("path,desc\nconn,connections\n"), 0644))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(files, "iocs.txt"), []byte("CBrzd94qfowOqJwCHa\n"), 0644))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(files, "patterns"), []byte("UIDPREFIX C[A-Z]\n"), 0644))
+	outside := createTempDir(t)
+	defer os.RemoveAll(outside)
+	secret := filepath.Join(outside, "secret.csv")
+	require.NoError(t, ioutil.WriteFile(secret, []byte("path,desc\nconn,secret\n"), 0644))
+	secretIOCs := filepath.Join(outside, "secret.txt")
+	require.NoError(t, ioutil.WriteFile(secretIOCs, []byte("conn\n"), 0644))
+
+	search := func(c *zqd.Core, query string) (int, string) {
+		req := api.SearchRequest{
+			Space: "test",
+			Query: query,
+			Span:  nano.MaxSpan,
+			Dir:   -1,
+		}
+		res := httpRequest(t, zqd.NewHandler(c), "POST", "http://localhost:9867/search?format=json", req)
+		body, err := ioutil.ReadAll(res.Body)
+		require.NoError(t, err)
+		return res.StatusCode, string(body)
+	}
+	conf := zqd.Config{Root: createTempDir(t), FileRoot: files}
+	defer os.RemoveAll(conf.Root)
+	c := zqd.NewCore(conf)
+	createSpaceWithData(t, c, "test", src)
+	status, body := search(c, `* | lookup file="paths.csv" on _path=path`)
+	require.Equal(t, http.StatusOK, status, body)
+	assert.Contains(t, body, "connections")
+	status, body = search(c, "* | intel file=iocs.txt")
+	require.Equal(t, http.StatusOK, status, body)
+	assert.Contains(t, body, "iocs.txt")
+	status, body = search(c, `* | parse uid with "%{UIDPREFIX:prefix}" -patterns patterns`)
+	require.Equal(t, http.StatusOK, status, body)
+	assert.Contains(t, body, "CB")
+
+	refused := []string{
+		fmt.Sprintf("* | lookup file=%q on _path=path", secret),
+		fmt.Sprintf("* | lookup file=%q on _path=path", filepath.Join("..", filepath.Base(outside), "secret.csv")),
+		fmt.Sprintf("* | intel file=%q", secretIOCs),
+		fmt.Sprintf("* | intel file=iocs.txt, %q", filepath.Join("..", filepath.Base(outside), "secret.txt")),
+		fmt.Sprintf(`* | parse uid with "%%{WORD:w}" -patterns %q`, secretIOCs),
+	}
+	for _, query := range refused {
+		status, body := search(c, query)
+		assert.Equal(t, http.StatusBadRequest, status, query)
+		assert.Contains(t, body, "file not allowed", query)
+		assert.NotContains(t, body, `"secret"`, query)
+	}
+
+	// Without a FileRoot, searches may not name any file.
+	root := createTempDir(t)
+	defer os.RemoveAll(root)
+	c = newCoreAtDir(t, root)
+	createSpaceWithData(t, c, "test", src)
+	status, body = search(c, `* | lookup file="paths.csv" on _path=path`)
+	assert.Equal(t, http.StatusBadRequest, status)
+	assert.Contains(t, body, "file not allowed")
+}
+
+func TestFormat(t *testing.T) {
+	c := newCore(t)
+	defer os.RemoveAll(c.Root)
+	h := zqd.NewHandler(c)
+	var res api.FormatResponse
+	req := api.FormatRequest{
+		Query:  "def a = count() by _path;  host=$h |a|  sort -r",
+		Params: map[string]ast.Literal{"h": {Type: "ip", Value: "10.0.0.1"}},
+	}
+	httpJSONSuccess(t, h, "POST", "http://localhost:9867/format", req, &res)
+	assert.Equal(t, "host=10.0.0.1 | count() by _path | sort -r", res.Query)
+
+	r := httpRequest(t, h, "POST", "http://localhost:9867/format", api.FormatRequest{Query: "* |"})
+	require.Equal(t, http.StatusBadRequest, r.StatusCode)
+	var aerr api.Error
+	require.NoError(t, json.NewDecoder(r.Body).Decode(&aerr))
+	assert.Equal(t, "ParseError", aerr.Type)
 }
 
 func TestSpaceList(t *testing.T) {
@@ -237,12 +692,15 @@ func execSearch(t *testing.T, c *zqd.Core, space, prog string) string {
 	require.NoError(t, err)
 	proc, err := json.Marshal(parsed)
 	require.NoError(t, err)
-	s := api.SearchRequest{
+	return execSearchRequest(t, c, api.SearchRequest{
 		Space: space,
 		Proc:  proc,
 		Span:  nano.MaxSpan,
-		Dir:   1,
-	}
+		Dir:   -1,
+	})
+}
+
+func execSearchRequest(t *testing.T, c *zqd.Core, s api.SearchRequest) string {
 	// XXX Get rid of this format query param and use http headers instead.
 	res := httpRequest(t, zqd.NewHandler(c), "POST", "http://localhost:9867/search?format=bzng", s)
 	require.Equal(t, http.StatusOK, res.StatusCode)
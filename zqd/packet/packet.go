@@ -106,6 +106,7 @@ func (p *IngestProcess) run(ctx context.Context) error {
 		os.RemoveAll(p.logdir)
 		os.Remove(p.space.DataPath(IndexFile))
 		os.Remove(p.space.DataPath("all.bzng"))
+		os.Remove(bzngio.IndexPath(p.space.DataPath("all.bzng")))
 		p.space.SetPacketPath("")
 	}
 
@@ -254,10 +255,46 @@ func (p *IngestProcess) createSnapshot(ctx context.Context) error {
 	if err := bzngfile.Close(); err != nil {
 		return err
 	}
+	// A search that finds the index of one snapshot next to the bzng file
+	// of another ignores it as the two differ in size.
+	if err := indexBzng(bzngfile.Name(), p.space.DataPath("all.bzng")); err != nil {
+		os.Remove(bzngfile.Name())
+		return err
+	}
 	atomic.AddInt32(&p.snapshots, 1)
 	return os.Rename(bzngfile.Name(), p.space.DataPath("all.bzng"))
 }
 
+// indexBzng writes the time index of the bzng file at path as the index
+// of the file at dst.
+func indexBzng(path, dst string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	idx, err := bzngio.CreateIndex(f, 10000)
+	if err != nil {
+		return err
+	}
+	idxpath := bzngio.IndexPath(dst)
+	tmppath := idxpath + ".tmp"
+	out, err := os.Create(tmppath)
+	if err != nil {
+		return err
+	}
+	if err := json.NewEncoder(out).Encode(idx); err != nil {
+		out.Close()
+		os.Remove(tmppath)
+		return err
+	}
+	if err := out.Close(); err != nil {
+		os.Remove(tmppath)
+		return err
+	}
+	return os.Rename(tmppath, idxpath)
+}
+
 func (p *IngestProcess) Write(b []byte) (int, error) {
 	n := len(b)
 	atomic.AddInt64(&p.pcapReadSize, int64(n))
@@ -5,20 +5,19 @@ package search
 import (
 	"context"
 	"errors"
-	"io"
-	"io/ioutil"
 	"os"
+	"runtime"
 	"strings"
 	"time"
 
 	"github.com/brimsec/zq/ast"
 	zdriver "github.com/brimsec/zq/driver"
-	"github.com/brimsec/zq/filter"
+	"github.com/brimsec/zq/optimizer"
 	"github.com/brimsec/zq/pkg/nano"
 	"github.com/brimsec/zq/proc"
 	"github.com/brimsec/zq/scanner"
 	"github.com/brimsec/zq/zbuf"
-	"github.com/brimsec/zq/zio/detector"
+	"github.com/brimsec/zq/zio/bzngio"
 	"github.com/brimsec/zq/zng/resolver"
 	"github.com/brimsec/zq/zqd/api"
 	"github.com/brimsec/zq/zqd/space"
@@ -30,7 +29,11 @@ import (
 // so the recevier can do reasonable, interactive streaming updates.
 const DefaultMTU = 100
 
-func Search(ctx context.Context, s *space.Space, req api.SearchRequest, out Output) error {
+// Search runs the search described by req over the space s and sends its
+// results to out.  The procs of the search buffer records within memory,
+// which may be nil for no limit, and read only the files that files
+// allows.
+func Search(ctx context.Context, s *space.Space, req api.SearchRequest, out Output, memory *proc.Budget, files *proc.FileRoot) error {
 	// XXX These validation checks should result in 400 level status codes and
 	// thus shouldn't occur here.
 	if req.Span.Ts < 0 {
@@ -39,34 +42,59 @@ func Search(ctx context.Context, s *space.Space, req api.SearchRequest, out Outp
 	if req.Span.Dur < 0 {
 		return errors.New("time span must have non-negative duration")
 	}
-	// XXX allow either direction even through we do forward only right now
 	if req.Dir != 1 && req.Dir != -1 {
 		return errors.New("time direction must be 1 or -1")
 	}
+	if req.Timeout < 0 {
+		return errors.New("timeout must be non-negative")
+	}
+	if req.Timeout > 0 {
+		// The deadline ends the scan rather than the search so
+		// that the results of what was scanned are still sent.
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, req.Timeout)
+		defer cancel()
+	}
 	query, err := UnpackQuery(req)
 	if err != nil {
 		return err
 	}
-	var f io.ReadCloser
-	f, err = s.OpenFile("all.bzng")
-	if err != nil {
-		if !os.IsNotExist(err) {
-			return err
-		}
-		f = ioutil.NopCloser(strings.NewReader(""))
+	// If all.bzng has an index, only the parts of it that may hold
+	// records within the span that match the query's search are read.
+	var filter ast.BooleanExpr
+	if fp, _ := optimizer.LiftFilter(optimizer.Optimize(query.Proc)); fp != nil {
+		filter = fp.Filter
 	}
-	defer f.Close()
-	zngReader, err := detector.LookupReader("bzng", f, resolver.NewContext())
-	if err != nil {
+	open := scanner.OpenBzngFile
+	if req.Dir > 0 {
+		// all.bzng is sorted by descending time so read it backward
+		// to search forward in time.
+		open = scanner.OpenReverseBzngFile
+	}
+	var zngReader zbuf.Reader
+	f, err := open(resolver.NewContext(), s.DataPath("all.bzng"), query.Span, filter)
+	switch {
+	case os.IsNotExist(err):
+		zngReader = bzngio.NewReader(strings.NewReader(""), resolver.NewContext())
+	case err != nil:
 		return err
+	default:
+		defer f.Close()
+		zngReader = f
 	}
 	zctx := resolver.NewContext()
 	mapper := scanner.NewMapper(zngReader, zctx)
-	mux, err := launch(ctx, query, mapper, zctx)
+	procCtx := newContext(ctx, query, zctx)
+	procCtx.Memory = memory
+	procCtx.Files = files
+	mux, err := zdriver.CompileQuery(procCtx, query.Proc, mapper, query.Span, runtime.GOMAXPROCS(0))
 	if err != nil {
 		return err
 	}
-	return run(mux, out)
+	if query.Explain {
+		return explain(query, mux, procCtx.Analyzer, out)
+	}
+	return run(mux, out, procCtx)
 }
 
 func Copy(ctx context.Context, w []zbuf.Writer, r zbuf.Reader, prog string) error {
@@ -79,7 +107,7 @@ func Copy(ctx context.Context, w []zbuf.Writer, r zbuf.Reader, prog string) erro
 		TypeContext: resolver.NewContext(),
 		Logger:      zap.NewNop(),
 	}
-	mux, err := compile(procCtx, p, r, nano.MaxSpan)
+	mux, err := zdriver.CompileQuery(procCtx, p, r, nano.MaxSpan, runtime.GOMAXPROCS(0))
 	if err != nil {
 		return err
 	}
@@ -97,26 +125,61 @@ type Output interface {
 // of tuples, a "search" applied to the tuples producing a set of matched
 // tuples, and a proc to the process the tuples
 type Query struct {
-	Space string
-	Dir   int
-	Span  nano.Span
-	Proc  ast.Proc
+	Space   string
+	Dir     int
+	Span    nano.Span
+	Proc    ast.Proc
+	Explain bool
+	Analyze bool
 }
 
 // UnpackQuery transforms a api.SearchRequest into a Query.
 func UnpackQuery(req api.SearchRequest) (*Query, error) {
-	proc, err := ast.UnpackProc(nil, req.Proc)
+	var proc ast.Proc
+	var err error
+	switch {
+	case req.Query != "" && req.Proc != nil:
+		return nil, errors.New("search request has both proc and query")
+	case req.Query != "":
+		proc, err = zql.ParseProcWithParams(req.Query, req.Params, req.Include...)
+	case req.Include != nil:
+		return nil, errors.New("search request includes libraries without a query")
+	case req.Params != nil:
+		return nil, errors.New("search request has parameters without a query")
+	default:
+		proc, err = ast.UnpackProc(nil, req.Proc)
+		if err == nil {
+			err = zql.Check(proc)
+		}
+	}
 	if err != nil {
-		return nil, err
+		return nil, queryError(err)
 	}
 	return &Query{
-		Space: req.Space,
-		Dir:   req.Dir,
-		Span:  req.Span,
-		Proc:  proc,
+		Space:   req.Space,
+		Dir:     req.Dir,
+		Span:    req.Span,
+		Proc:    proc,
+		Explain: req.Explain,
+		Analyze: req.Analyze,
 	}, nil
 }
 
+// queryError converts an error from parsing or checking a query into an
+// api.Error whose Info holds the location of a syntax error or the
+// details of a semantic error.  Other errors are returned unchanged.
+func queryError(err error) error {
+	var perr *zql.ParseError
+	if errors.As(err, &perr) {
+		return &api.Error{Type: "ParseError", Message: err.Error(), Info: perr}
+	}
+	var serr *zql.SemanticError
+	if errors.As(err, &serr) {
+		return &api.Error{Type: "SemanticError", Message: err.Error(), Info: serr}
+	}
+	return err
+}
+
 type driver struct {
 	output    Output
 	startTime nano.Ts
@@ -132,7 +195,12 @@ func (d *driver) end(id int64) error {
 
 func (d *driver) abort(id int64, err error) error {
 	verr := &api.Error{Type: "INTERNAL", Message: err.Error()}
-	return d.output.SendControl(&api.TaskEnd{"TaskEnd", id, verr})
+	var merr *proc.MemoryLimitError
+	if errors.As(err, &merr) {
+		verr = &api.Error{Type: "MemoryLimitError", Message: err.Error(), Info: merr}
+	}
+	// The TaskEnd is the last message of the search.
+	return d.output.End(&api.TaskEnd{"TaskEnd", id, verr})
 }
 
 // send a stats update every 500 ms XXX
@@ -156,20 +224,30 @@ func (d *driver) sendStats(stats api.ScannerStats) error {
 	return d.output.SendControl(v)
 }
 
-func (d *driver) searchEnd(cid int, stats api.ScannerStats) error {
+// searchEnd sends the stats and the end of the search on channel cid.
+// The end's reason is "timeout" if the deadline of the search ended the
+// scan of out early.
+func (d *driver) searchEnd(out *proc.MuxOutput, cid int, stats api.ScannerStats) error {
 	err := d.sendStats(stats)
 	if err != nil {
 		return err
 	}
+	reason := "eof"
+	if out.Stopped() {
+		reason = "timeout"
+	}
 	v := &api.SearchEnd{
 		Type:      "SearchEnd",
 		ChannelID: cid,
-		Reason:    "eof",
+		Reason:    reason,
 	}
 	return d.output.SendControl(v)
 }
 
-func run(out *proc.MuxOutput, output Output) error {
+// run sends the results of the flowgraph out to output or, if ctx has an
+// Analyzer, a batch of records with the statistics of each proc on
+// channel 0 when the flowgraph is done.
+func run(out *proc.MuxOutput, output Output, ctx *proc.Context) error {
 	//XXX scanner needs to track stats, for now send zeroes
 	var stats api.ScannerStats
 	d := &driver{
@@ -203,10 +281,13 @@ func run(out *proc.MuxOutput, output Output) error {
 				return d.abort(0, err)
 			}
 		}
+		if ctx.Analyzer != nil {
+			continue
+		}
 		if chunk.Batch == nil {
 			// a search is done on a channel.  we send stats and
 			// a done message for each channel that finishes
-			err := d.searchEnd(chunk.ID, stats)
+			err := d.searchEnd(out, chunk.ID, stats)
 			if err != nil {
 				return d.abort(0, err)
 			}
@@ -217,61 +298,24 @@ func run(out *proc.MuxOutput, output Output) error {
 			}
 		}
 	}
-	return d.end(0)
-}
-
-// from zq main - move to shared place
-func liftFilter(p ast.Proc) (*ast.FilterProc, ast.Proc) {
-	if fp, ok := p.(*ast.FilterProc); ok {
-		pass := &ast.PassProc{
-			Node: ast.Node{"PassProc"},
+	if ctx.Analyzer != nil {
+		recs, err := zdriver.AnalysisRecords(ctx.TypeContext, ctx.Analyzer)
+		if err != nil {
+			return d.abort(0, err)
 		}
-		return fp, pass
-	}
-	seq, ok := p.(*ast.SequentialProc)
-	if ok && len(seq.Procs) > 0 {
-		if fp, ok := seq.Procs[0].(*ast.FilterProc); ok {
-			rest := &ast.SequentialProc{
-				Node:  ast.Node{"SequentialProc"},
-				Procs: seq.Procs[1:],
+		if len(recs) > 0 {
+			if err := d.output.SendBatch(0, zbuf.NewArray(recs, nano.Span{})); err != nil {
+				return d.abort(0, err)
 			}
-			return fp, rest
 		}
-	}
-	return nil, nil
-}
-
-// from zq main - move to shared place
-func compile(ctx *proc.Context, program ast.Proc, reader zbuf.Reader, span nano.Span) (*proc.MuxOutput, error) {
-	// Try to move the filter into the scanner so we can throw
-	// out unmatched records without copying their contents in the
-	// case of readers (like zio raw.Reader) that create volatile
-	// records that are kept by the scanner only if matched.
-	// For other readers, it certainly doesn't hurt to do this.
-	var f filter.Filter
-	filterProc, rest := liftFilter(program)
-	if filterProc != nil {
-		var err error
-		f, err = filter.Compile(filterProc.Filter)
-		if err != nil {
-			return nil, err
+		if err := d.searchEnd(out, 0, stats); err != nil {
+			return d.abort(0, err)
 		}
-		program = rest
 	}
-	input := scanner.NewScanner(reader, f)
-	input.SetSpan(span)
-	leaves, err := proc.CompileProc(nil, program, ctx, input)
-	if err != nil {
-		return nil, err
-	}
-	return proc.NewMuxOutput(ctx, leaves), nil
+	return d.end(0)
 }
 
-func launch(ctx context.Context, query *Query, reader zbuf.Reader, zctx *resolver.Context) (*proc.MuxOutput, error) {
-	span := query.Span
-	if span == (nano.Span{}) {
-		span = nano.MaxSpan
-	}
+func newContext(ctx context.Context, query *Query, zctx *resolver.Context) *proc.Context {
 	procCtx := &proc.Context{
 		Context:     ctx,
 		TypeContext: zctx,
@@ -279,5 +323,21 @@ func launch(ctx context.Context, query *Query, reader zbuf.Reader, zctx *resolve
 		Reverse:     query.Dir < 0,
 		Warnings:    make(chan string, 5),
 	}
-	return compile(procCtx, query.Proc, reader, query.Span)
+	if query.Explain || query.Analyze {
+		procCtx.Analyzer = &proc.Analyzer{}
+	}
+	return procCtx
+}
+
+func explain(query *Query, mux *proc.MuxOutput, a *proc.Analyzer, output Output) error {
+	var b strings.Builder
+	if err := zdriver.Explain(&b, query.Proc, mux, a); err != nil {
+		return err
+	}
+	d := &driver{output: output}
+	d.start(0)
+	if err := output.SendControl(&api.SearchExplain{Type: "SearchExplain", Explanation: b.String()}); err != nil {
+		return d.abort(0, err)
+	}
+	return d.end(0)
 }
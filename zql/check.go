@@ -0,0 +1,131 @@
+package zql
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/brimsec/zq/ast"
+	zexpr "github.com/brimsec/zq/expr"
+	"github.com/brimsec/zq/reducer/compile"
+)
+
+// A SemanticError is an error in a query that parses but cannot be run,
+// e.g., a call to an unknown function.  Proc is the op of the proc where
+// the error was found.
+type SemanticError struct {
+	Message string `json:"message"`
+	Proc    string `json:"proc"`
+}
+
+func (e *SemanticError) Error() string {
+	return fmt.Sprintf("%s: %s", strings.ToLower(strings.TrimSuffix(e.Proc, "Proc")), e.Message)
+}
+
+// windowFunctions holds the arities of the functions that may be called
+// only from the assignments of a window proc.  These must agree with the
+// functions implemented by proc.Window.
+var windowFunctions = map[string]struct {
+	minArgs int
+	maxArgs int
+}{
+	"lag":           {1, 2},
+	"lead":          {1, 2},
+	"delta":         {1, 1},
+	"movavg":        {2, 2},
+	"count":         {0, 1},
+	"sum":           {0, 1},
+	"avg":           {0, 1},
+	"min":           {0, 1},
+	"max":           {0, 1},
+	"first":         {0, 1},
+	"last":          {0, 1},
+	"countdistinct": {0, 1},
+}
+
+// Check validates the parts of a parsed query that the parser accepts
+// but that must be known to the runtime: the names of reducers and the
+// names and number of arguments of called functions.  It returns a
+// *SemanticError for the first error found.
+func Check(p ast.Proc) error {
+	switch p := p.(type) {
+	case *ast.SequentialProc:
+		return checkProcs(p.Procs)
+	case *ast.ParallelProc:
+		return checkProcs(p.Procs)
+	case *ast.SwitchProc:
+		for _, c := range p.Cases {
+			if err := Check(c.Proc); err != nil {
+				return err
+			}
+		}
+	case *ast.ReducerProc:
+		return checkReducers(p.Op, p.Reducers)
+	case *ast.GroupByProc:
+		return checkReducers(p.Op, p.Reducers)
+	case *ast.SessionProc:
+		return checkReducers(p.Op, p.Reducers)
+	case *ast.PutProc:
+		return checkExpr(p.Op, p.Expr, false)
+	case *ast.WindowProc:
+		for _, a := range p.Assignments {
+			if err := checkExpr(p.Op, a.Expr, true); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func checkProcs(procs []ast.Proc) error {
+	for _, p := range procs {
+		if err := Check(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func checkReducers(op string, reducers []ast.Reducer) error {
+	for _, r := range reducers {
+		if _, err := compile.Compile(r); err != nil {
+			return &SemanticError{Message: fmt.Sprintf("%s: %s", r.Var, err), Proc: op}
+		}
+	}
+	return nil
+}
+
+func checkExpr(op string, e ast.Expression, window bool) error {
+	switch e := e.(type) {
+	case *ast.BinaryExpression:
+		if err := checkExpr(op, e.LHS, window); err != nil {
+			return err
+		}
+		return checkExpr(op, e.RHS, window)
+	case *ast.FunctionCall:
+		if err := checkCall(e, window); err != nil {
+			return &SemanticError{Message: err.Error(), Proc: op}
+		}
+		for _, arg := range e.Args {
+			if err := checkExpr(op, arg, window); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func checkCall(call *ast.FunctionCall, window bool) error {
+	name := strings.ToLower(call.Function)
+	fn, ok := windowFunctions[name]
+	if !window || !ok {
+		return zexpr.CheckFunctionCall(call)
+	}
+	nargs := len(call.Args)
+	if nargs < fn.minArgs {
+		return fmt.Errorf("%s: %w", name, zexpr.ErrTooFewArgs)
+	}
+	if nargs > fn.maxArgs {
+		return fmt.Errorf("%s: %w", name, zexpr.ErrTooManyArgs)
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+package zql
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// A ParseError is a syntax error in a query along with its location.  Line
+// and Column count from one, and Column counts characters rather than bytes.
+type ParseError struct {
+	Message string `json:"message"`
+	Line    int    `json:"line"`
+	Column  int    `json:"column"`
+	Offset  int    `json:"offset"`
+	// Excerpt is the line of the query containing the error followed by
+	// a line with a caret marking the error's column.
+	Excerpt string `json:"excerpt"`
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("line %d, column %d: %s\n%s", e.Line, e.Column, e.Message, e.Excerpt)
+}
+
+// newParseError converts an error returned by Parse for src into a
+// ParseError for the first error found.  Other errors are returned
+// unchanged.
+func newParseError(src string, err error) error {
+	list, ok := err.(errList)
+	if !ok || len(list) == 0 {
+		return err
+	}
+	perr, ok := list[0].(*parserError)
+	if !ok {
+		return err
+	}
+	offset := perr.pos.offset
+	if offset > len(src) {
+		offset = len(src)
+	}
+	start := strings.LastIndexByte(src[:offset], '\n') + 1
+	end := strings.IndexByte(src[offset:], '\n')
+	if end < 0 {
+		end = len(src)
+	} else {
+		end += offset
+	}
+	line := strings.TrimSuffix(src[start:end], "\r")
+	// Indent the caret with the whitespace of the line so that tabs line
+	// up and with a space for every other character.
+	var caret strings.Builder
+	for _, r := range src[start:offset] {
+		if r == '\t' {
+			caret.WriteRune(r)
+		} else {
+			caret.WriteRune(' ')
+		}
+	}
+	caret.WriteRune('^')
+	return &ParseError{
+		Message: perr.Inner.Error(),
+		Line:    strings.Count(src[:offset], "\n") + 1,
+		Column:  utf8.RuneCountInString(src[start:offset]) + 1,
+		Offset:  offset,
+		Excerpt: line + "\n" + caret.String(),
+	}
+}
@@ -0,0 +1,804 @@
+package zql
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/brimsec/zq/ast"
+)
+
+// FormatProc returns the canonical ZQL text for a proc.  Parsing the text
+// yields an AST equal to p when p was itself produced by the parser, with
+// the exception that macros appear expanded and parameters appear as their
+// bound values.  An error is returned if p has no representation in ZQL.
+func FormatProc(p ast.Proc) (string, error) {
+	var f formatter
+	f.query(p)
+	return f.result()
+}
+
+// FormatChainedProc returns the canonical ZQL text for a proc as it appears
+// after a pipe in a query.
+func FormatChainedProc(p ast.Proc) (string, error) {
+	var f formatter
+	f.proc(p)
+	return f.result()
+}
+
+// FormatFilter returns the canonical ZQL text for a boolean expression as
+// it appears in a search or a filter proc.
+func FormatFilter(e ast.BooleanExpr) (string, error) {
+	var f formatter
+	f.filter(e)
+	return f.result()
+}
+
+// FormatExpr returns the canonical ZQL text for an expression as it appears
+// in a put or window proc.
+func FormatExpr(e ast.Expression) (string, error) {
+	var f formatter
+	f.expr(e, 0)
+	return f.result()
+}
+
+var fieldNameRegexp = regexp.MustCompile(`^[A-Za-z_$][A-Za-z_$0-9]*$`)
+
+var comparators = map[string]string{
+	"eql":  "=",
+	"neql": "!=",
+	"lt":   "<",
+	"lte":  "<=",
+	"gt":   ">",
+	"gte":  ">=",
+}
+
+var reducerNames = map[string]string{
+	"Count":         "count",
+	"Sum":           "sum",
+	"Avg":           "avg",
+	"Stdev":         "stdev",
+	"Var":           "var",
+	"Entropy":       "entropy",
+	"Min":           "min",
+	"Max":           "max",
+	"First":         "first",
+	"Last":          "last",
+	"CountDistinct": "countdistinct",
+}
+
+// Precedence levels of expressions, from loosest to tightest binding.
+const (
+	precOr = iota + 1
+	precAnd
+	precEquality
+	precRelative
+	precAdditive
+	precMultiplicative
+	precCall
+	precDeref
+	precPrimary
+)
+
+func exprPrec(e ast.Expression) int {
+	switch e := e.(type) {
+	case *ast.BinaryExpression:
+		switch strings.ToUpper(e.Operator) {
+		case "OR":
+			return precOr
+		case "AND":
+			return precAnd
+		case "=", "!=":
+			return precEquality
+		case "<", "<=", ">", ">=":
+			return precRelative
+		case "+", "-":
+			return precAdditive
+		case "*", "/":
+			return precMultiplicative
+		case "[", ".":
+			return precDeref
+		}
+	case *ast.FunctionCall:
+		return precCall
+	}
+	return precPrimary
+}
+
+// durationUnits are the units used to format durations, largest first.
+var durationUnits = []struct {
+	ns   int64
+	unit string
+}{
+	{7 * 24 * 3600 * 1e9, "w"},
+	{24 * 3600 * 1e9, "d"},
+	{3600 * 1e9, "h"},
+	{60 * 1e9, "m"},
+	{1e9, "s"},
+	{1e6, "ms"},
+	{1e3, "us"},
+	{1, "ns"},
+}
+
+func formatDuration(d ast.Duration) string {
+	if d.Nanoseconds == 0 {
+		return "0s"
+	}
+	for _, u := range durationUnits {
+		if d.Nanoseconds%u.ns == 0 {
+			return strconv.FormatInt(d.Nanoseconds/u.ns, 10) + u.unit
+		}
+	}
+	panic("unreachable")
+}
+
+// formatPercent returns the shortest decimal text for rate as a percentage
+// that parses back to rate.
+func formatPercent(rate float64) string {
+	var s string
+	for prec := 0; prec <= 17; prec++ {
+		s = strconv.FormatFloat(rate*100, 'f', prec, 64)
+		if v, _ := strconv.ParseFloat(s, 64); v/100 == rate {
+			break
+		}
+	}
+	return s
+}
+
+func quote(s string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for _, r := range s {
+		switch r {
+		case '"':
+			b.WriteString(`\"`)
+		case '\\':
+			b.WriteString(`\\`)
+		case '\b':
+			b.WriteString(`\b`)
+		case '\f':
+			b.WriteString(`\f`)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '\t':
+			b.WriteString(`\t`)
+		case '\v':
+			b.WriteString(`\v`)
+		default:
+			if r < 0x20 {
+				fmt.Fprintf(&b, `\u%04x`, r)
+			} else {
+				b.WriteRune(r)
+			}
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
+// quoteRegexp returns a regular expression enclosed in slashes with each
+// unescaped slash escaped.
+func quoteRegexp(re string) string {
+	var b strings.Builder
+	b.WriteByte('/')
+	for k := 0; k < len(re); k++ {
+		switch re[k] {
+		case '\\':
+			b.WriteByte('\\')
+			if k+1 < len(re) {
+				k++
+				b.WriteByte(re[k])
+			}
+		case '/':
+			b.WriteString(`\/`)
+		default:
+			b.WriteByte(re[k])
+		}
+	}
+	b.WriteByte('/')
+	return b.String()
+}
+
+type formatter struct {
+	strings.Builder
+	err error
+}
+
+func (f *formatter) result() (string, error) {
+	if f.err != nil {
+		return "", f.err
+	}
+	return f.String(), nil
+}
+
+func (f *formatter) fail(format string, args ...interface{}) {
+	if f.err == nil {
+		f.err = fmt.Errorf(format, args...)
+	}
+}
+
+// query formats a proc as a complete query, which begins with a search.
+func (f *formatter) query(p ast.Proc) {
+	procs := []ast.Proc{p}
+	if seq, ok := p.(*ast.SequentialProc); ok {
+		procs = seq.Procs
+	}
+	if len(procs) > 0 {
+		if filt, ok := procs[0].(*ast.FilterProc); ok {
+			f.filter(filt.Filter)
+			f.pipe(procs[1:])
+			return
+		}
+	}
+	f.WriteString("*")
+	f.pipe(procs)
+}
+
+func (f *formatter) pipe(procs []ast.Proc) {
+	for _, p := range procs {
+		f.WriteString(" | ")
+		f.proc(p)
+	}
+}
+
+func (f *formatter) chain(p ast.Proc) {
+	seq, ok := p.(*ast.SequentialProc)
+	if !ok {
+		f.proc(p)
+		return
+	}
+	if len(seq.Procs) == 0 {
+		f.fail("cannot format an empty SequentialProc")
+		return
+	}
+	for k, p := range seq.Procs {
+		if k > 0 {
+			f.WriteString(" | ")
+		}
+		f.proc(p)
+	}
+}
+
+func (f *formatter) proc(p ast.Proc) {
+	switch p := p.(type) {
+	case *ast.SequentialProc:
+		f.chain(p)
+	case *ast.ParallelProc:
+		f.WriteString("(")
+		for k, p := range p.Procs {
+			if k > 0 {
+				f.WriteString("; ")
+			}
+			f.chain(p)
+		}
+		f.WriteString(")")
+	case *ast.SwitchProc:
+		f.WriteString("switch (")
+		for k, c := range p.Cases {
+			if k > 0 {
+				f.WriteString("; ")
+			}
+			if _, ok := c.Filter.(*ast.MatchAll); ok {
+				f.WriteString("default")
+			} else {
+				f.filter(c.Filter)
+			}
+			f.WriteString(" => ")
+			f.chain(c.Proc)
+		}
+		f.WriteString(")")
+	case *ast.SortProc:
+		f.WriteString("sort")
+		if p.Limit != 0 {
+			fmt.Fprintf(f, " -limit %d", p.Limit)
+		}
+		if p.SortDir < 0 {
+			f.WriteString(" -r")
+		}
+		if p.NullsFirst {
+			f.WriteString(" -nulls first")
+		}
+		if len(p.Fields) > 0 {
+			f.WriteString(" ")
+			f.fields(p.Fields)
+		}
+	case *ast.TopProc:
+		f.WriteString("top")
+		if p.Limit != 0 {
+			fmt.Fprintf(f, " %d", p.Limit)
+		}
+		if p.Flush {
+			f.WriteString(" -flush")
+		}
+		if len(p.Fields) > 0 {
+			f.WriteString(" ")
+			f.fields(p.Fields)
+		}
+	case *ast.CutProc:
+		f.WriteString("cut ")
+		if p.Partial {
+			f.WriteString("-partial ")
+		}
+		f.fields(p.Fields)
+	case *ast.HeadProc:
+		fmt.Fprintf(f, "head %d", p.Count)
+		f.groupBy(p.Keys)
+	case *ast.TailProc:
+		fmt.Fprintf(f, "tail %d", p.Count)
+		f.groupBy(p.Keys)
+	case *ast.PassProc:
+		f.WriteString("pass")
+	case *ast.FilterProc:
+		f.WriteString("filter ")
+		f.filter(p.Filter)
+	case *ast.UniqProc:
+		switch {
+		case len(p.Keys) > 0:
+			f.WriteString("uniq -by ")
+			f.fields(p.Keys)
+			if p.Limit != 0 {
+				fmt.Fprintf(f, " -limit %d", p.Limit)
+			}
+		case p.Cflag:
+			f.WriteString("uniq -c")
+		default:
+			f.WriteString("uniq")
+		}
+	case *ast.ReducerProc:
+		f.reducers(p.Reducers)
+	case *ast.GroupByProc:
+		f.every(p.Duration, p.Window)
+		f.reducers(p.Reducers)
+		f.groupBy(p.Keys)
+		if p.Limit != 0 {
+			fmt.Fprintf(f, " -limit %d", p.Limit)
+		}
+	case *ast.PutProc:
+		f.WriteString("put ")
+		f.assignment(p.Target, p.Expr)
+	case *ast.WindowProc:
+		f.WriteString("window ")
+		for k, a := range p.Assignments {
+			if k > 0 {
+				f.WriteString(", ")
+			}
+			f.assignment(a.Target, a.Expr)
+		}
+		f.groupBy(p.Keys)
+	case *ast.SessionProc:
+		f.WriteString("session gap ")
+		f.WriteString(formatDuration(p.Gap))
+		if len(p.Reducers) > 0 {
+			f.WriteString(" ")
+			f.reducers(p.Reducers)
+		}
+		f.groupBy(p.Keys)
+	case *ast.SampleProc:
+		if p.Rate != 0 {
+			fmt.Fprintf(f, "sample %s%%", formatPercent(p.Rate))
+		} else {
+			fmt.Fprintf(f, "sample %d", p.Size)
+		}
+		f.groupBy(p.Keys)
+		if p.Seed != 0 {
+			fmt.Fprintf(f, " -seed %d", p.Seed)
+		}
+	case *ast.HistogramProc:
+		f.WriteString("histogram ")
+		f.field(p.Field)
+		switch {
+		case len(p.Bounds) > 0:
+			f.WriteString(" bounds ")
+			for k, b := range p.Bounds {
+				if k > 0 {
+					f.WriteString(", ")
+				}
+				f.WriteString(strconv.FormatFloat(b, 'f', -1, 64))
+			}
+		case p.Log:
+			f.WriteString(" log")
+		case p.Bins != 0:
+			fmt.Fprintf(f, " bins %d", p.Bins)
+		}
+		f.groupBy(p.Keys)
+	case *ast.LookupProc:
+		fmt.Fprintf(f, "lookup file=%s on ", quote(p.File))
+		f.field(p.Key)
+		f.WriteString("=")
+		f.name(p.TableKey)
+		if len(p.Fields) > 0 {
+			f.WriteString(" fields ")
+			for k, name := range p.Fields {
+				if k > 0 {
+					f.WriteString(", ")
+				}
+				f.name(name)
+			}
+		}
+	case *ast.IntelProc:
+		if len(p.Files) == 0 {
+			f.fail("cannot format an IntelProc with no files")
+			return
+		}
+		f.WriteString("intel file=")
+		for k, file := range p.Files {
+			if k > 0 {
+				f.WriteString(", ")
+			}
+			f.WriteString(quote(file))
+		}
+	case *ast.ParseProc:
+		f.WriteString("parse ")
+		f.field(p.Field)
+		f.WriteString(" with ")
+		f.WriteString(quote(p.Pattern))
+		if p.PatternFile != "" {
+			f.WriteString(" -patterns ")
+			f.WriteString(quote(p.PatternFile))
+		}
+		if p.Warn {
+			f.WriteString(" -warn")
+		}
+	case *ast.UnnestProc:
+		f.WriteString("unnest ")
+		f.name(p.Field)
+	default:
+		f.fail("cannot format proc type %T", p)
+	}
+}
+
+// name writes a field name that must be written as a single identifier.
+func (f *formatter) name(name string) {
+	if !fieldNameRegexp.MatchString(name) {
+		f.fail("cannot format field name %q", name)
+	}
+	f.WriteString(name)
+}
+
+func (f *formatter) field(e ast.FieldExpr) {
+	switch e := e.(type) {
+	case *ast.FieldRead:
+		f.WriteString(e.Field)
+	case *ast.FieldCall:
+		switch e.Fn {
+		case "RecordFieldRead":
+			f.field(e.Field)
+			f.WriteString(".")
+			f.name(e.Param)
+		case "Index":
+			f.field(e.Field)
+			fmt.Fprintf(f, "[%s]", e.Param)
+		case "Len":
+			f.WriteString("len(")
+			f.field(e.Field)
+			f.WriteString(")")
+		default:
+			f.fail("cannot format field call %s", e.Fn)
+		}
+	default:
+		f.fail("cannot format field expression type %T", e)
+	}
+}
+
+func (f *formatter) fields(fields []ast.FieldExpr) {
+	for k, e := range fields {
+		if k > 0 {
+			f.WriteString(", ")
+		}
+		f.field(e)
+	}
+}
+
+func (f *formatter) groupBy(keys []ast.FieldExpr) {
+	if len(keys) > 0 {
+		f.WriteString(" by ")
+		f.fields(keys)
+	}
+}
+
+func (f *formatter) every(d ast.Duration, w ast.TimeWindow) {
+	switch w.Kind {
+	case ast.WindowSliding:
+		fmt.Fprintf(f, "every %s slide %s ", formatDuration(d), formatDuration(w.Slide))
+	case ast.WindowCalendar:
+		fmt.Fprintf(f, "every %d%s", w.Count, w.Unit)
+		tz := w.TimeZone
+		if tz == "" && (w.Unit == "day" || w.Unit == "week") {
+			// Days and weeks are calendar intervals only when a time
+			// zone is given.
+			tz = "UTC"
+		}
+		if tz != "" {
+			fmt.Fprintf(f, " tz %s", quote(tz))
+		}
+		f.WriteString(" ")
+	default:
+		if d.Nanoseconds != 0 {
+			fmt.Fprintf(f, "every %s ", formatDuration(d))
+		}
+	}
+}
+
+func (f *formatter) reducers(reducers []ast.Reducer) {
+	for k, r := range reducers {
+		if k > 0 {
+			f.WriteString(", ")
+		}
+		name, ok := reducerNames[r.Op]
+		if !ok {
+			f.fail("cannot format reducer %s", r.Op)
+			return
+		}
+		f.WriteString(name + "(")
+		if r.Field != nil {
+			f.field(r.Field)
+		} else if r.Op != "Count" {
+			f.fail("cannot format reducer %s with no field", r.Op)
+		}
+		f.WriteString(")")
+		if r.Var != name {
+			f.WriteString(" as ")
+			f.name(r.Var)
+		}
+	}
+}
+
+func (f *formatter) assignment(target string, e ast.Expression) {
+	f.name(target)
+	f.WriteString(" = ")
+	f.expr(e, 0)
+}
+
+// filter writes a boolean expression, enclosing each operand of a logical
+// operator in parentheses when needed for it to parse back to the same
+// tree.  A "not" applies to everything that follows it, so a negated
+// operand is always enclosed.
+func (f *formatter) filter(e ast.BooleanExpr) {
+	switch e := e.(type) {
+	case *ast.LogicalOr:
+		if s, ok := bareSearch(e); ok {
+			if isBareWord(s) {
+				f.WriteString(s.Value)
+			} else {
+				f.literal(*s)
+			}
+			return
+		}
+		f.operand(e.Left, isNot(e.Left))
+		f.WriteString(" or ")
+		f.operand(e.Right, isOr(e.Right) || isNot(e.Right))
+	case *ast.LogicalAnd:
+		f.operand(e.Left, isOr(e.Left) || isNot(e.Left))
+		f.WriteString(" ")
+		f.operand(e.Right, isOr(e.Right) || isNot(e.Right) || isAnd(e.Right))
+	case *ast.LogicalNot:
+		f.WriteString("not ")
+		f.operand(e.Expr, isOr(e.Expr) || isAnd(e.Expr))
+	case *ast.MatchAll:
+		f.WriteString("*")
+	case *ast.CompareAny:
+		switch {
+		case e.Comparator == "in" && !e.Recursive:
+			f.literal(e.Value)
+			f.WriteString(" in *")
+		case comparators[e.Comparator] != "":
+			if e.Recursive {
+				f.WriteString("**")
+			} else {
+				f.WriteString("*")
+			}
+			f.WriteString(comparators[e.Comparator])
+			f.literal(e.Value)
+		default:
+			f.fail("cannot format comparison %s of any field", e.Comparator)
+		}
+	case *ast.CompareField:
+		if e.Comparator == "in" {
+			f.literal(e.Value)
+			f.WriteString(" in ")
+			f.field(e.Field)
+			return
+		}
+		op, ok := comparators[e.Comparator]
+		if !ok {
+			f.fail("cannot format comparison %s of a field", e.Comparator)
+			return
+		}
+		f.field(e.Field)
+		f.WriteString(op)
+		f.literal(e.Value)
+	default:
+		f.fail("cannot format boolean expression type %T", e)
+	}
+}
+
+func (f *formatter) operand(e ast.BooleanExpr, parens bool) {
+	if o, ok := e.(*ast.LogicalOr); ok {
+		if _, ok := bareSearch(o); ok {
+			parens = false
+		}
+	}
+	if parens {
+		f.WriteString("(")
+	}
+	f.filter(e)
+	if parens {
+		f.WriteString(")")
+	}
+}
+
+func isOr(e ast.BooleanExpr) bool {
+	_, ok := e.(*ast.LogicalOr)
+	return ok
+}
+
+func isAnd(e ast.BooleanExpr) bool {
+	_, ok := e.(*ast.LogicalAnd)
+	return ok
+}
+
+func isNot(e ast.BooleanExpr) bool {
+	_, ok := e.(*ast.LogicalNot)
+	return ok
+}
+
+func compareAny(e ast.BooleanExpr, comparator string) (*ast.Literal, bool) {
+	c, ok := e.(*ast.CompareAny)
+	if !ok || c.Comparator != comparator || !c.Recursive {
+		return nil, false
+	}
+	return &c.Value, true
+}
+
+// bareSearch returns the value of the search term that the parser expanded
+// into e or false if e is not such an expansion.  A string matches itself
+// and a regular expression matches any value it matches.  Any other value
+// matches itself and its text as a string.
+func bareSearch(e *ast.LogicalOr) (*ast.Literal, bool) {
+	if l, ok := compareAny(e.Left, "search"); ok {
+		if r, ok := compareAny(e.Right, "searchin"); ok && l.Type == "string" && *l == *r {
+			return l, true
+		}
+		return nil, false
+	}
+	if l, ok := compareAny(e.Left, "eql"); ok {
+		if r, ok := compareAny(e.Right, "in"); ok && l.Type == "regexp" && *l == *r {
+			return l, true
+		}
+		return nil, false
+	}
+	in, ok := compareAny(e.Right, "in")
+	if !ok {
+		return nil, false
+	}
+	e2, ok := e.Left.(*ast.LogicalOr)
+	if !ok {
+		return nil, false
+	}
+	if eql, ok := compareAny(e2.Right, "eql"); !ok || *eql != *in {
+		return nil, false
+	}
+	e3, ok := e2.Left.(*ast.LogicalOr)
+	if !ok {
+		return nil, false
+	}
+	s, ok := compareAny(e3.Left, "search")
+	if !ok || s.Type != "string" {
+		return nil, false
+	}
+	if s2, ok := compareAny(e3.Right, "searchin"); !ok || *s2 != *s {
+		return nil, false
+	}
+	return in, true
+}
+
+var bareWordRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z_.0-9]*$`)
+
+// isBareWord returns true if the string searched for by a bare search term
+// can be written without quotes.  Such a word must not be a keyword and
+// must parse as a search for itself rather than as, e.g., a proc or a
+// boolean.
+func isBareWord(v *ast.Literal) bool {
+	if v.Type != "string" || !bareWordRegexp.MatchString(v.Value) {
+		return false
+	}
+	switch strings.ToLower(v.Value) {
+	case "and", "or", "not", "in", "default":
+		return false
+	}
+	p, err := Parse("", []byte(v.Value))
+	if err != nil {
+		return false
+	}
+	filt, ok := p.(*ast.FilterProc)
+	if !ok {
+		return false
+	}
+	e, ok := filt.Filter.(*ast.LogicalOr)
+	if !ok {
+		return false
+	}
+	s, ok := bareSearch(e)
+	return ok && *s == *v
+}
+
+// literal writes a value as it is written in a search or an expression.
+func (f *formatter) literal(v ast.Literal) {
+	switch v.Type {
+	case "string":
+		f.WriteString(quote(v.Value))
+	case "regexp":
+		if v.Value == "" {
+			f.fail("cannot format an empty regular expression")
+		}
+		f.WriteString(quoteRegexp(v.Value))
+	case "port":
+		f.WriteString(":" + v.Value)
+	case "ip", "net", "int64", "float64", "bool":
+		f.WriteString(v.Value)
+	case "null":
+		f.WriteString("null")
+	default:
+		f.fail("cannot format literal of type %s", v.Type)
+	}
+}
+
+// expr writes an expression that is an operand of an operator with the
+// given precedence level.
+func (f *formatter) expr(e ast.Expression, prec int) {
+	parens := exprPrec(e) < prec
+	if parens {
+		f.WriteString("(")
+	}
+	switch e := e.(type) {
+	case *ast.BinaryExpression:
+		level := exprPrec(e)
+		switch e.Operator {
+		case "[":
+			f.expr(e.LHS, precDeref)
+			f.WriteString("[")
+			f.expr(e.RHS, 0)
+			f.WriteString("]")
+		case ".":
+			f.expr(e.LHS, precDeref)
+			f.WriteString(".")
+			lit, ok := e.RHS.(*ast.Literal)
+			if !ok || lit.Type != "string" {
+				f.fail("cannot format field dereference of %T", e.RHS)
+				break
+			}
+			f.name(lit.Value)
+		default:
+			if level == precPrimary {
+				f.fail("cannot format operator %s", e.Operator)
+				break
+			}
+			// Operators are left associative so a right operand at
+			// the same level must be enclosed.
+			f.expr(e.LHS, level)
+			f.WriteString(" " + e.Operator + " ")
+			f.expr(e.RHS, level+1)
+		}
+	case *ast.FunctionCall:
+		f.WriteString(e.Function + "(")
+		for k, arg := range e.Args {
+			if k > 0 {
+				f.WriteString(", ")
+			}
+			f.expr(arg, 0)
+		}
+		f.WriteString(")")
+	case *ast.Literal:
+		f.literal(*e)
+	case *ast.FieldRead:
+		f.WriteString(e.Field)
+	default:
+		f.fail("cannot format expression type %T", e)
+	}
+	if parens {
+		f.WriteString(")")
+	}
+}
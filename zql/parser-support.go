@@ -2,27 +2,88 @@ package zql
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
 
 	"github.com/brimsec/zq/ast"
+	"github.com/brimsec/zq/pkg/nano"
+	"github.com/brimsec/zq/zng"
 )
 
 // ParseProc() is an entry point for use from external go code,
 // mostly just a wrapper around Parse() that casts the return value.
-func ParseProc(query string) (ast.Proc, error) {
-	parsed, err := Parse("", []byte(query))
+// Macros defined by the libraries, which contain only macro definitions,
+// may be used in the query.  A syntax error is returned as a *ParseError
+// and a query that parses but fails Check returns a *SemanticError.
+func ParseProc(query string, libraries ...string) (ast.Proc, error) {
+	return ParseProcWithParams(query, nil, libraries...)
+}
+
+// ParseProcWithParams is like ParseProc but binds each placeholder $name
+// in the query to params[name].  Bound values are inserted into the AST as
+// literals and are never parsed as ZQL, so they need no quoting or escaping.
+func ParseProcWithParams(query string, params map[string]ast.Literal, libraries ...string) (ast.Proc, error) {
+	for name, lit := range params {
+		if _, err := zng.Parse(lit); err != nil {
+			return nil, fmt.Errorf("parameter $%s: %w", name, err)
+		}
+	}
+	macros := make(map[string]ast.Proc)
+	opts := []Option{GlobalStore("macros", macros), GlobalStore("params", params)}
+	for k, lib := range libraries {
+		if _, err := Parse("", []byte(lib), append(opts, Entrypoint("library"))...); err != nil {
+			return nil, fmt.Errorf("library %d: %w", k+1, newParseError(lib, err))
+		}
+	}
+	parsed, err := Parse("", []byte(query), opts...)
 	if err != nil {
-		return nil, err
+		return nil, newParseError(query, err)
 	}
 	ret, ok := parsed.(ast.Proc)
 	if !ok {
 		return nil, fmt.Errorf("parser generated a %T (expected ast.Proc)", parsed)
 	}
+	if err := Check(ret); err != nil {
+		return nil, err
+	}
 	return ret, nil
 }
 
+// getMacros returns the macros defined so far, which are kept in the
+// parser's global store so that definitions from libraries are available
+// to a query.
+func getMacros(store map[string]interface{}) map[string]ast.Proc {
+	macros, ok := store["macros"].(map[string]ast.Proc)
+	if !ok {
+		macros = make(map[string]ast.Proc)
+		store["macros"] = macros
+	}
+	return macros
+}
+
+func defineMacro(store map[string]interface{}, nameIn, bodyIn interface{}) interface{} {
+	getMacros(store)[nameIn.(string)] = bodyIn.(ast.Proc)
+	return nil
+}
+
+func isMacro(store map[string]interface{}, nameIn interface{}) bool {
+	_, ok := getMacros(store)[nameIn.(string)]
+	return ok
+}
+
+// expandMacro returns a copy of the body of a macro so that each use of
+// the macro has its own AST nodes.
+func expandMacro(store map[string]interface{}, nameIn interface{}) (ast.Proc, error) {
+	body := getMacros(store)[nameIn.(string)]
+	b, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	return ast.UnpackProc(nil, b)
+}
+
 // Helper to get a properly-typed slice of Procs from an interface{}.
 func procArray(val interface{}) []ast.Proc {
 	var ret []ast.Proc
@@ -33,7 +94,15 @@ func procArray(val interface{}) []ast.Proc {
 }
 
 func makeSequentialProc(procsIn interface{}) ast.Proc {
-	procs := procArray(procsIn)
+	var procs []ast.Proc
+	for _, p := range procArray(procsIn) {
+		// Splice in the procs of a macro that expanded to a sequence.
+		if seq, ok := p.(*ast.SequentialProc); ok {
+			procs = append(procs, seq.Procs...)
+		} else {
+			procs = append(procs, p)
+		}
+	}
 	if len(procs) == 0 {
 		return procs[0]
 	}
@@ -48,6 +117,19 @@ func makeParallelProc(procsIn interface{}) ast.Proc {
 	return &ast.ParallelProc{ast.Node{"ParallelProc"}, procArray(procsIn)}
 }
 
+func makeSwitchCase(filterIn, procIn interface{}) *ast.SwitchCase {
+	return &ast.SwitchCase{filterIn.(ast.BooleanExpr), procIn.(ast.Proc)}
+}
+
+func makeSwitchProc(casesIn interface{}) *ast.SwitchProc {
+	arr := casesIn.([]interface{})
+	cases := make([]ast.SwitchCase, len(arr))
+	for i, c := range arr {
+		cases[i] = *(c.(*ast.SwitchCase))
+	}
+	return &ast.SwitchProc{ast.Node{"SwitchProc"}, cases}
+}
+
 func makeLiteral(typ string, val interface{}) *ast.Literal {
 	return &ast.Literal{ast.Node{"Literal"}, typ, val.(string)}
 }
@@ -56,6 +138,35 @@ func getValueType(val interface{}) string {
 	return val.(*ast.Literal).Type
 }
 
+func getValueText(val interface{}) string {
+	return val.(*ast.Literal).Value
+}
+
+func getParams(store map[string]interface{}) map[string]ast.Literal {
+	params, _ := store["params"].(map[string]ast.Literal)
+	return params
+}
+
+func isParam(store map[string]interface{}, nameIn interface{}) bool {
+	_, ok := getParams(store)[nameIn.(string)]
+	return ok
+}
+
+func hasParams(store map[string]interface{}) bool {
+	return getParams(store) != nil
+}
+
+// unboundParam returns the error for a parameter that is not bound.
+func unboundParam(store map[string]interface{}, nameIn interface{}) (*ast.Literal, error) {
+	return nil, fmt.Errorf("parameter $%s is not bound", nameIn.(string))
+}
+
+// bindParam returns a literal holding the value bound to a parameter.
+func bindParam(store map[string]interface{}, nameIn interface{}) *ast.Literal {
+	lit := getParams(store)[nameIn.(string)]
+	return &ast.Literal{ast.Node{"Literal"}, lit.Type, lit.Value}
+}
+
 type FieldCallPlaceholder struct {
 	op    string
 	param string
@@ -201,23 +312,35 @@ func makeTopProc(fieldsIn, limitIn, flushIn interface{}) *ast.TopProc {
 	return &ast.TopProc{ast.Node{"TopProc"}, limit, fields, flush}
 }
 
-func makeCutProc(fieldsIn interface{}) *ast.CutProc {
+func makeCutProc(fieldsIn, partialIn interface{}) *ast.CutProc {
 	fields := fieldExprArray(fieldsIn)
-	return &ast.CutProc{ast.Node{"CutProc"}, fields}
+	partial := partialIn != nil
+	return &ast.CutProc{ast.Node{"CutProc"}, fields, partial}
 }
 
-func makeHeadProc(countIn interface{}) *ast.HeadProc {
+func makeHeadProc(countIn, keysIn interface{}) *ast.HeadProc {
 	count := countIn.(int)
-	return &ast.HeadProc{ast.Node{"HeadProc"}, count}
+	keys := fieldExprArray(keysIn)
+	return &ast.HeadProc{ast.Node{"HeadProc"}, count, keys}
 }
 
-func makeTailProc(countIn interface{}) *ast.TailProc {
+func makeTailProc(countIn, keysIn interface{}) *ast.TailProc {
 	count := countIn.(int)
-	return &ast.TailProc{ast.Node{"TailProc"}, count}
+	keys := fieldExprArray(keysIn)
+	return &ast.TailProc{ast.Node{"TailProc"}, count, keys}
 }
 
 func makeUniqProc(cflag bool) *ast.UniqProc {
-	return &ast.UniqProc{ast.Node{"UniqProc"}, cflag}
+	return &ast.UniqProc{ast.Node{"UniqProc"}, cflag, nil, 0}
+}
+
+func makeUniqByProc(keysIn, limitIn interface{}) *ast.UniqProc {
+	keys := fieldExprArray(keysIn)
+	var limit int
+	if limitIn != nil {
+		limit = limitIn.(int)
+	}
+	return &ast.UniqProc{ast.Node{"UniqProc"}, false, keys, limit}
 }
 
 func makeFilterProc(expr interface{}) *ast.FilterProc {
@@ -228,6 +351,133 @@ func makePutProc(target, expr interface{}) *ast.PutProc {
 	return &ast.PutProc{ast.Node{"PutProc"}, target.(string), expr.(ast.Expression)}
 }
 
+func makeAssignment(targetIn, exprIn interface{}) *ast.Assignment {
+	return &ast.Assignment{targetIn.(string), exprIn.(ast.Expression)}
+}
+
+func makeWindowProc(assignmentsIn, keysIn interface{}) *ast.WindowProc {
+	arr := assignmentsIn.([]interface{})
+	assignments := make([]ast.Assignment, len(arr))
+	for i, a := range arr {
+		assignments[i] = *(a.(*ast.Assignment))
+	}
+	keys := fieldExprArray(keysIn)
+	return &ast.WindowProc{ast.Node{"WindowProc"}, assignments, keys}
+}
+
+func makeSessionProc(gapIn, reducersIn, keysIn interface{}) *ast.SessionProc {
+	var reducers []ast.Reducer
+	if reducersIn != nil {
+		reducers = reducersArray(reducersIn)
+	}
+	return &ast.SessionProc{
+		Node:     ast.Node{"SessionProc"},
+		Gap:      *(gapIn.(*ast.Duration)),
+		Keys:     fieldExprArray(keysIn),
+		Reducers: reducers,
+	}
+}
+
+func makeSampleProc(sizeIn, rateIn, keysIn, seedIn interface{}) *ast.SampleProc {
+	var size int
+	if sizeIn != nil {
+		size = sizeIn.(int)
+	}
+	var rate float64
+	switch v := rateIn.(type) {
+	case int:
+		rate = float64(v) / 100
+	case float64:
+		rate = v / 100
+	}
+	var seed int64
+	if seedIn != nil {
+		seed = int64(seedIn.(int))
+	}
+	return &ast.SampleProc{
+		Node: ast.Node{"SampleProc"},
+		Size: size,
+		Rate: rate,
+		Keys: fieldExprArray(keysIn),
+		Seed: seed,
+	}
+}
+
+func makeHistogramProc(fieldIn, binsIn, logIn, boundsIn, keysIn interface{}) *ast.HistogramProc {
+	var bins int
+	if binsIn != nil {
+		bins = binsIn.(int)
+	}
+	var bounds []float64
+	if boundsIn != nil {
+		for _, b := range boundsIn.([]interface{}) {
+			switch v := b.(type) {
+			case int:
+				bounds = append(bounds, float64(v))
+			case float64:
+				bounds = append(bounds, v)
+			}
+		}
+	}
+	return &ast.HistogramProc{
+		Node:   ast.Node{"HistogramProc"},
+		Field:  fieldIn.(ast.FieldExpr),
+		Bins:   bins,
+		Log:    logIn.(bool),
+		Bounds: bounds,
+		Keys:   fieldExprArray(keysIn),
+	}
+}
+
+func makeLookupProc(fileIn, keyIn, tableKeyIn, fieldsIn interface{}) *ast.LookupProc {
+	var fields []string
+	if fieldsIn != nil {
+		for _, f := range fieldsIn.([]interface{}) {
+			fields = append(fields, f.(string))
+		}
+	}
+	return &ast.LookupProc{
+		Node:     ast.Node{"LookupProc"},
+		File:     fileIn.(string),
+		Key:      keyIn.(ast.FieldExpr),
+		TableKey: tableKeyIn.(string),
+		Fields:   fields,
+	}
+}
+
+func makeIntelProc(filesIn interface{}) *ast.IntelProc {
+	var files []string
+	for _, f := range filesIn.([]interface{}) {
+		files = append(files, f.(string))
+	}
+	return &ast.IntelProc{
+		Node:  ast.Node{"IntelProc"},
+		Files: files,
+	}
+}
+
+func makeParseProc(fieldIn, patternIn, patternFileIn, warnIn interface{}) *ast.ParseProc {
+	var patternFile string
+	if patternFileIn != nil {
+		patternFile = patternFileIn.(string)
+	}
+	return &ast.ParseProc{
+		Node:        ast.Node{"ParseProc"},
+		Field:       fieldIn.(ast.FieldExpr),
+		Pattern:     patternIn.(string),
+		PatternFile: patternFile,
+		Warn:        warnIn != nil,
+	}
+}
+
+func makePassProc() *ast.PassProc {
+	return &ast.PassProc{ast.Node{"PassProc"}}
+}
+
+func makeUnnestProc(fieldIn interface{}) *ast.UnnestProc {
+	return &ast.UnnestProc{ast.Node{"UnnestProc"}, fieldIn.(string)}
+}
+
 func makeReducer(opIn, varIn, fieldIn interface{}) *ast.Reducer {
 	var field ast.FieldExpr
 	if fieldIn != nil {
@@ -243,7 +493,50 @@ func overrideReducerVar(reducerIn, varIn interface{}) *ast.Reducer {
 }
 
 func makeDuration(seconds interface{}) *ast.Duration {
-	return &ast.Duration{seconds.(int)}
+	return &ast.Duration{nano.Duration(int64(seconds.(int)), 0)}
+}
+
+func makeNanoDuration(nanoseconds interface{}) *ast.Duration {
+	return &ast.Duration{int64(nanoseconds.(int))}
+}
+
+// An everyClause holds the time window of a group-by's every clause
+// until it is attached to the GroupByProc.
+type everyClause struct {
+	duration ast.Duration
+	window   ast.TimeWindow
+}
+
+func makeEvery(durationIn, windowIn interface{}) *everyClause {
+	var every everyClause
+	if durationIn != nil {
+		every.duration = *(durationIn.(*ast.Duration))
+	}
+	if windowIn != nil {
+		every.window = *(windowIn.(*ast.TimeWindow))
+	}
+	return &every
+}
+
+func makeSlidingWindow(slideIn interface{}) *ast.TimeWindow {
+	return &ast.TimeWindow{
+		Kind:  ast.WindowSliding,
+		Slide: *(slideIn.(*ast.Duration)),
+	}
+}
+
+func makeCalendarWindow(intervalIn, tzIn interface{}) *ast.TimeWindow {
+	interval := intervalIn.([]interface{})
+	var tz string
+	if tzIn != nil {
+		tz = tzIn.(string)
+	}
+	return &ast.TimeWindow{
+		Kind:     ast.WindowCalendar,
+		Unit:     interval[1].(string),
+		Count:    interval[0].(int),
+		TimeZone: tz,
+	}
 }
 
 func reducersArray(reducersIn interface{}) []ast.Reducer {
@@ -262,10 +555,10 @@ func makeReducerProc(reducers interface{}) *ast.ReducerProc {
 	}
 }
 
-func makeGroupByProc(durationIn, limitIn, keysIn, reducersIn interface{}) *ast.GroupByProc {
-	var duration ast.Duration
-	if durationIn != nil {
-		duration = *(durationIn.(*ast.Duration))
+func makeGroupByProc(everyIn, limitIn, keysIn, reducersIn interface{}) *ast.GroupByProc {
+	var every everyClause
+	if everyIn != nil {
+		every = *(everyIn.(*everyClause))
 	}
 
 	var limit int
@@ -278,7 +571,8 @@ func makeGroupByProc(durationIn, limitIn, keysIn, reducersIn interface{}) *ast.G
 
 	return &ast.GroupByProc{
 		Node:     ast.Node{"GroupByProc"},
-		Duration: duration,
+		Duration: every.duration,
+		Window:   every.window,
 		Limit:    limit,
 		Keys:     keys,
 		Reducers: reducers,
@@ -358,7 +652,7 @@ func parseInt(v interface{}) interface{} {
 
 func parseFloat(v interface{}) interface{} {
 	num := v.(string)
-	if f, err := strconv.ParseFloat(num, 10); err != nil {
+	if f, err := strconv.ParseFloat(num, 64); err == nil {
 		return f
 	}
 
@@ -1,15 +1,61 @@
 let reglob = require("../reglob/reglob")
 
   function makeSequentialProc(procs) {
+    // Splice in the procs of a macro that expanded to a sequence.
+    procs = [].concat(...procs.map((p) => p.op === "SequentialProc" ? p.procs : [p]));
     return { op: "SequentialProc", procs };
   }
 
+function getMacros(options) {
+  if (!options.macros) { options.macros = {}; }
+  return options.macros;
+}
+
+function defineMacro(options, name, body) {
+  getMacros(options)[name] = body;
+  return null;
+}
+
+function isMacro(options, name) {
+  return Object.prototype.hasOwnProperty.call(getMacros(options), name);
+}
+
+function expandMacro(options, name) {
+  return JSON.parse(JSON.stringify(getMacros(options)[name]));
+}
+
 function makeParallelProc(procs) {
   return { op: "ParallelProc", procs };
 }
 
+function makeSwitchCase(filter, proc) {
+  return { filter, proc };
+}
+
+function makeSwitchProc(cases) {
+  return { op: "SwitchProc", cases };
+}
+
 function makeLiteral(type, value) { return { op: "Literal", type, value }; }
 function getValueType(v) { return v.type; }
+function getValueText(v) { return v.value; }
+
+function isParam(options, name) {
+  return Object.prototype.hasOwnProperty.call(options.params || {}, name);
+}
+
+function hasParams(options) {
+  return options.params != null;
+}
+
+function unboundParam(options, name) {
+  throw new Error(`parameter $${name} is not bound`);
+}
+
+function bindParam(options, name) {
+  let { type, value } = options.params[name];
+  return makeLiteral(type, value);
+}
 
 function makeFieldCall(fn, field, param) {
   return { op: "FieldCall", fn, field, param };
@@ -81,11 +127,78 @@ function makeTopProc(fields, limit, flush) {
   return { op: "TopProc", fields, limit, flush};
 }
 
-function makeCutProc(fields) { return { op: "CutProc", fields }; }
-function makeHeadProc(count) { return { op: "HeadProc", count }; }
-function makeTailProc(count) { return { op: "TailProc", count }; }
-function makeUniqProc(cflag) { return { op: "TailProc", cflag }; }
+function makeCutProc(fields, partial) {
+  if (partial) { return { op: "CutProc", fields, partial: true }; }
+  return { op: "CutProc", fields };
+}
+function makeHeadProc(count, keys) {
+  if (keys === null) { keys = undefined; }
+  return { op: "HeadProc", count, keys };
+}
+function makeTailProc(count, keys) {
+  if (keys === null) { keys = undefined; }
+  return { op: "TailProc", count, keys };
+}
+function makeUniqProc(cflag) { return { op: "UniqProc", cflag }; }
+function makeUniqByProc(keys, limit) {
+  if (limit === null) { limit = undefined; }
+  return { op: "UniqProc", cflag: false, keys, limit };
+}
 function makeFilterProc(filter) { return { op: "FilterProc", filter }; }
+function makePutProc(target, expression) {
+  return { op: "PutProc", target, expression };
+}
+function makeAssignment(target, expression) {
+  return { target, expression };
+}
+function makeWindowProc(assignments, keys) {
+  if (keys === null) { keys = undefined; }
+  return { op: "WindowProc", assignments, keys };
+}
+
+function makeSessionProc(gap, reducers, keys) {
+  if (reducers === null) { reducers = undefined; }
+  if (keys === null) { keys = undefined; }
+  return { op: "SessionProc", gap, reducers, keys };
+}
+
+function makeSampleProc(size, rate, keys, seed) {
+  if (size === null) { size = undefined; }
+  if (rate === null) { rate = undefined; } else { rate = rate / 100; }
+  if (keys === null) { keys = undefined; }
+  if (seed === null) { seed = undefined; }
+  return { op: "SampleProc", size, rate, keys, seed };
+}
+
+function makeHistogramProc(field, bins, log, bounds, keys) {
+  if (bins === null) { bins = undefined; }
+  if (bounds === null) { bounds = undefined; }
+  if (keys === null) { keys = undefined; }
+  return { op: "HistogramProc", field, bins, log, bounds, keys };
+}
+
+function makeLookupProc(file, key, table_key, fields) {
+  if (fields === null) { fields = undefined; }
+  return { op: "LookupProc", file, key, table_key, fields };
+}
+
+function makeIntelProc(files) {
+  return { op: "IntelProc", files };
+}
+
+function makeParseProc(field, pattern, pattern_file, warn) {
+  if (pattern_file === null) { pattern_file = undefined; }
+  return { op: "ParseProc", field, pattern, pattern_file, warn: !!warn };
+}
+
+function makePassProc() {
+  return { op: "PassProc" };
+}
+
+function makeUnnestProc(field) {
+  return { op: "UnnestProc", field };
+}
+
 function makeReducer(op, var_, field) {
   if (field === null) { field = undefined; }
   return { op, var: var_, field };
@@ -99,13 +212,35 @@ function makeDuration(seconds) {
   return {type: "Duration", seconds};
 }
 
+function makeNanoDuration(nanoseconds) {
+  return {type: "Duration", nanoseconds};
+}
+
+function makeEvery(duration, window) {
+  return { duration: duration || undefined, window: window || undefined };
+}
+
+function makeSlidingWindow(slide) {
+  return { kind: "sliding", slide };
+}
+
+function makeCalendarWindow(interval, tz) {
+  if (tz === null) { tz = undefined; }
+  return { kind: "calendar", count: interval[0], unit: interval[1], tz };
+}
+
 function makeReducerProc(reducers) {
   return { op: "ReducerProc", reducers };
 }
 
-function makeGroupByProc(duration, limit, keys, reducers) {
+function makeGroupByProc(every, limit, keys, reducers) {
   if (limit === null) { limit = undefined; }
-  return { op: "GroupByProc", keys, reducers, duration, limit };
+  let duration, window;
+  if (every) {
+    duration = every.duration;
+    window = every.window;
+  }
+  return { op: "GroupByProc", keys, reducers, duration, window, limit };
 }
 
 function makeBinaryExprChain(first, rest) {
@@ -23,37 +23,56 @@ var g = &grammar{
 	rules: []*rule{
 		{
 			name: "start",
-			pos:  position{line: 12, col: 1, offset: 69},
+			pos:  position{line: 5, col: 1, offset: 62},
 			expr: &actionExpr{
-				pos: position{line: 12, col: 9, offset: 77},
+				pos: position{line: 5, col: 9, offset: 70},
 				run: (*parser).callonstart1,
 				expr: &seqExpr{
-					pos: position{line: 12, col: 9, offset: 77},
+					pos: position{line: 5, col: 9, offset: 70},
 					exprs: []interface{}{
 						&zeroOrOneExpr{
-							pos: position{line: 12, col: 9, offset: 77},
+							pos: position{line: 5, col: 9, offset: 70},
 							expr: &ruleRefExpr{
-								pos:  position{line: 12, col: 9, offset: 77},
-								name: "_",
+								pos:  position{line: 5, col: 9, offset: 70},
+								name: "defSpace",
+							},
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 5, col: 19, offset: 80},
+							expr: &seqExpr{
+								pos: position{line: 5, col: 20, offset: 81},
+								exprs: []interface{}{
+									&ruleRefExpr{
+										pos:  position{line: 5, col: 20, offset: 81},
+										name: "macroDef",
+									},
+									&zeroOrOneExpr{
+										pos: position{line: 5, col: 29, offset: 90},
+										expr: &ruleRefExpr{
+											pos:  position{line: 5, col: 29, offset: 90},
+											name: "defSpace",
+										},
+									},
+								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 12, col: 12, offset: 80},
+							pos:   position{line: 5, col: 41, offset: 102},
 							label: "ast",
 							expr: &ruleRefExpr{
-								pos:  position{line: 12, col: 16, offset: 84},
+								pos:  position{line: 5, col: 45, offset: 106},
 								name: "query",
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 12, col: 22, offset: 90},
+							pos: position{line: 5, col: 51, offset: 112},
 							expr: &ruleRefExpr{
-								pos:  position{line: 12, col: 22, offset: 90},
+								pos:  position{line: 5, col: 51, offset: 112},
 								name: "_",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 12, col: 25, offset: 93},
+							pos:  position{line: 5, col: 54, offset: 115},
 							name: "EOF",
 						},
 					},
@@ -61,51 +80,248 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "query",
-			pos:  position{line: 14, col: 1, offset: 118},
+			name: "library",
+			pos:  position{line: 6, col: 1, offset: 139},
+			expr: &actionExpr{
+				pos: position{line: 6, col: 11, offset: 149},
+				run: (*parser).callonlibrary1,
+				expr: &seqExpr{
+					pos: position{line: 6, col: 11, offset: 149},
+					exprs: []interface{}{
+						&zeroOrOneExpr{
+							pos: position{line: 6, col: 11, offset: 149},
+							expr: &ruleRefExpr{
+								pos:  position{line: 6, col: 11, offset: 149},
+								name: "defSpace",
+							},
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 6, col: 21, offset: 159},
+							expr: &seqExpr{
+								pos: position{line: 6, col: 22, offset: 160},
+								exprs: []interface{}{
+									&ruleRefExpr{
+										pos:  position{line: 6, col: 22, offset: 160},
+										name: "macroDef",
+									},
+									&zeroOrOneExpr{
+										pos: position{line: 6, col: 31, offset: 169},
+										expr: &ruleRefExpr{
+											pos:  position{line: 6, col: 31, offset: 169},
+											name: "defSpace",
+										},
+									},
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 6, col: 43, offset: 181},
+							name: "EOF",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "macroDef",
+			pos:  position{line: 7, col: 1, offset: 205},
+			expr: &actionExpr{
+				pos: position{line: 8, col: 5, offset: 218},
+				run: (*parser).callonmacroDef1,
+				expr: &seqExpr{
+					pos: position{line: 8, col: 5, offset: 218},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 8, col: 5, offset: 218},
+							val:        "def",
+							ignoreCase: true,
+						},
+						&ruleRefExpr{
+							pos:  position{line: 8, col: 12, offset: 225},
+							name: "_",
+						},
+						&labeledExpr{
+							pos:   position{line: 8, col: 14, offset: 227},
+							label: "name",
+							expr: &ruleRefExpr{
+								pos:  position{line: 8, col: 19, offset: 232},
+								name: "identifier",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 8, col: 30, offset: 243},
+							name: "__",
+						},
+						&litMatcher{
+							pos:        position{line: 8, col: 33, offset: 246},
+							val:        "=",
+							ignoreCase: false,
+						},
+						&ruleRefExpr{
+							pos:  position{line: 8, col: 37, offset: 250},
+							name: "__",
+						},
+						&labeledExpr{
+							pos:   position{line: 8, col: 40, offset: 253},
+							label: "body",
+							expr: &ruleRefExpr{
+								pos:  position{line: 8, col: 45, offset: 258},
+								name: "macroBody",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 8, col: 55, offset: 268},
+							name: "__",
+						},
+						&litMatcher{
+							pos:        position{line: 8, col: 58, offset: 271},
+							val:        ";",
+							ignoreCase: false,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "macroBody",
+			pos:  position{line: 11, col: 1, offset: 340},
 			expr: &choiceExpr{
-				pos: position{line: 15, col: 5, offset: 128},
+				pos: position{line: 12, col: 5, offset: 354},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 15, col: 5, offset: 128},
-						run: (*parser).callonquery2,
+						pos: position{line: 12, col: 5, offset: 354},
+						run: (*parser).callonmacroBody2,
 						expr: &labeledExpr{
-							pos:   position{line: 15, col: 5, offset: 128},
+							pos:   position{line: 12, col: 5, offset: 354},
 							label: "procs",
 							expr: &ruleRefExpr{
-								pos:  position{line: 15, col: 11, offset: 134},
+								pos:  position{line: 12, col: 11, offset: 360},
 								name: "procChain",
 							},
 						},
 					},
+					&ruleRefExpr{
+						pos:  position{line: 13, col: 5, offset: 416},
+						name: "query",
+					},
+				},
+			},
+		},
+		{
+			name: "identifier",
+			pos:  position{line: 14, col: 1, offset: 422},
+			expr: &actionExpr{
+				pos: position{line: 14, col: 14, offset: 435},
+				run: (*parser).callonidentifier1,
+				expr: &seqExpr{
+					pos: position{line: 14, col: 14, offset: 435},
+					exprs: []interface{}{
+						&charClassMatcher{
+							pos:        position{line: 14, col: 14, offset: 435},
+							val:        "[A-Za-z_]",
+							chars:      []rune{'_'},
+							ranges:     []rune{'A', 'Z', 'a', 'z'},
+							ignoreCase: false,
+							inverted:   false,
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 14, col: 24, offset: 445},
+							expr: &charClassMatcher{
+								pos:        position{line: 14, col: 24, offset: 445},
+								val:        "[A-Za-z0-9_]",
+								chars:      []rune{'_'},
+								ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
+								ignoreCase: false,
+								inverted:   false,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "macroRef",
+			pos:  position{line: 15, col: 1, offset: 490},
+			expr: &actionExpr{
+				pos: position{line: 16, col: 5, offset: 503},
+				run: (*parser).callonmacroRef1,
+				expr: &seqExpr{
+					pos: position{line: 16, col: 5, offset: 503},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 16, col: 5, offset: 503},
+							label: "name",
+							expr: &ruleRefExpr{
+								pos:  position{line: 16, col: 10, offset: 508},
+								name: "identifier",
+							},
+						},
+						&andCodeExpr{
+							pos: position{line: 16, col: 21, offset: 519},
+							run: (*parser).callonmacroRef5,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "query",
+			pos:  position{line: 19, col: 1, offset: 619},
+			expr: &choiceExpr{
+				pos: position{line: 20, col: 5, offset: 629},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 20, col: 5, offset: 629},
+						run: (*parser).callonquery2,
+						expr: &seqExpr{
+							pos: position{line: 20, col: 5, offset: 629},
+							exprs: []interface{}{
+								&notExpr{
+									pos: position{line: 20, col: 5, offset: 629},
+									expr: &ruleRefExpr{
+										pos:  position{line: 20, col: 6, offset: 630},
+										name: "pass",
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 20, col: 11, offset: 635},
+									label: "procs",
+									expr: &ruleRefExpr{
+										pos:  position{line: 20, col: 17, offset: 641},
+										name: "procChain",
+									},
+								},
+							},
+						},
+					},
 					&actionExpr{
-						pos: position{line: 19, col: 5, offset: 297},
-						run: (*parser).callonquery5,
+						pos: position{line: 24, col: 5, offset: 802},
+						run: (*parser).callonquery8,
 						expr: &seqExpr{
-							pos: position{line: 19, col: 5, offset: 297},
+							pos: position{line: 24, col: 5, offset: 802},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 19, col: 5, offset: 297},
+									pos:   position{line: 24, col: 5, offset: 802},
 									label: "s",
 									expr: &ruleRefExpr{
-										pos:  position{line: 19, col: 7, offset: 299},
+										pos:  position{line: 24, col: 7, offset: 804},
 										name: "search",
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 19, col: 14, offset: 306},
+									pos: position{line: 24, col: 14, offset: 811},
 									expr: &ruleRefExpr{
-										pos:  position{line: 19, col: 14, offset: 306},
+										pos:  position{line: 24, col: 14, offset: 811},
 										name: "_",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 19, col: 17, offset: 309},
+									pos:   position{line: 24, col: 17, offset: 814},
 									label: "rest",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 19, col: 22, offset: 314},
+										pos: position{line: 24, col: 22, offset: 819},
 										expr: &ruleRefExpr{
-											pos:  position{line: 19, col: 22, offset: 314},
+											pos:  position{line: 24, col: 22, offset: 819},
 											name: "chainedProc",
 										},
 									},
@@ -114,13 +330,13 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 26, col: 5, offset: 524},
-						run: (*parser).callonquery14,
+						pos: position{line: 31, col: 5, offset: 1028},
+						run: (*parser).callonquery17,
 						expr: &labeledExpr{
-							pos:   position{line: 26, col: 5, offset: 524},
+							pos:   position{line: 31, col: 5, offset: 1028},
 							label: "s",
 							expr: &ruleRefExpr{
-								pos:  position{line: 26, col: 7, offset: 526},
+								pos:  position{line: 31, col: 7, offset: 1030},
 								name: "search",
 							},
 						},
@@ -130,28 +346,28 @@ var g = &grammar{
 		},
 		{
 			name: "procChain",
-			pos:  position{line: 30, col: 1, offset: 597},
+			pos:  position{line: 34, col: 1, offset: 1100},
 			expr: &actionExpr{
-				pos: position{line: 31, col: 5, offset: 611},
+				pos: position{line: 35, col: 5, offset: 1114},
 				run: (*parser).callonprocChain1,
 				expr: &seqExpr{
-					pos: position{line: 31, col: 5, offset: 611},
+					pos: position{line: 35, col: 5, offset: 1114},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 31, col: 5, offset: 611},
+							pos:   position{line: 35, col: 5, offset: 1114},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 31, col: 11, offset: 617},
+								pos:  position{line: 35, col: 11, offset: 1120},
 								name: "proc",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 31, col: 16, offset: 622},
+							pos:   position{line: 35, col: 16, offset: 1125},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 31, col: 21, offset: 627},
+								pos: position{line: 35, col: 21, offset: 1130},
 								expr: &ruleRefExpr{
-									pos:  position{line: 31, col: 21, offset: 627},
+									pos:  position{line: 35, col: 21, offset: 1130},
 									name: "chainedProc",
 								},
 							},
@@ -162,37 +378,37 @@ var g = &grammar{
 		},
 		{
 			name: "chainedProc",
-			pos:  position{line: 39, col: 1, offset: 813},
+			pos:  position{line: 42, col: 1, offset: 1314},
 			expr: &actionExpr{
-				pos: position{line: 39, col: 15, offset: 827},
+				pos: position{line: 42, col: 15, offset: 1328},
 				run: (*parser).callonchainedProc1,
 				expr: &seqExpr{
-					pos: position{line: 39, col: 15, offset: 827},
+					pos: position{line: 42, col: 15, offset: 1328},
 					exprs: []interface{}{
 						&zeroOrOneExpr{
-							pos: position{line: 39, col: 15, offset: 827},
+							pos: position{line: 42, col: 15, offset: 1328},
 							expr: &ruleRefExpr{
-								pos:  position{line: 39, col: 15, offset: 827},
+								pos:  position{line: 42, col: 15, offset: 1328},
 								name: "_",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 39, col: 18, offset: 830},
+							pos:        position{line: 42, col: 18, offset: 1331},
 							val:        "|",
 							ignoreCase: false,
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 39, col: 22, offset: 834},
+							pos: position{line: 42, col: 22, offset: 1335},
 							expr: &ruleRefExpr{
-								pos:  position{line: 39, col: 22, offset: 834},
+								pos:  position{line: 42, col: 22, offset: 1335},
 								name: "_",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 39, col: 25, offset: 837},
+							pos:   position{line: 42, col: 25, offset: 1338},
 							label: "p",
 							expr: &ruleRefExpr{
-								pos:  position{line: 39, col: 27, offset: 839},
+								pos:  position{line: 42, col: 27, offset: 1340},
 								name: "proc",
 							},
 						},
@@ -202,15 +418,15 @@ var g = &grammar{
 		},
 		{
 			name: "search",
-			pos:  position{line: 41, col: 1, offset: 863},
+			pos:  position{line: 43, col: 1, offset: 1363},
 			expr: &actionExpr{
-				pos: position{line: 42, col: 5, offset: 874},
+				pos: position{line: 44, col: 5, offset: 1374},
 				run: (*parser).callonsearch1,
 				expr: &labeledExpr{
-					pos:   position{line: 42, col: 5, offset: 874},
+					pos:   position{line: 44, col: 5, offset: 1374},
 					label: "expr",
 					expr: &ruleRefExpr{
-						pos:  position{line: 42, col: 10, offset: 879},
+						pos:  position{line: 44, col: 10, offset: 1379},
 						name: "searchExpr",
 					},
 				},
@@ -218,28 +434,28 @@ var g = &grammar{
 		},
 		{
 			name: "searchExpr",
-			pos:  position{line: 46, col: 1, offset: 938},
+			pos:  position{line: 47, col: 1, offset: 1437},
 			expr: &actionExpr{
-				pos: position{line: 47, col: 5, offset: 953},
+				pos: position{line: 48, col: 5, offset: 1452},
 				run: (*parser).callonsearchExpr1,
 				expr: &seqExpr{
-					pos: position{line: 47, col: 5, offset: 953},
+					pos: position{line: 48, col: 5, offset: 1452},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 47, col: 5, offset: 953},
+							pos:   position{line: 48, col: 5, offset: 1452},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 47, col: 11, offset: 959},
+								pos:  position{line: 48, col: 11, offset: 1458},
 								name: "searchTerm",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 47, col: 22, offset: 970},
+							pos:   position{line: 48, col: 22, offset: 1469},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 47, col: 27, offset: 975},
+								pos: position{line: 48, col: 27, offset: 1474},
 								expr: &ruleRefExpr{
-									pos:  position{line: 47, col: 27, offset: 975},
+									pos:  position{line: 48, col: 27, offset: 1474},
 									name: "oredSearchTerm",
 								},
 							},
@@ -250,30 +466,30 @@ var g = &grammar{
 		},
 		{
 			name: "oredSearchTerm",
-			pos:  position{line: 51, col: 1, offset: 1043},
+			pos:  position{line: 51, col: 1, offset: 1541},
 			expr: &actionExpr{
-				pos: position{line: 51, col: 18, offset: 1060},
+				pos: position{line: 51, col: 18, offset: 1558},
 				run: (*parser).callonoredSearchTerm1,
 				expr: &seqExpr{
-					pos: position{line: 51, col: 18, offset: 1060},
+					pos: position{line: 51, col: 18, offset: 1558},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 51, col: 18, offset: 1060},
+							pos:  position{line: 51, col: 18, offset: 1558},
 							name: "_",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 51, col: 20, offset: 1062},
+							pos:  position{line: 51, col: 20, offset: 1560},
 							name: "orToken",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 51, col: 28, offset: 1070},
+							pos:  position{line: 51, col: 28, offset: 1568},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 51, col: 30, offset: 1072},
+							pos:   position{line: 51, col: 30, offset: 1570},
 							label: "t",
 							expr: &ruleRefExpr{
-								pos:  position{line: 51, col: 32, offset: 1074},
+								pos:  position{line: 51, col: 32, offset: 1572},
 								name: "searchTerm",
 							},
 						},
@@ -283,28 +499,28 @@ var g = &grammar{
 		},
 		{
 			name: "searchTerm",
-			pos:  position{line: 53, col: 1, offset: 1104},
+			pos:  position{line: 52, col: 1, offset: 1601},
 			expr: &actionExpr{
-				pos: position{line: 54, col: 5, offset: 1119},
+				pos: position{line: 53, col: 5, offset: 1616},
 				run: (*parser).callonsearchTerm1,
 				expr: &seqExpr{
-					pos: position{line: 54, col: 5, offset: 1119},
+					pos: position{line: 53, col: 5, offset: 1616},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 54, col: 5, offset: 1119},
+							pos:   position{line: 53, col: 5, offset: 1616},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 54, col: 11, offset: 1125},
+								pos:  position{line: 53, col: 11, offset: 1622},
 								name: "searchFactor",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 54, col: 24, offset: 1138},
+							pos:   position{line: 53, col: 24, offset: 1635},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 54, col: 29, offset: 1143},
+								pos: position{line: 53, col: 29, offset: 1640},
 								expr: &ruleRefExpr{
-									pos:  position{line: 54, col: 29, offset: 1143},
+									pos:  position{line: 53, col: 29, offset: 1640},
 									name: "andedSearchTerm",
 								},
 							},
@@ -315,38 +531,38 @@ var g = &grammar{
 		},
 		{
 			name: "andedSearchTerm",
-			pos:  position{line: 58, col: 1, offset: 1213},
+			pos:  position{line: 56, col: 1, offset: 1709},
 			expr: &actionExpr{
-				pos: position{line: 58, col: 19, offset: 1231},
+				pos: position{line: 56, col: 19, offset: 1727},
 				run: (*parser).callonandedSearchTerm1,
 				expr: &seqExpr{
-					pos: position{line: 58, col: 19, offset: 1231},
+					pos: position{line: 56, col: 19, offset: 1727},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 58, col: 19, offset: 1231},
+							pos:  position{line: 56, col: 19, offset: 1727},
 							name: "_",
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 58, col: 21, offset: 1233},
+							pos: position{line: 56, col: 21, offset: 1729},
 							expr: &seqExpr{
-								pos: position{line: 58, col: 22, offset: 1234},
+								pos: position{line: 56, col: 22, offset: 1730},
 								exprs: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 58, col: 22, offset: 1234},
+										pos:  position{line: 56, col: 22, offset: 1730},
 										name: "andToken",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 58, col: 31, offset: 1243},
+										pos:  position{line: 56, col: 31, offset: 1739},
 										name: "_",
 									},
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 58, col: 35, offset: 1247},
+							pos:   position{line: 56, col: 35, offset: 1743},
 							label: "f",
 							expr: &ruleRefExpr{
-								pos:  position{line: 58, col: 37, offset: 1249},
+								pos:  position{line: 56, col: 37, offset: 1745},
 								name: "searchFactor",
 							},
 						},
@@ -356,44 +572,44 @@ var g = &grammar{
 		},
 		{
 			name: "searchFactor",
-			pos:  position{line: 60, col: 1, offset: 1281},
+			pos:  position{line: 57, col: 1, offset: 1776},
 			expr: &choiceExpr{
-				pos: position{line: 61, col: 5, offset: 1298},
+				pos: position{line: 58, col: 5, offset: 1793},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 61, col: 5, offset: 1298},
+						pos: position{line: 58, col: 5, offset: 1793},
 						run: (*parser).callonsearchFactor2,
 						expr: &seqExpr{
-							pos: position{line: 61, col: 5, offset: 1298},
+							pos: position{line: 58, col: 5, offset: 1793},
 							exprs: []interface{}{
 								&choiceExpr{
-									pos: position{line: 61, col: 6, offset: 1299},
+									pos: position{line: 58, col: 6, offset: 1794},
 									alternatives: []interface{}{
 										&seqExpr{
-											pos: position{line: 61, col: 6, offset: 1299},
+											pos: position{line: 58, col: 6, offset: 1794},
 											exprs: []interface{}{
 												&ruleRefExpr{
-													pos:  position{line: 61, col: 6, offset: 1299},
+													pos:  position{line: 58, col: 6, offset: 1794},
 													name: "notToken",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 61, col: 15, offset: 1308},
+													pos:  position{line: 58, col: 15, offset: 1803},
 													name: "_",
 												},
 											},
 										},
 										&seqExpr{
-											pos: position{line: 61, col: 19, offset: 1312},
+											pos: position{line: 58, col: 19, offset: 1807},
 											exprs: []interface{}{
 												&litMatcher{
-													pos:        position{line: 61, col: 19, offset: 1312},
+													pos:        position{line: 58, col: 19, offset: 1807},
 													val:        "!",
 													ignoreCase: false,
 												},
 												&zeroOrOneExpr{
-													pos: position{line: 61, col: 23, offset: 1316},
+													pos: position{line: 58, col: 23, offset: 1811},
 													expr: &ruleRefExpr{
-														pos:  position{line: 61, col: 23, offset: 1316},
+														pos:  position{line: 58, col: 23, offset: 1811},
 														name: "_",
 													},
 												},
@@ -402,10 +618,10 @@ var g = &grammar{
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 61, col: 27, offset: 1320},
+									pos:   position{line: 58, col: 27, offset: 1815},
 									label: "e",
 									expr: &ruleRefExpr{
-										pos:  position{line: 61, col: 29, offset: 1322},
+										pos:  position{line: 58, col: 29, offset: 1817},
 										name: "searchExpr",
 									},
 								},
@@ -413,24 +629,24 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 64, col: 5, offset: 1381},
+						pos: position{line: 61, col: 5, offset: 1876},
 						run: (*parser).callonsearchFactor14,
 						expr: &seqExpr{
-							pos: position{line: 64, col: 5, offset: 1381},
+							pos: position{line: 61, col: 5, offset: 1876},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 64, col: 5, offset: 1381},
+									pos: position{line: 61, col: 5, offset: 1876},
 									expr: &litMatcher{
-										pos:        position{line: 64, col: 7, offset: 1383},
+										pos:        position{line: 61, col: 7, offset: 1878},
 										val:        "-",
 										ignoreCase: false,
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 64, col: 12, offset: 1388},
+									pos:   position{line: 61, col: 12, offset: 1883},
 									label: "s",
 									expr: &ruleRefExpr{
-										pos:  position{line: 64, col: 14, offset: 1390},
+										pos:  position{line: 61, col: 14, offset: 1885},
 										name: "searchPred",
 									},
 								},
@@ -438,40 +654,40 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 65, col: 5, offset: 1423},
+						pos: position{line: 62, col: 5, offset: 1918},
 						run: (*parser).callonsearchFactor20,
 						expr: &seqExpr{
-							pos: position{line: 65, col: 5, offset: 1423},
+							pos: position{line: 62, col: 5, offset: 1918},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 65, col: 5, offset: 1423},
+									pos:        position{line: 62, col: 5, offset: 1918},
 									val:        "(",
 									ignoreCase: false,
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 65, col: 9, offset: 1427},
+									pos: position{line: 62, col: 9, offset: 1922},
 									expr: &ruleRefExpr{
-										pos:  position{line: 65, col: 9, offset: 1427},
+										pos:  position{line: 62, col: 9, offset: 1922},
 										name: "_",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 65, col: 12, offset: 1430},
+									pos:   position{line: 62, col: 12, offset: 1925},
 									label: "expr",
 									expr: &ruleRefExpr{
-										pos:  position{line: 65, col: 17, offset: 1435},
+										pos:  position{line: 62, col: 17, offset: 1930},
 										name: "searchExpr",
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 65, col: 28, offset: 1446},
+									pos: position{line: 62, col: 28, offset: 1941},
 									expr: &ruleRefExpr{
-										pos:  position{line: 65, col: 28, offset: 1446},
+										pos:  position{line: 62, col: 28, offset: 1941},
 										name: "_",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 65, col: 31, offset: 1449},
+									pos:        position{line: 62, col: 31, offset: 1944},
 									val:        ")",
 									ignoreCase: false,
 								},
@@ -483,48 +699,48 @@ var g = &grammar{
 		},
 		{
 			name: "searchPred",
-			pos:  position{line: 67, col: 1, offset: 1475},
+			pos:  position{line: 63, col: 1, offset: 1969},
 			expr: &choiceExpr{
-				pos: position{line: 68, col: 5, offset: 1490},
+				pos: position{line: 64, col: 5, offset: 1984},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 68, col: 5, offset: 1490},
+						pos: position{line: 64, col: 5, offset: 1984},
 						run: (*parser).callonsearchPred2,
 						expr: &seqExpr{
-							pos: position{line: 68, col: 5, offset: 1490},
+							pos: position{line: 64, col: 5, offset: 1984},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 68, col: 5, offset: 1490},
+									pos:        position{line: 64, col: 5, offset: 1984},
 									val:        "*",
 									ignoreCase: false,
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 68, col: 9, offset: 1494},
+									pos: position{line: 64, col: 9, offset: 1988},
 									expr: &ruleRefExpr{
-										pos:  position{line: 68, col: 9, offset: 1494},
+										pos:  position{line: 64, col: 9, offset: 1988},
 										name: "_",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 68, col: 12, offset: 1497},
+									pos:   position{line: 64, col: 12, offset: 1991},
 									label: "fieldComparator",
 									expr: &ruleRefExpr{
-										pos:  position{line: 68, col: 28, offset: 1513},
+										pos:  position{line: 64, col: 28, offset: 2007},
 										name: "equalityToken",
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 68, col: 42, offset: 1527},
+									pos: position{line: 64, col: 42, offset: 2021},
 									expr: &ruleRefExpr{
-										pos:  position{line: 68, col: 42, offset: 1527},
+										pos:  position{line: 64, col: 42, offset: 2021},
 										name: "_",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 68, col: 45, offset: 1530},
+									pos:   position{line: 64, col: 45, offset: 2024},
 									label: "v",
 									expr: &ruleRefExpr{
-										pos:  position{line: 68, col: 47, offset: 1532},
+										pos:  position{line: 64, col: 47, offset: 2026},
 										name: "searchValue",
 									},
 								},
@@ -532,43 +748,43 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 71, col: 5, offset: 1616},
+						pos: position{line: 67, col: 5, offset: 2110},
 						run: (*parser).callonsearchPred13,
 						expr: &seqExpr{
-							pos: position{line: 71, col: 5, offset: 1616},
+							pos: position{line: 67, col: 5, offset: 2110},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 71, col: 5, offset: 1616},
+									pos:        position{line: 67, col: 5, offset: 2110},
 									val:        "**",
 									ignoreCase: false,
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 71, col: 10, offset: 1621},
+									pos: position{line: 67, col: 10, offset: 2115},
 									expr: &ruleRefExpr{
-										pos:  position{line: 71, col: 10, offset: 1621},
+										pos:  position{line: 67, col: 10, offset: 2115},
 										name: "_",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 71, col: 13, offset: 1624},
+									pos:   position{line: 67, col: 13, offset: 2118},
 									label: "fieldComparator",
 									expr: &ruleRefExpr{
-										pos:  position{line: 71, col: 29, offset: 1640},
+										pos:  position{line: 67, col: 29, offset: 2134},
 										name: "equalityToken",
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 71, col: 43, offset: 1654},
+									pos: position{line: 67, col: 43, offset: 2148},
 									expr: &ruleRefExpr{
-										pos:  position{line: 71, col: 43, offset: 1654},
+										pos:  position{line: 67, col: 43, offset: 2148},
 										name: "_",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 71, col: 46, offset: 1657},
+									pos:   position{line: 67, col: 46, offset: 2151},
 									label: "v",
 									expr: &ruleRefExpr{
-										pos:  position{line: 71, col: 48, offset: 1659},
+										pos:  position{line: 67, col: 48, offset: 2153},
 										name: "searchValue",
 									},
 								},
@@ -576,46 +792,46 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 74, col: 5, offset: 1742},
+						pos: position{line: 70, col: 5, offset: 2236},
 						run: (*parser).callonsearchPred24,
 						expr: &seqExpr{
-							pos: position{line: 74, col: 5, offset: 1742},
+							pos: position{line: 70, col: 5, offset: 2236},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 74, col: 5, offset: 1742},
+									pos:   position{line: 70, col: 5, offset: 2236},
 									label: "f",
 									expr: &ruleRefExpr{
-										pos:  position{line: 74, col: 7, offset: 1744},
+										pos:  position{line: 70, col: 7, offset: 2238},
 										name: "fieldExpr",
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 74, col: 17, offset: 1754},
+									pos: position{line: 70, col: 17, offset: 2248},
 									expr: &ruleRefExpr{
-										pos:  position{line: 74, col: 17, offset: 1754},
+										pos:  position{line: 70, col: 17, offset: 2248},
 										name: "_",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 74, col: 20, offset: 1757},
+									pos:   position{line: 70, col: 20, offset: 2251},
 									label: "fieldComparator",
 									expr: &ruleRefExpr{
-										pos:  position{line: 74, col: 36, offset: 1773},
+										pos:  position{line: 70, col: 36, offset: 2267},
 										name: "equalityToken",
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 74, col: 50, offset: 1787},
+									pos: position{line: 70, col: 50, offset: 2281},
 									expr: &ruleRefExpr{
-										pos:  position{line: 74, col: 50, offset: 1787},
+										pos:  position{line: 70, col: 50, offset: 2281},
 										name: "_",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 74, col: 53, offset: 1790},
+									pos:   position{line: 70, col: 53, offset: 2284},
 									label: "v",
 									expr: &ruleRefExpr{
-										pos:  position{line: 74, col: 55, offset: 1792},
+										pos:  position{line: 70, col: 55, offset: 2286},
 										name: "searchValue",
 									},
 								},
@@ -623,39 +839,39 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 77, col: 5, offset: 1874},
+						pos: position{line: 73, col: 5, offset: 2368},
 						run: (*parser).callonsearchPred36,
 						expr: &seqExpr{
-							pos: position{line: 77, col: 5, offset: 1874},
+							pos: position{line: 73, col: 5, offset: 2368},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 77, col: 5, offset: 1874},
+									pos:   position{line: 73, col: 5, offset: 2368},
 									label: "v",
 									expr: &ruleRefExpr{
-										pos:  position{line: 77, col: 7, offset: 1876},
+										pos:  position{line: 73, col: 7, offset: 2370},
 										name: "searchValue",
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 77, col: 19, offset: 1888},
+									pos: position{line: 73, col: 19, offset: 2382},
 									expr: &ruleRefExpr{
-										pos:  position{line: 77, col: 19, offset: 1888},
+										pos:  position{line: 73, col: 19, offset: 2382},
 										name: "_",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 77, col: 22, offset: 1891},
+									pos:  position{line: 73, col: 22, offset: 2385},
 									name: "inToken",
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 77, col: 30, offset: 1899},
+									pos: position{line: 73, col: 30, offset: 2393},
 									expr: &ruleRefExpr{
-										pos:  position{line: 77, col: 30, offset: 1899},
+										pos:  position{line: 73, col: 30, offset: 2393},
 										name: "_",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 77, col: 33, offset: 1902},
+									pos:        position{line: 73, col: 33, offset: 2396},
 									val:        "*",
 									ignoreCase: false,
 								},
@@ -663,42 +879,42 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 80, col: 5, offset: 1967},
+						pos: position{line: 76, col: 5, offset: 2461},
 						run: (*parser).callonsearchPred46,
 						expr: &seqExpr{
-							pos: position{line: 80, col: 5, offset: 1967},
+							pos: position{line: 76, col: 5, offset: 2461},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 80, col: 5, offset: 1967},
+									pos:   position{line: 76, col: 5, offset: 2461},
 									label: "v",
 									expr: &ruleRefExpr{
-										pos:  position{line: 80, col: 7, offset: 1969},
+										pos:  position{line: 76, col: 7, offset: 2463},
 										name: "searchValue",
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 80, col: 19, offset: 1981},
+									pos: position{line: 76, col: 19, offset: 2475},
 									expr: &ruleRefExpr{
-										pos:  position{line: 80, col: 19, offset: 1981},
+										pos:  position{line: 76, col: 19, offset: 2475},
 										name: "_",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 80, col: 22, offset: 1984},
+									pos:  position{line: 76, col: 22, offset: 2478},
 									name: "inToken",
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 80, col: 30, offset: 1992},
+									pos: position{line: 76, col: 30, offset: 2486},
 									expr: &ruleRefExpr{
-										pos:  position{line: 80, col: 30, offset: 1992},
+										pos:  position{line: 76, col: 30, offset: 2486},
 										name: "_",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 80, col: 33, offset: 1995},
+									pos:   position{line: 76, col: 33, offset: 2489},
 									label: "f",
 									expr: &ruleRefExpr{
-										pos:  position{line: 80, col: 35, offset: 1997},
+										pos:  position{line: 76, col: 35, offset: 2491},
 										name: "fieldReference",
 									},
 								},
@@ -706,13 +922,25 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 83, col: 5, offset: 2071},
+						pos: position{line: 79, col: 5, offset: 2565},
 						run: (*parser).callonsearchPred57,
 						expr: &labeledExpr{
-							pos:   position{line: 83, col: 5, offset: 2071},
+							pos:   position{line: 79, col: 5, offset: 2565},
+							label: "v",
+							expr: &ruleRefExpr{
+								pos:  position{line: 79, col: 7, offset: 2567},
+								name: "ParamLiteral",
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 85, col: 5, offset: 3012},
+						run: (*parser).callonsearchPred60,
+						expr: &labeledExpr{
+							pos:   position{line: 85, col: 5, offset: 3012},
 							label: "v",
 							expr: &ruleRefExpr{
-								pos:  position{line: 83, col: 7, offset: 2073},
+								pos:  position{line: 85, col: 7, offset: 3014},
 								name: "searchValue",
 							},
 						},
@@ -722,52 +950,56 @@ var g = &grammar{
 		},
 		{
 			name: "searchValue",
-			pos:  position{line: 97, col: 1, offset: 2752},
+			pos:  position{line: 97, col: 1, offset: 3691},
 			expr: &choiceExpr{
-				pos: position{line: 98, col: 5, offset: 2768},
+				pos: position{line: 98, col: 5, offset: 3707},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 98, col: 5, offset: 2768},
+						pos:  position{line: 98, col: 5, offset: 3707},
+						name: "ParamLiteral",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 99, col: 5, offset: 3724},
 						name: "StringLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 99, col: 5, offset: 2786},
+						pos:  position{line: 100, col: 5, offset: 3742},
 						name: "RegexpLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 100, col: 5, offset: 2804},
+						pos:  position{line: 101, col: 5, offset: 3760},
 						name: "PortLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 101, col: 5, offset: 2820},
+						pos:  position{line: 102, col: 5, offset: 3776},
 						name: "SubnetLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 102, col: 5, offset: 2838},
+						pos:  position{line: 103, col: 5, offset: 3794},
 						name: "AddressLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 103, col: 5, offset: 2857},
+						pos:  position{line: 104, col: 5, offset: 3813},
 						name: "FloatLiteral",
 					},
 					&actionExpr{
-						pos: position{line: 107, col: 5, offset: 3024},
-						run: (*parser).callonsearchValue8,
+						pos: position{line: 105, col: 5, offset: 3830},
+						run: (*parser).callonsearchValue9,
 						expr: &seqExpr{
-							pos: position{line: 107, col: 5, offset: 3024},
+							pos: position{line: 105, col: 5, offset: 3830},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 107, col: 5, offset: 3024},
+									pos:   position{line: 105, col: 5, offset: 3830},
 									label: "i",
 									expr: &ruleRefExpr{
-										pos:  position{line: 107, col: 7, offset: 3026},
+										pos:  position{line: 105, col: 7, offset: 3832},
 										name: "IntegerLiteral",
 									},
 								},
 								&notExpr{
-									pos: position{line: 107, col: 22, offset: 3041},
+									pos: position{line: 105, col: 22, offset: 3847},
 									expr: &ruleRefExpr{
-										pos:  position{line: 107, col: 23, offset: 3042},
+										pos:  position{line: 105, col: 23, offset: 3848},
 										name: "searchWord",
 									},
 								},
@@ -775,32 +1007,32 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 109, col: 5, offset: 3076},
-						run: (*parser).callonsearchValue14,
+						pos: position{line: 106, col: 5, offset: 3881},
+						run: (*parser).callonsearchValue15,
 						expr: &seqExpr{
-							pos: position{line: 109, col: 5, offset: 3076},
+							pos: position{line: 106, col: 5, offset: 3881},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 109, col: 5, offset: 3076},
+									pos: position{line: 106, col: 5, offset: 3881},
 									expr: &seqExpr{
-										pos: position{line: 109, col: 7, offset: 3078},
+										pos: position{line: 106, col: 7, offset: 3883},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 109, col: 7, offset: 3078},
+												pos:  position{line: 106, col: 7, offset: 3883},
 												name: "searchKeywords",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 109, col: 22, offset: 3093},
+												pos:  position{line: 106, col: 22, offset: 3898},
 												name: "_",
 											},
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 109, col: 25, offset: 3096},
+									pos:   position{line: 106, col: 25, offset: 3901},
 									label: "v",
 									expr: &ruleRefExpr{
-										pos:  position{line: 109, col: 27, offset: 3098},
+										pos:  position{line: 106, col: 27, offset: 3903},
 										name: "BooleanLiteral",
 									},
 								},
@@ -808,32 +1040,32 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 110, col: 5, offset: 3135},
-						run: (*parser).callonsearchValue22,
+						pos: position{line: 107, col: 5, offset: 3940},
+						run: (*parser).callonsearchValue23,
 						expr: &seqExpr{
-							pos: position{line: 110, col: 5, offset: 3135},
+							pos: position{line: 107, col: 5, offset: 3940},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 110, col: 5, offset: 3135},
+									pos: position{line: 107, col: 5, offset: 3940},
 									expr: &seqExpr{
-										pos: position{line: 110, col: 7, offset: 3137},
+										pos: position{line: 107, col: 7, offset: 3942},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 110, col: 7, offset: 3137},
+												pos:  position{line: 107, col: 7, offset: 3942},
 												name: "searchKeywords",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 110, col: 22, offset: 3152},
+												pos:  position{line: 107, col: 22, offset: 3957},
 												name: "_",
 											},
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 110, col: 25, offset: 3155},
+									pos:   position{line: 107, col: 25, offset: 3960},
 									label: "v",
 									expr: &ruleRefExpr{
-										pos:  position{line: 110, col: 27, offset: 3157},
+										pos:  position{line: 107, col: 27, offset: 3962},
 										name: "NullLiteral",
 									},
 								},
@@ -841,32 +1073,32 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 111, col: 5, offset: 3191},
-						run: (*parser).callonsearchValue30,
+						pos: position{line: 108, col: 5, offset: 3996},
+						run: (*parser).callonsearchValue31,
 						expr: &seqExpr{
-							pos: position{line: 111, col: 5, offset: 3191},
+							pos: position{line: 108, col: 5, offset: 3996},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 111, col: 5, offset: 3191},
+									pos: position{line: 108, col: 5, offset: 3996},
 									expr: &seqExpr{
-										pos: position{line: 111, col: 7, offset: 3193},
+										pos: position{line: 108, col: 7, offset: 3998},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 111, col: 8, offset: 3194},
+												pos:  position{line: 108, col: 8, offset: 3999},
 												name: "searchKeywords",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 111, col: 24, offset: 3210},
+												pos:  position{line: 108, col: 24, offset: 4015},
 												name: "_",
 											},
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 111, col: 27, offset: 3213},
+									pos:   position{line: 108, col: 27, offset: 4018},
 									label: "v",
 									expr: &ruleRefExpr{
-										pos:  position{line: 111, col: 29, offset: 3215},
+										pos:  position{line: 108, col: 29, offset: 4020},
 										name: "searchWord",
 									},
 								},
@@ -876,17 +1108,78 @@ var g = &grammar{
 				},
 			},
 		},
+		{
+			name: "ParamLiteral",
+			pos:  position{line: 115, col: 1, offset: 4217},
+			expr: &choiceExpr{
+				pos: position{line: 116, col: 5, offset: 4234},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 116, col: 5, offset: 4234},
+						run: (*parser).callonParamLiteral2,
+						expr: &seqExpr{
+							pos: position{line: 116, col: 5, offset: 4234},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 116, col: 5, offset: 4234},
+									val:        "$",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 116, col: 9, offset: 4238},
+									label: "name",
+									expr: &ruleRefExpr{
+										pos:  position{line: 116, col: 14, offset: 4243},
+										name: "identifier",
+									},
+								},
+								&andCodeExpr{
+									pos: position{line: 116, col: 25, offset: 4254},
+									run: (*parser).callonParamLiteral7,
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 119, col: 5, offset: 4361},
+						run: (*parser).callonParamLiteral8,
+						expr: &seqExpr{
+							pos: position{line: 119, col: 5, offset: 4361},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 119, col: 5, offset: 4361},
+									val:        "$",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 119, col: 9, offset: 4365},
+									label: "name",
+									expr: &ruleRefExpr{
+										pos:  position{line: 119, col: 14, offset: 4370},
+										name: "identifier",
+									},
+								},
+								&andCodeExpr{
+									pos: position{line: 119, col: 25, offset: 4381},
+									run: (*parser).callonParamLiteral13,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "StringLiteral",
-			pos:  position{line: 119, col: 1, offset: 3414},
+			pos:  position{line: 122, col: 1, offset: 4478},
 			expr: &actionExpr{
-				pos: position{line: 120, col: 5, offset: 3432},
+				pos: position{line: 123, col: 5, offset: 4496},
 				run: (*parser).callonStringLiteral1,
 				expr: &labeledExpr{
-					pos:   position{line: 120, col: 5, offset: 3432},
+					pos:   position{line: 123, col: 5, offset: 4496},
 					label: "v",
 					expr: &ruleRefExpr{
-						pos:  position{line: 120, col: 7, offset: 3434},
+						pos:  position{line: 123, col: 7, offset: 4498},
 						name: "quotedString",
 					},
 				},
@@ -894,15 +1187,15 @@ var g = &grammar{
 		},
 		{
 			name: "RegexpLiteral",
-			pos:  position{line: 124, col: 1, offset: 3499},
+			pos:  position{line: 126, col: 1, offset: 4562},
 			expr: &actionExpr{
-				pos: position{line: 125, col: 5, offset: 3517},
+				pos: position{line: 127, col: 5, offset: 4580},
 				run: (*parser).callonRegexpLiteral1,
 				expr: &labeledExpr{
-					pos:   position{line: 125, col: 5, offset: 3517},
+					pos:   position{line: 127, col: 5, offset: 4580},
 					label: "v",
 					expr: &ruleRefExpr{
-						pos:  position{line: 125, col: 7, offset: 3519},
+						pos:  position{line: 127, col: 7, offset: 4582},
 						name: "reString",
 					},
 				},
@@ -910,15 +1203,15 @@ var g = &grammar{
 		},
 		{
 			name: "PortLiteral",
-			pos:  position{line: 129, col: 1, offset: 3580},
+			pos:  position{line: 130, col: 1, offset: 4642},
 			expr: &actionExpr{
-				pos: position{line: 130, col: 5, offset: 3596},
+				pos: position{line: 131, col: 5, offset: 4658},
 				run: (*parser).callonPortLiteral1,
 				expr: &labeledExpr{
-					pos:   position{line: 130, col: 5, offset: 3596},
+					pos:   position{line: 131, col: 5, offset: 4658},
 					label: "v",
 					expr: &ruleRefExpr{
-						pos:  position{line: 130, col: 7, offset: 3598},
+						pos:  position{line: 131, col: 7, offset: 4660},
 						name: "port",
 					},
 				},
@@ -926,30 +1219,30 @@ var g = &grammar{
 		},
 		{
 			name: "SubnetLiteral",
-			pos:  position{line: 134, col: 1, offset: 3653},
+			pos:  position{line: 134, col: 1, offset: 4714},
 			expr: &choiceExpr{
-				pos: position{line: 135, col: 5, offset: 3671},
+				pos: position{line: 135, col: 5, offset: 4732},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 135, col: 5, offset: 3671},
+						pos: position{line: 135, col: 5, offset: 4732},
 						run: (*parser).callonSubnetLiteral2,
 						expr: &labeledExpr{
-							pos:   position{line: 135, col: 5, offset: 3671},
+							pos:   position{line: 135, col: 5, offset: 4732},
 							label: "v",
 							expr: &ruleRefExpr{
-								pos:  position{line: 135, col: 7, offset: 3673},
+								pos:  position{line: 135, col: 7, offset: 4734},
 								name: "ip6subnet",
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 138, col: 5, offset: 3735},
+						pos: position{line: 138, col: 5, offset: 4796},
 						run: (*parser).callonSubnetLiteral5,
 						expr: &labeledExpr{
-							pos:   position{line: 138, col: 5, offset: 3735},
+							pos:   position{line: 138, col: 5, offset: 4796},
 							label: "v",
 							expr: &ruleRefExpr{
-								pos:  position{line: 138, col: 7, offset: 3737},
+								pos:  position{line: 138, col: 7, offset: 4798},
 								name: "subnet",
 							},
 						},
@@ -959,30 +1252,30 @@ var g = &grammar{
 		},
 		{
 			name: "AddressLiteral",
-			pos:  position{line: 142, col: 1, offset: 3793},
+			pos:  position{line: 141, col: 1, offset: 4853},
 			expr: &choiceExpr{
-				pos: position{line: 143, col: 5, offset: 3812},
+				pos: position{line: 142, col: 5, offset: 4872},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 143, col: 5, offset: 3812},
+						pos: position{line: 142, col: 5, offset: 4872},
 						run: (*parser).callonAddressLiteral2,
 						expr: &labeledExpr{
-							pos:   position{line: 143, col: 5, offset: 3812},
+							pos:   position{line: 142, col: 5, offset: 4872},
 							label: "v",
 							expr: &ruleRefExpr{
-								pos:  position{line: 143, col: 7, offset: 3814},
+								pos:  position{line: 142, col: 7, offset: 4874},
 								name: "ip6addr",
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 146, col: 5, offset: 3873},
+						pos: position{line: 145, col: 5, offset: 4933},
 						run: (*parser).callonAddressLiteral5,
 						expr: &labeledExpr{
-							pos:   position{line: 146, col: 5, offset: 3873},
+							pos:   position{line: 145, col: 5, offset: 4933},
 							label: "v",
 							expr: &ruleRefExpr{
-								pos:  position{line: 146, col: 7, offset: 3875},
+								pos:  position{line: 145, col: 7, offset: 4935},
 								name: "addr",
 							},
 						},
@@ -992,15 +1285,15 @@ var g = &grammar{
 		},
 		{
 			name: "FloatLiteral",
-			pos:  position{line: 150, col: 1, offset: 3928},
+			pos:  position{line: 148, col: 1, offset: 4987},
 			expr: &actionExpr{
-				pos: position{line: 151, col: 5, offset: 3945},
+				pos: position{line: 149, col: 5, offset: 5004},
 				run: (*parser).callonFloatLiteral1,
 				expr: &labeledExpr{
-					pos:   position{line: 151, col: 5, offset: 3945},
+					pos:   position{line: 149, col: 5, offset: 5004},
 					label: "v",
 					expr: &ruleRefExpr{
-						pos:  position{line: 151, col: 7, offset: 3947},
+						pos:  position{line: 149, col: 7, offset: 5006},
 						name: "sdouble",
 					},
 				},
@@ -1008,15 +1301,15 @@ var g = &grammar{
 		},
 		{
 			name: "IntegerLiteral",
-			pos:  position{line: 155, col: 1, offset: 4008},
+			pos:  position{line: 152, col: 1, offset: 5066},
 			expr: &actionExpr{
-				pos: position{line: 156, col: 5, offset: 4027},
+				pos: position{line: 153, col: 5, offset: 5085},
 				run: (*parser).callonIntegerLiteral1,
 				expr: &labeledExpr{
-					pos:   position{line: 156, col: 5, offset: 4027},
+					pos:   position{line: 153, col: 5, offset: 5085},
 					label: "v",
 					expr: &ruleRefExpr{
-						pos:  position{line: 156, col: 7, offset: 4029},
+						pos:  position{line: 153, col: 7, offset: 5087},
 						name: "sinteger",
 					},
 				},
@@ -1024,24 +1317,24 @@ var g = &grammar{
 		},
 		{
 			name: "BooleanLiteral",
-			pos:  position{line: 160, col: 1, offset: 4089},
+			pos:  position{line: 156, col: 1, offset: 5146},
 			expr: &choiceExpr{
-				pos: position{line: 161, col: 5, offset: 4108},
+				pos: position{line: 157, col: 5, offset: 5165},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 161, col: 5, offset: 4108},
+						pos: position{line: 157, col: 5, offset: 5165},
 						run: (*parser).callonBooleanLiteral2,
 						expr: &litMatcher{
-							pos:        position{line: 161, col: 5, offset: 4108},
+							pos:        position{line: 157, col: 5, offset: 5165},
 							val:        "true",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 162, col: 5, offset: 4173},
+						pos: position{line: 158, col: 5, offset: 5220},
 						run: (*parser).callonBooleanLiteral4,
 						expr: &litMatcher{
-							pos:        position{line: 162, col: 5, offset: 4173},
+							pos:        position{line: 158, col: 5, offset: 5220},
 							val:        "false",
 							ignoreCase: false,
 						},
@@ -1051,12 +1344,12 @@ var g = &grammar{
 		},
 		{
 			name: "NullLiteral",
-			pos:  position{line: 164, col: 1, offset: 4236},
+			pos:  position{line: 159, col: 1, offset: 5273},
 			expr: &actionExpr{
-				pos: position{line: 165, col: 5, offset: 4252},
+				pos: position{line: 160, col: 5, offset: 5289},
 				run: (*parser).callonNullLiteral1,
 				expr: &litMatcher{
-					pos:        position{line: 165, col: 5, offset: 4252},
+					pos:        position{line: 160, col: 5, offset: 5289},
 					val:        "null",
 					ignoreCase: false,
 				},
@@ -1064,20 +1357,20 @@ var g = &grammar{
 		},
 		{
 			name: "searchKeywords",
-			pos:  position{line: 167, col: 1, offset: 4310},
+			pos:  position{line: 161, col: 1, offset: 5336},
 			expr: &choiceExpr{
-				pos: position{line: 168, col: 5, offset: 4329},
+				pos: position{line: 162, col: 5, offset: 5355},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 168, col: 5, offset: 4329},
+						pos:  position{line: 162, col: 5, offset: 5355},
 						name: "andToken",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 169, col: 5, offset: 4342},
+						pos:  position{line: 163, col: 5, offset: 5368},
 						name: "orToken",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 170, col: 5, offset: 4354},
+						pos:  position{line: 164, col: 5, offset: 5380},
 						name: "inToken",
 					},
 				},
@@ -1085,28 +1378,28 @@ var g = &grammar{
 		},
 		{
 			name: "procList",
-			pos:  position{line: 172, col: 1, offset: 4363},
+			pos:  position{line: 165, col: 1, offset: 5388},
 			expr: &actionExpr{
-				pos: position{line: 173, col: 5, offset: 4376},
+				pos: position{line: 166, col: 5, offset: 5401},
 				run: (*parser).callonprocList1,
 				expr: &seqExpr{
-					pos: position{line: 173, col: 5, offset: 4376},
+					pos: position{line: 166, col: 5, offset: 5401},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 173, col: 5, offset: 4376},
+							pos:   position{line: 166, col: 5, offset: 5401},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 173, col: 11, offset: 4382},
+								pos:  position{line: 166, col: 11, offset: 5407},
 								name: "procChain",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 173, col: 21, offset: 4392},
+							pos:   position{line: 166, col: 21, offset: 5417},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 173, col: 26, offset: 4397},
+								pos: position{line: 166, col: 26, offset: 5422},
 								expr: &ruleRefExpr{
-									pos:  position{line: 173, col: 26, offset: 4397},
+									pos:  position{line: 166, col: 26, offset: 5422},
 									name: "parallelChain",
 								},
 							},
@@ -1117,37 +1410,37 @@ var g = &grammar{
 		},
 		{
 			name: "parallelChain",
-			pos:  position{line: 182, col: 1, offset: 4621},
+			pos:  position{line: 174, col: 1, offset: 5643},
 			expr: &actionExpr{
-				pos: position{line: 183, col: 5, offset: 4639},
+				pos: position{line: 175, col: 5, offset: 5661},
 				run: (*parser).callonparallelChain1,
 				expr: &seqExpr{
-					pos: position{line: 183, col: 5, offset: 4639},
+					pos: position{line: 175, col: 5, offset: 5661},
 					exprs: []interface{}{
 						&zeroOrOneExpr{
-							pos: position{line: 183, col: 5, offset: 4639},
+							pos: position{line: 175, col: 5, offset: 5661},
 							expr: &ruleRefExpr{
-								pos:  position{line: 183, col: 5, offset: 4639},
+								pos:  position{line: 175, col: 5, offset: 5661},
 								name: "_",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 183, col: 8, offset: 4642},
+							pos:        position{line: 175, col: 8, offset: 5664},
 							val:        ";",
 							ignoreCase: false,
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 183, col: 12, offset: 4646},
+							pos: position{line: 175, col: 12, offset: 5668},
 							expr: &ruleRefExpr{
-								pos:  position{line: 183, col: 12, offset: 4646},
+								pos:  position{line: 175, col: 12, offset: 5668},
 								name: "_",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 183, col: 15, offset: 4649},
+							pos:   position{line: 175, col: 15, offset: 5671},
 							label: "ch",
 							expr: &ruleRefExpr{
-								pos:  position{line: 183, col: 18, offset: 4652},
+								pos:  position{line: 175, col: 18, offset: 5674},
 								name: "procChain",
 							},
 						},
@@ -1157,53 +1450,61 @@ var g = &grammar{
 		},
 		{
 			name: "proc",
-			pos:  position{line: 185, col: 1, offset: 4702},
+			pos:  position{line: 176, col: 1, offset: 5723},
 			expr: &choiceExpr{
-				pos: position{line: 186, col: 5, offset: 4711},
+				pos: position{line: 177, col: 5, offset: 5732},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 186, col: 5, offset: 4711},
+						pos:  position{line: 177, col: 5, offset: 5732},
+						name: "macroRef",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 178, col: 5, offset: 5745},
 						name: "simpleProc",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 187, col: 5, offset: 4726},
+						pos:  position{line: 179, col: 5, offset: 5760},
 						name: "reducerProc",
 					},
+					&ruleRefExpr{
+						pos:  position{line: 180, col: 5, offset: 5776},
+						name: "switchProc",
+					},
 					&actionExpr{
-						pos: position{line: 188, col: 5, offset: 4742},
-						run: (*parser).callonproc4,
+						pos: position{line: 181, col: 5, offset: 5791},
+						run: (*parser).callonproc6,
 						expr: &seqExpr{
-							pos: position{line: 188, col: 5, offset: 4742},
+							pos: position{line: 181, col: 5, offset: 5791},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 188, col: 5, offset: 4742},
+									pos:        position{line: 181, col: 5, offset: 5791},
 									val:        "(",
 									ignoreCase: false,
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 188, col: 9, offset: 4746},
+									pos: position{line: 181, col: 9, offset: 5795},
 									expr: &ruleRefExpr{
-										pos:  position{line: 188, col: 9, offset: 4746},
+										pos:  position{line: 181, col: 9, offset: 5795},
 										name: "_",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 188, col: 12, offset: 4749},
+									pos:   position{line: 181, col: 12, offset: 5798},
 									label: "proc",
 									expr: &ruleRefExpr{
-										pos:  position{line: 188, col: 17, offset: 4754},
+										pos:  position{line: 181, col: 17, offset: 5803},
 										name: "procList",
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 188, col: 26, offset: 4763},
+									pos: position{line: 181, col: 26, offset: 5812},
 									expr: &ruleRefExpr{
-										pos:  position{line: 188, col: 26, offset: 4763},
+										pos:  position{line: 181, col: 26, offset: 5812},
 										name: "_",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 188, col: 29, offset: 4766},
+									pos:        position{line: 181, col: 29, offset: 5815},
 									val:        ")",
 									ignoreCase: false,
 								},
@@ -1214,121 +1515,534 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "groupBy",
-			pos:  position{line: 192, col: 1, offset: 4802},
+			name: "switchProc",
+			pos:  position{line: 184, col: 1, offset: 5850},
 			expr: &actionExpr{
-				pos: position{line: 193, col: 5, offset: 4814},
-				run: (*parser).callongroupBy1,
+				pos: position{line: 185, col: 5, offset: 5865},
+				run: (*parser).callonswitchProc1,
 				expr: &seqExpr{
-					pos: position{line: 193, col: 5, offset: 4814},
+					pos: position{line: 185, col: 5, offset: 5865},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 193, col: 5, offset: 4814},
-							val:        "by",
+							pos:        position{line: 185, col: 5, offset: 5865},
+							val:        "switch",
 							ignoreCase: true,
 						},
-						&ruleRefExpr{
-							pos:  position{line: 193, col: 11, offset: 4820},
-							name: "_",
-						},
-						&labeledExpr{
-							pos:   position{line: 193, col: 13, offset: 4822},
-							label: "list",
+						&zeroOrOneExpr{
+							pos: position{line: 185, col: 15, offset: 5875},
 							expr: &ruleRefExpr{
-								pos:  position{line: 193, col: 18, offset: 4827},
-								name: "fieldExprList",
+								pos:  position{line: 185, col: 15, offset: 5875},
+								name: "_",
 							},
 						},
-					},
-				},
-			},
-		},
-		{
-			name: "everyDur",
-			pos:  position{line: 195, col: 1, offset: 4863},
-			expr: &actionExpr{
-				pos: position{line: 196, col: 5, offset: 4876},
-				run: (*parser).calloneveryDur1,
-				expr: &seqExpr{
-					pos: position{line: 196, col: 5, offset: 4876},
-					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 196, col: 5, offset: 4876},
-							val:        "every",
-							ignoreCase: true,
+							pos:        position{line: 185, col: 18, offset: 5878},
+							val:        "(",
+							ignoreCase: false,
 						},
-						&ruleRefExpr{
-							pos:  position{line: 196, col: 14, offset: 4885},
-							name: "_",
+						&zeroOrOneExpr{
+							pos: position{line: 185, col: 22, offset: 5882},
+							expr: &ruleRefExpr{
+								pos:  position{line: 185, col: 22, offset: 5882},
+								name: "_",
+							},
 						},
 						&labeledExpr{
-							pos:   position{line: 196, col: 16, offset: 4887},
-							label: "dur",
+							pos:   position{line: 185, col: 25, offset: 5885},
+							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 196, col: 20, offset: 4891},
-								name: "duration",
+								pos:  position{line: 185, col: 31, offset: 5891},
+								name: "switchCase",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 185, col: 42, offset: 5902},
+							label: "rest",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 185, col: 47, offset: 5907},
+								expr: &actionExpr{
+									pos: position{line: 185, col: 48, offset: 5908},
+									run: (*parser).callonswitchProc13,
+									expr: &seqExpr{
+										pos: position{line: 185, col: 48, offset: 5908},
+										exprs: []interface{}{
+											&zeroOrOneExpr{
+												pos: position{line: 185, col: 48, offset: 5908},
+												expr: &ruleRefExpr{
+													pos:  position{line: 185, col: 48, offset: 5908},
+													name: "_",
+												},
+											},
+											&litMatcher{
+												pos:        position{line: 185, col: 51, offset: 5911},
+												val:        ";",
+												ignoreCase: false,
+											},
+											&zeroOrOneExpr{
+												pos: position{line: 185, col: 55, offset: 5915},
+												expr: &ruleRefExpr{
+													pos:  position{line: 185, col: 55, offset: 5915},
+													name: "_",
+												},
+											},
+											&labeledExpr{
+												pos:   position{line: 185, col: 58, offset: 5918},
+												label: "ch",
+												expr: &ruleRefExpr{
+													pos:  position{line: 185, col: 61, offset: 5921},
+													name: "switchCase",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 185, col: 93, offset: 5953},
+							expr: &ruleRefExpr{
+								pos:  position{line: 185, col: 93, offset: 5953},
+								name: "_",
 							},
 						},
+						&litMatcher{
+							pos:        position{line: 185, col: 96, offset: 5956},
+							val:        ")",
+							ignoreCase: false,
+						},
 					},
 				},
 			},
 		},
 		{
-			name: "equalityToken",
-			pos:  position{line: 198, col: 1, offset: 4921},
+			name: "switchCase",
+			pos:  position{line: 188, col: 1, offset: 6058},
 			expr: &choiceExpr{
-				pos: position{line: 199, col: 5, offset: 4939},
+				pos: position{line: 189, col: 5, offset: 6073},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 199, col: 5, offset: 4939},
-						run: (*parser).callonequalityToken2,
-						expr: &litMatcher{
-							pos:        position{line: 199, col: 5, offset: 4939},
-							val:        "=",
-							ignoreCase: false,
-						},
-					},
-					&actionExpr{
-						pos: position{line: 200, col: 5, offset: 4969},
-						run: (*parser).callonequalityToken4,
+						pos: position{line: 189, col: 5, offset: 6073},
+						run: (*parser).callonswitchCase2,
+						expr: &seqExpr{
+							pos: position{line: 189, col: 5, offset: 6073},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 189, col: 5, offset: 6073},
+									val:        "default",
+									ignoreCase: true,
+								},
+								&zeroOrOneExpr{
+									pos: position{line: 189, col: 16, offset: 6084},
+									expr: &ruleRefExpr{
+										pos:  position{line: 189, col: 16, offset: 6084},
+										name: "_",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 189, col: 19, offset: 6087},
+									val:        "=>",
+									ignoreCase: false,
+								},
+								&zeroOrOneExpr{
+									pos: position{line: 189, col: 24, offset: 6092},
+									expr: &ruleRefExpr{
+										pos:  position{line: 189, col: 24, offset: 6092},
+										name: "_",
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 189, col: 27, offset: 6095},
+									label: "procs",
+									expr: &ruleRefExpr{
+										pos:  position{line: 189, col: 33, offset: 6101},
+										name: "procChain",
+									},
+								},
+								&andExpr{
+									pos: position{line: 189, col: 43, offset: 6111},
+									expr: &seqExpr{
+										pos: position{line: 189, col: 45, offset: 6113},
+										exprs: []interface{}{
+											&zeroOrOneExpr{
+												pos: position{line: 189, col: 45, offset: 6113},
+												expr: &ruleRefExpr{
+													pos:  position{line: 189, col: 45, offset: 6113},
+													name: "_",
+												},
+											},
+											&litMatcher{
+												pos:        position{line: 189, col: 48, offset: 6116},
+												val:        ")",
+												ignoreCase: false,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 192, col: 5, offset: 6209},
+						run: (*parser).callonswitchCase17,
+						expr: &seqExpr{
+							pos: position{line: 192, col: 5, offset: 6209},
+							exprs: []interface{}{
+								&notExpr{
+									pos: position{line: 192, col: 5, offset: 6209},
+									expr: &seqExpr{
+										pos: position{line: 192, col: 7, offset: 6211},
+										exprs: []interface{}{
+											&litMatcher{
+												pos:        position{line: 192, col: 7, offset: 6211},
+												val:        "default",
+												ignoreCase: true,
+											},
+											&zeroOrOneExpr{
+												pos: position{line: 192, col: 18, offset: 6222},
+												expr: &ruleRefExpr{
+													pos:  position{line: 192, col: 18, offset: 6222},
+													name: "_",
+												},
+											},
+											&litMatcher{
+												pos:        position{line: 192, col: 21, offset: 6225},
+												val:        "=>",
+												ignoreCase: false,
+											},
+										},
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 192, col: 27, offset: 6231},
+									label: "filter",
+									expr: &ruleRefExpr{
+										pos:  position{line: 192, col: 34, offset: 6238},
+										name: "searchExpr",
+									},
+								},
+								&zeroOrOneExpr{
+									pos: position{line: 192, col: 45, offset: 6249},
+									expr: &ruleRefExpr{
+										pos:  position{line: 192, col: 45, offset: 6249},
+										name: "_",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 192, col: 48, offset: 6252},
+									val:        "=>",
+									ignoreCase: false,
+								},
+								&zeroOrOneExpr{
+									pos: position{line: 192, col: 53, offset: 6257},
+									expr: &ruleRefExpr{
+										pos:  position{line: 192, col: 53, offset: 6257},
+										name: "_",
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 192, col: 56, offset: 6260},
+									label: "procs",
+									expr: &ruleRefExpr{
+										pos:  position{line: 192, col: 62, offset: 6266},
+										name: "procChain",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "groupBy",
+			pos:  position{line: 195, col: 1, offset: 6352},
+			expr: &actionExpr{
+				pos: position{line: 196, col: 5, offset: 6364},
+				run: (*parser).callongroupBy1,
+				expr: &seqExpr{
+					pos: position{line: 196, col: 5, offset: 6364},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 196, col: 5, offset: 6364},
+							val:        "by",
+							ignoreCase: true,
+						},
+						&ruleRefExpr{
+							pos:  position{line: 196, col: 11, offset: 6370},
+							name: "_",
+						},
+						&labeledExpr{
+							pos:   position{line: 196, col: 13, offset: 6372},
+							label: "list",
+							expr: &ruleRefExpr{
+								pos:  position{line: 196, col: 18, offset: 6377},
+								name: "fieldExprList",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "everyDur",
+			pos:  position{line: 197, col: 1, offset: 6412},
+			expr: &choiceExpr{
+				pos: position{line: 198, col: 5, offset: 6425},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 198, col: 5, offset: 6425},
+						run: (*parser).calloneveryDur2,
+						expr: &seqExpr{
+							pos: position{line: 198, col: 5, offset: 6425},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 198, col: 5, offset: 6425},
+									val:        "every",
+									ignoreCase: true,
+								},
+								&ruleRefExpr{
+									pos:  position{line: 198, col: 14, offset: 6434},
+									name: "_",
+								},
+								&labeledExpr{
+									pos:   position{line: 198, col: 16, offset: 6436},
+									label: "dur",
+									expr: &ruleRefExpr{
+										pos:  position{line: 198, col: 20, offset: 6440},
+										name: "duration",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 198, col: 29, offset: 6449},
+									name: "_",
+								},
+								&litMatcher{
+									pos:        position{line: 198, col: 31, offset: 6451},
+									val:        "slide",
+									ignoreCase: true,
+								},
+								&ruleRefExpr{
+									pos:  position{line: 198, col: 40, offset: 6460},
+									name: "_",
+								},
+								&labeledExpr{
+									pos:   position{line: 198, col: 42, offset: 6462},
+									label: "slide",
+									expr: &ruleRefExpr{
+										pos:  position{line: 198, col: 48, offset: 6468},
+										name: "duration",
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 201, col: 5, offset: 6548},
+						run: (*parser).calloneveryDur13,
+						expr: &seqExpr{
+							pos: position{line: 201, col: 5, offset: 6548},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 201, col: 5, offset: 6548},
+									val:        "every",
+									ignoreCase: true,
+								},
+								&ruleRefExpr{
+									pos:  position{line: 201, col: 14, offset: 6557},
+									name: "_",
+								},
+								&labeledExpr{
+									pos:   position{line: 201, col: 16, offset: 6559},
+									label: "interval",
+									expr: &ruleRefExpr{
+										pos:  position{line: 201, col: 25, offset: 6568},
+										name: "calendarInterval",
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 201, col: 42, offset: 6585},
+									label: "tz",
+									expr: &zeroOrOneExpr{
+										pos: position{line: 201, col: 45, offset: 6588},
+										expr: &actionExpr{
+											pos: position{line: 201, col: 46, offset: 6589},
+											run: (*parser).calloneveryDur21,
+											expr: &seqExpr{
+												pos: position{line: 201, col: 46, offset: 6589},
+												exprs: []interface{}{
+													&ruleRefExpr{
+														pos:  position{line: 201, col: 46, offset: 6589},
+														name: "_",
+													},
+													&labeledExpr{
+														pos:   position{line: 201, col: 48, offset: 6591},
+														label: "z",
+														expr: &ruleRefExpr{
+															pos:  position{line: 201, col: 50, offset: 6593},
+															name: "timeZone",
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 204, col: 5, offset: 6701},
+						run: (*parser).calloneveryDur26,
+						expr: &seqExpr{
+							pos: position{line: 204, col: 5, offset: 6701},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 204, col: 5, offset: 6701},
+									val:        "every",
+									ignoreCase: true,
+								},
+								&ruleRefExpr{
+									pos:  position{line: 204, col: 14, offset: 6710},
+									name: "_",
+								},
+								&labeledExpr{
+									pos:   position{line: 204, col: 16, offset: 6712},
+									label: "interval",
+									expr: &ruleRefExpr{
+										pos:  position{line: 204, col: 25, offset: 6721},
+										name: "dayInterval",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 204, col: 37, offset: 6733},
+									name: "_",
+								},
+								&labeledExpr{
+									pos:   position{line: 204, col: 39, offset: 6735},
+									label: "tz",
+									expr: &ruleRefExpr{
+										pos:  position{line: 204, col: 42, offset: 6738},
+										name: "timeZone",
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 207, col: 5, offset: 6826},
+						run: (*parser).calloneveryDur35,
+						expr: &seqExpr{
+							pos: position{line: 207, col: 5, offset: 6826},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 207, col: 5, offset: 6826},
+									val:        "every",
+									ignoreCase: true,
+								},
+								&ruleRefExpr{
+									pos:  position{line: 207, col: 14, offset: 6835},
+									name: "_",
+								},
+								&labeledExpr{
+									pos:   position{line: 207, col: 16, offset: 6837},
+									label: "dur",
+									expr: &ruleRefExpr{
+										pos:  position{line: 207, col: 20, offset: 6841},
+										name: "duration",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "timeZone",
+			pos:  position{line: 208, col: 1, offset: 6886},
+			expr: &actionExpr{
+				pos: position{line: 209, col: 5, offset: 6899},
+				run: (*parser).callontimeZone1,
+				expr: &seqExpr{
+					pos: position{line: 209, col: 5, offset: 6899},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 209, col: 5, offset: 6899},
+							val:        "tz",
+							ignoreCase: true,
+						},
+						&ruleRefExpr{
+							pos:  position{line: 209, col: 11, offset: 6905},
+							name: "_",
+						},
+						&labeledExpr{
+							pos:   position{line: 209, col: 13, offset: 6907},
+							label: "zone",
+							expr: &ruleRefExpr{
+								pos:  position{line: 209, col: 18, offset: 6912},
+								name: "quotedString",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "equalityToken",
+			pos:  position{line: 210, col: 1, offset: 6946},
+			expr: &choiceExpr{
+				pos: position{line: 211, col: 5, offset: 6964},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 211, col: 5, offset: 6964},
+						run: (*parser).callonequalityToken2,
+						expr: &litMatcher{
+							pos:        position{line: 211, col: 5, offset: 6964},
+							val:        "=",
+							ignoreCase: false,
+						},
+					},
+					&actionExpr{
+						pos: position{line: 212, col: 5, offset: 6994},
+						run: (*parser).callonequalityToken4,
 						expr: &litMatcher{
-							pos:        position{line: 200, col: 5, offset: 4969},
+							pos:        position{line: 212, col: 5, offset: 6994},
 							val:        "!=",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 201, col: 5, offset: 5001},
+						pos: position{line: 213, col: 5, offset: 7026},
 						run: (*parser).callonequalityToken6,
 						expr: &litMatcher{
-							pos:        position{line: 201, col: 5, offset: 5001},
+							pos:        position{line: 213, col: 5, offset: 7026},
 							val:        "<=",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 202, col: 5, offset: 5032},
+						pos: position{line: 214, col: 5, offset: 7057},
 						run: (*parser).callonequalityToken8,
 						expr: &litMatcher{
-							pos:        position{line: 202, col: 5, offset: 5032},
+							pos:        position{line: 214, col: 5, offset: 7057},
 							val:        ">=",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 203, col: 5, offset: 5063},
+						pos: position{line: 215, col: 5, offset: 7088},
 						run: (*parser).callonequalityToken10,
 						expr: &litMatcher{
-							pos:        position{line: 203, col: 5, offset: 5063},
+							pos:        position{line: 215, col: 5, offset: 7088},
 							val:        "<",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 204, col: 5, offset: 5092},
+						pos: position{line: 216, col: 5, offset: 7117},
 						run: (*parser).callonequalityToken12,
 						expr: &litMatcher{
-							pos:        position{line: 204, col: 5, offset: 5092},
+							pos:        position{line: 216, col: 5, offset: 7117},
 							val:        ">",
 							ignoreCase: false,
 						},
@@ -1338,12 +2052,12 @@ var g = &grammar{
 		},
 		{
 			name: "andToken",
-			pos:  position{line: 206, col: 1, offset: 5118},
+			pos:  position{line: 217, col: 1, offset: 7142},
 			expr: &actionExpr{
-				pos: position{line: 206, col: 12, offset: 5129},
+				pos: position{line: 217, col: 12, offset: 7153},
 				run: (*parser).callonandToken1,
 				expr: &litMatcher{
-					pos:        position{line: 206, col: 12, offset: 5129},
+					pos:        position{line: 217, col: 12, offset: 7153},
 					val:        "and",
 					ignoreCase: true,
 				},
@@ -1351,12 +2065,12 @@ var g = &grammar{
 		},
 		{
 			name: "orToken",
-			pos:  position{line: 207, col: 1, offset: 5167},
+			pos:  position{line: 218, col: 1, offset: 7191},
 			expr: &actionExpr{
-				pos: position{line: 207, col: 11, offset: 5177},
+				pos: position{line: 218, col: 11, offset: 7201},
 				run: (*parser).callonorToken1,
 				expr: &litMatcher{
-					pos:        position{line: 207, col: 11, offset: 5177},
+					pos:        position{line: 218, col: 11, offset: 7201},
 					val:        "or",
 					ignoreCase: true,
 				},
@@ -1364,12 +2078,12 @@ var g = &grammar{
 		},
 		{
 			name: "inToken",
-			pos:  position{line: 208, col: 1, offset: 5214},
+			pos:  position{line: 219, col: 1, offset: 7238},
 			expr: &actionExpr{
-				pos: position{line: 208, col: 11, offset: 5224},
+				pos: position{line: 219, col: 11, offset: 7248},
 				run: (*parser).calloninToken1,
 				expr: &litMatcher{
-					pos:        position{line: 208, col: 11, offset: 5224},
+					pos:        position{line: 219, col: 11, offset: 7248},
 					val:        "in",
 					ignoreCase: true,
 				},
@@ -1377,12 +2091,12 @@ var g = &grammar{
 		},
 		{
 			name: "notToken",
-			pos:  position{line: 209, col: 1, offset: 5261},
+			pos:  position{line: 220, col: 1, offset: 7285},
 			expr: &actionExpr{
-				pos: position{line: 209, col: 12, offset: 5272},
+				pos: position{line: 220, col: 12, offset: 7296},
 				run: (*parser).callonnotToken1,
 				expr: &litMatcher{
-					pos:        position{line: 209, col: 12, offset: 5272},
+					pos:        position{line: 220, col: 12, offset: 7296},
 					val:        "not",
 					ignoreCase: true,
 				},
@@ -1390,21 +2104,21 @@ var g = &grammar{
 		},
 		{
 			name: "fieldName",
-			pos:  position{line: 211, col: 1, offset: 5311},
+			pos:  position{line: 221, col: 1, offset: 7334},
 			expr: &actionExpr{
-				pos: position{line: 211, col: 13, offset: 5323},
+				pos: position{line: 221, col: 13, offset: 7346},
 				run: (*parser).callonfieldName1,
 				expr: &seqExpr{
-					pos: position{line: 211, col: 13, offset: 5323},
+					pos: position{line: 221, col: 13, offset: 7346},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 211, col: 13, offset: 5323},
+							pos:  position{line: 221, col: 13, offset: 7346},
 							name: "fieldNameStart",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 211, col: 28, offset: 5338},
+							pos: position{line: 221, col: 28, offset: 7361},
 							expr: &ruleRefExpr{
-								pos:  position{line: 211, col: 28, offset: 5338},
+								pos:  position{line: 221, col: 28, offset: 7361},
 								name: "fieldNameRest",
 							},
 						},
@@ -1414,9 +2128,9 @@ var g = &grammar{
 		},
 		{
 			name: "fieldNameStart",
-			pos:  position{line: 213, col: 1, offset: 5385},
+			pos:  position{line: 222, col: 1, offset: 7407},
 			expr: &charClassMatcher{
-				pos:        position{line: 213, col: 18, offset: 5402},
+				pos:        position{line: 222, col: 18, offset: 7424},
 				val:        "[A-Za-z_$]",
 				chars:      []rune{'_', '$'},
 				ranges:     []rune{'A', 'Z', 'a', 'z'},
@@ -1426,16 +2140,16 @@ var g = &grammar{
 		},
 		{
 			name: "fieldNameRest",
-			pos:  position{line: 214, col: 1, offset: 5413},
+			pos:  position{line: 223, col: 1, offset: 7435},
 			expr: &choiceExpr{
-				pos: position{line: 214, col: 17, offset: 5429},
+				pos: position{line: 223, col: 17, offset: 7451},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 214, col: 17, offset: 5429},
+						pos:  position{line: 223, col: 17, offset: 7451},
 						name: "fieldNameStart",
 					},
 					&charClassMatcher{
-						pos:        position{line: 214, col: 34, offset: 5446},
+						pos:        position{line: 223, col: 34, offset: 7468},
 						val:        "[0-9]",
 						ranges:     []rune{'0', '9'},
 						ignoreCase: false,
@@ -1446,45 +2160,45 @@ var g = &grammar{
 		},
 		{
 			name: "fieldReference",
-			pos:  position{line: 216, col: 1, offset: 5453},
+			pos:  position{line: 224, col: 1, offset: 7474},
 			expr: &actionExpr{
-				pos: position{line: 217, col: 4, offset: 5471},
+				pos: position{line: 225, col: 4, offset: 7492},
 				run: (*parser).callonfieldReference1,
 				expr: &seqExpr{
-					pos: position{line: 217, col: 4, offset: 5471},
+					pos: position{line: 225, col: 4, offset: 7492},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 217, col: 4, offset: 5471},
+							pos:   position{line: 225, col: 4, offset: 7492},
 							label: "base",
 							expr: &ruleRefExpr{
-								pos:  position{line: 217, col: 9, offset: 5476},
+								pos:  position{line: 225, col: 9, offset: 7497},
 								name: "fieldName",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 217, col: 19, offset: 5486},
+							pos:   position{line: 225, col: 19, offset: 7507},
 							label: "derefs",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 217, col: 26, offset: 5493},
+								pos: position{line: 225, col: 26, offset: 7514},
 								expr: &choiceExpr{
-									pos: position{line: 218, col: 8, offset: 5502},
+									pos: position{line: 226, col: 8, offset: 7523},
 									alternatives: []interface{}{
 										&actionExpr{
-											pos: position{line: 218, col: 8, offset: 5502},
+											pos: position{line: 226, col: 8, offset: 7523},
 											run: (*parser).callonfieldReference8,
 											expr: &seqExpr{
-												pos: position{line: 218, col: 8, offset: 5502},
+												pos: position{line: 226, col: 8, offset: 7523},
 												exprs: []interface{}{
 													&litMatcher{
-														pos:        position{line: 218, col: 8, offset: 5502},
+														pos:        position{line: 226, col: 8, offset: 7523},
 														val:        ".",
 														ignoreCase: false,
 													},
 													&labeledExpr{
-														pos:   position{line: 218, col: 12, offset: 5506},
+														pos:   position{line: 226, col: 12, offset: 7527},
 														label: "field",
 														expr: &ruleRefExpr{
-															pos:  position{line: 218, col: 18, offset: 5512},
+															pos:  position{line: 226, col: 18, offset: 7533},
 															name: "fieldName",
 														},
 													},
@@ -1492,26 +2206,26 @@ var g = &grammar{
 											},
 										},
 										&actionExpr{
-											pos: position{line: 219, col: 8, offset: 5593},
+											pos: position{line: 227, col: 8, offset: 7611},
 											run: (*parser).callonfieldReference13,
 											expr: &seqExpr{
-												pos: position{line: 219, col: 8, offset: 5593},
+												pos: position{line: 227, col: 8, offset: 7611},
 												exprs: []interface{}{
 													&litMatcher{
-														pos:        position{line: 219, col: 8, offset: 5593},
+														pos:        position{line: 227, col: 8, offset: 7611},
 														val:        "[",
 														ignoreCase: false,
 													},
 													&labeledExpr{
-														pos:   position{line: 219, col: 12, offset: 5597},
+														pos:   position{line: 227, col: 12, offset: 7615},
 														label: "index",
 														expr: &ruleRefExpr{
-															pos:  position{line: 219, col: 18, offset: 5603},
+															pos:  position{line: 227, col: 18, offset: 7621},
 															name: "suint",
 														},
 													},
 													&litMatcher{
-														pos:        position{line: 219, col: 24, offset: 5609},
+														pos:        position{line: 227, col: 24, offset: 7627},
 														val:        "]",
 														ignoreCase: false,
 													},
@@ -1528,60 +2242,60 @@ var g = &grammar{
 		},
 		{
 			name: "fieldExpr",
-			pos:  position{line: 224, col: 1, offset: 5725},
+			pos:  position{line: 231, col: 1, offset: 7742},
 			expr: &choiceExpr{
-				pos: position{line: 225, col: 5, offset: 5739},
+				pos: position{line: 232, col: 5, offset: 7756},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 225, col: 5, offset: 5739},
+						pos: position{line: 232, col: 5, offset: 7756},
 						run: (*parser).callonfieldExpr2,
 						expr: &seqExpr{
-							pos: position{line: 225, col: 5, offset: 5739},
+							pos: position{line: 232, col: 5, offset: 7756},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 225, col: 5, offset: 5739},
+									pos:   position{line: 232, col: 5, offset: 7756},
 									label: "op",
 									expr: &ruleRefExpr{
-										pos:  position{line: 225, col: 8, offset: 5742},
+										pos:  position{line: 232, col: 8, offset: 7759},
 										name: "fieldOp",
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 225, col: 16, offset: 5750},
+									pos: position{line: 232, col: 16, offset: 7767},
 									expr: &ruleRefExpr{
-										pos:  position{line: 225, col: 16, offset: 5750},
+										pos:  position{line: 232, col: 16, offset: 7767},
 										name: "_",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 225, col: 19, offset: 5753},
+									pos:        position{line: 232, col: 19, offset: 7770},
 									val:        "(",
 									ignoreCase: false,
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 225, col: 23, offset: 5757},
+									pos: position{line: 232, col: 23, offset: 7774},
 									expr: &ruleRefExpr{
-										pos:  position{line: 225, col: 23, offset: 5757},
+										pos:  position{line: 232, col: 23, offset: 7774},
 										name: "_",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 225, col: 26, offset: 5760},
+									pos:   position{line: 232, col: 26, offset: 7777},
 									label: "field",
 									expr: &ruleRefExpr{
-										pos:  position{line: 225, col: 32, offset: 5766},
+										pos:  position{line: 232, col: 32, offset: 7783},
 										name: "fieldReference",
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 225, col: 47, offset: 5781},
+									pos: position{line: 232, col: 47, offset: 7798},
 									expr: &ruleRefExpr{
-										pos:  position{line: 225, col: 47, offset: 5781},
+										pos:  position{line: 232, col: 47, offset: 7798},
 										name: "_",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 225, col: 50, offset: 5784},
+									pos:        position{line: 232, col: 50, offset: 7801},
 									val:        ")",
 									ignoreCase: false,
 								},
@@ -1589,7 +2303,7 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 228, col: 5, offset: 5848},
+						pos:  position{line: 235, col: 5, offset: 7865},
 						name: "fieldReference",
 					},
 				},
@@ -1597,12 +2311,12 @@ var g = &grammar{
 		},
 		{
 			name: "fieldOp",
-			pos:  position{line: 230, col: 1, offset: 5864},
+			pos:  position{line: 236, col: 1, offset: 7880},
 			expr: &actionExpr{
-				pos: position{line: 231, col: 5, offset: 5876},
+				pos: position{line: 237, col: 5, offset: 7892},
 				run: (*parser).callonfieldOp1,
 				expr: &litMatcher{
-					pos:        position{line: 231, col: 5, offset: 5876},
+					pos:        position{line: 237, col: 5, offset: 7892},
 					val:        "len",
 					ignoreCase: true,
 				},
@@ -1610,50 +2324,50 @@ var g = &grammar{
 		},
 		{
 			name: "fieldExprList",
-			pos:  position{line: 233, col: 1, offset: 5906},
+			pos:  position{line: 238, col: 1, offset: 7921},
 			expr: &actionExpr{
-				pos: position{line: 234, col: 5, offset: 5924},
+				pos: position{line: 239, col: 5, offset: 7939},
 				run: (*parser).callonfieldExprList1,
 				expr: &seqExpr{
-					pos: position{line: 234, col: 5, offset: 5924},
+					pos: position{line: 239, col: 5, offset: 7939},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 234, col: 5, offset: 5924},
+							pos:   position{line: 239, col: 5, offset: 7939},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 234, col: 11, offset: 5930},
+								pos:  position{line: 239, col: 11, offset: 7945},
 								name: "fieldExpr",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 234, col: 21, offset: 5940},
+							pos:   position{line: 239, col: 21, offset: 7955},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 234, col: 26, offset: 5945},
+								pos: position{line: 239, col: 26, offset: 7960},
 								expr: &seqExpr{
-									pos: position{line: 234, col: 27, offset: 5946},
+									pos: position{line: 239, col: 27, offset: 7961},
 									exprs: []interface{}{
 										&zeroOrOneExpr{
-											pos: position{line: 234, col: 27, offset: 5946},
+											pos: position{line: 239, col: 27, offset: 7961},
 											expr: &ruleRefExpr{
-												pos:  position{line: 234, col: 27, offset: 5946},
+												pos:  position{line: 239, col: 27, offset: 7961},
 												name: "_",
 											},
 										},
 										&litMatcher{
-											pos:        position{line: 234, col: 30, offset: 5949},
+											pos:        position{line: 239, col: 30, offset: 7964},
 											val:        ",",
 											ignoreCase: false,
 										},
 										&zeroOrOneExpr{
-											pos: position{line: 234, col: 34, offset: 5953},
+											pos: position{line: 239, col: 34, offset: 7968},
 											expr: &ruleRefExpr{
-												pos:  position{line: 234, col: 34, offset: 5953},
+												pos:  position{line: 239, col: 34, offset: 7968},
 												name: "_",
 											},
 										},
 										&ruleRefExpr{
-											pos:  position{line: 234, col: 37, offset: 5956},
+											pos:  position{line: 239, col: 37, offset: 7971},
 											name: "fieldExpr",
 										},
 									},
@@ -1666,42 +2380,42 @@ var g = &grammar{
 		},
 		{
 			name: "fieldRefDotOnly",
-			pos:  position{line: 244, col: 1, offset: 6151},
+			pos:  position{line: 246, col: 1, offset: 8160},
 			expr: &actionExpr{
-				pos: position{line: 245, col: 5, offset: 6171},
+				pos: position{line: 247, col: 5, offset: 8180},
 				run: (*parser).callonfieldRefDotOnly1,
 				expr: &seqExpr{
-					pos: position{line: 245, col: 5, offset: 6171},
+					pos: position{line: 247, col: 5, offset: 8180},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 245, col: 5, offset: 6171},
+							pos:   position{line: 247, col: 5, offset: 8180},
 							label: "base",
 							expr: &ruleRefExpr{
-								pos:  position{line: 245, col: 10, offset: 6176},
+								pos:  position{line: 247, col: 10, offset: 8185},
 								name: "fieldName",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 245, col: 20, offset: 6186},
+							pos:   position{line: 247, col: 20, offset: 8195},
 							label: "refs",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 245, col: 25, offset: 6191},
+								pos: position{line: 247, col: 25, offset: 8200},
 								expr: &actionExpr{
-									pos: position{line: 245, col: 26, offset: 6192},
+									pos: position{line: 247, col: 26, offset: 8201},
 									run: (*parser).callonfieldRefDotOnly7,
 									expr: &seqExpr{
-										pos: position{line: 245, col: 26, offset: 6192},
+										pos: position{line: 247, col: 26, offset: 8201},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 245, col: 26, offset: 6192},
+												pos:        position{line: 247, col: 26, offset: 8201},
 												val:        ".",
 												ignoreCase: false,
 											},
 											&labeledExpr{
-												pos:   position{line: 245, col: 30, offset: 6196},
+												pos:   position{line: 247, col: 30, offset: 8205},
 												label: "field",
 												expr: &ruleRefExpr{
-													pos:  position{line: 245, col: 36, offset: 6202},
+													pos:  position{line: 247, col: 36, offset: 8211},
 													name: "fieldName",
 												},
 											},
@@ -1716,56 +2430,56 @@ var g = &grammar{
 		},
 		{
 			name: "fieldRefDotOnlyList",
-			pos:  position{line: 249, col: 1, offset: 6327},
+			pos:  position{line: 250, col: 1, offset: 8335},
 			expr: &actionExpr{
-				pos: position{line: 250, col: 5, offset: 6351},
+				pos: position{line: 251, col: 5, offset: 8359},
 				run: (*parser).callonfieldRefDotOnlyList1,
 				expr: &seqExpr{
-					pos: position{line: 250, col: 5, offset: 6351},
+					pos: position{line: 251, col: 5, offset: 8359},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 250, col: 5, offset: 6351},
+							pos:   position{line: 251, col: 5, offset: 8359},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 250, col: 11, offset: 6357},
+								pos:  position{line: 251, col: 11, offset: 8365},
 								name: "fieldRefDotOnly",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 250, col: 27, offset: 6373},
+							pos:   position{line: 251, col: 27, offset: 8381},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 250, col: 32, offset: 6378},
+								pos: position{line: 251, col: 32, offset: 8386},
 								expr: &actionExpr{
-									pos: position{line: 250, col: 33, offset: 6379},
+									pos: position{line: 251, col: 33, offset: 8387},
 									run: (*parser).callonfieldRefDotOnlyList7,
 									expr: &seqExpr{
-										pos: position{line: 250, col: 33, offset: 6379},
+										pos: position{line: 251, col: 33, offset: 8387},
 										exprs: []interface{}{
 											&zeroOrOneExpr{
-												pos: position{line: 250, col: 33, offset: 6379},
+												pos: position{line: 251, col: 33, offset: 8387},
 												expr: &ruleRefExpr{
-													pos:  position{line: 250, col: 33, offset: 6379},
+													pos:  position{line: 251, col: 33, offset: 8387},
 													name: "_",
 												},
 											},
 											&litMatcher{
-												pos:        position{line: 250, col: 36, offset: 6382},
+												pos:        position{line: 251, col: 36, offset: 8390},
 												val:        ",",
 												ignoreCase: false,
 											},
 											&zeroOrOneExpr{
-												pos: position{line: 250, col: 40, offset: 6386},
+												pos: position{line: 251, col: 40, offset: 8394},
 												expr: &ruleRefExpr{
-													pos:  position{line: 250, col: 40, offset: 6386},
+													pos:  position{line: 251, col: 40, offset: 8394},
 													name: "_",
 												},
 											},
 											&labeledExpr{
-												pos:   position{line: 250, col: 43, offset: 6389},
+												pos:   position{line: 251, col: 43, offset: 8397},
 												label: "ref",
 												expr: &ruleRefExpr{
-													pos:  position{line: 250, col: 47, offset: 6393},
+													pos:  position{line: 251, col: 47, offset: 8401},
 													name: "fieldRefDotOnly",
 												},
 											},
@@ -1780,50 +2494,50 @@ var g = &grammar{
 		},
 		{
 			name: "fieldNameList",
-			pos:  position{line: 258, col: 1, offset: 6573},
+			pos:  position{line: 258, col: 1, offset: 8577},
 			expr: &actionExpr{
-				pos: position{line: 259, col: 5, offset: 6591},
+				pos: position{line: 259, col: 5, offset: 8595},
 				run: (*parser).callonfieldNameList1,
 				expr: &seqExpr{
-					pos: position{line: 259, col: 5, offset: 6591},
+					pos: position{line: 259, col: 5, offset: 8595},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 259, col: 5, offset: 6591},
+							pos:   position{line: 259, col: 5, offset: 8595},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 259, col: 11, offset: 6597},
+								pos:  position{line: 259, col: 11, offset: 8601},
 								name: "fieldName",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 259, col: 21, offset: 6607},
+							pos:   position{line: 259, col: 21, offset: 8611},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 259, col: 26, offset: 6612},
+								pos: position{line: 259, col: 26, offset: 8616},
 								expr: &seqExpr{
-									pos: position{line: 259, col: 27, offset: 6613},
+									pos: position{line: 259, col: 27, offset: 8617},
 									exprs: []interface{}{
 										&zeroOrOneExpr{
-											pos: position{line: 259, col: 27, offset: 6613},
+											pos: position{line: 259, col: 27, offset: 8617},
 											expr: &ruleRefExpr{
-												pos:  position{line: 259, col: 27, offset: 6613},
+												pos:  position{line: 259, col: 27, offset: 8617},
 												name: "_",
 											},
 										},
 										&litMatcher{
-											pos:        position{line: 259, col: 30, offset: 6616},
+											pos:        position{line: 259, col: 30, offset: 8620},
 											val:        ",",
 											ignoreCase: false,
 										},
 										&zeroOrOneExpr{
-											pos: position{line: 259, col: 34, offset: 6620},
+											pos: position{line: 259, col: 34, offset: 8624},
 											expr: &ruleRefExpr{
-												pos:  position{line: 259, col: 34, offset: 6620},
+												pos:  position{line: 259, col: 34, offset: 8624},
 												name: "_",
 											},
 										},
 										&ruleRefExpr{
-											pos:  position{line: 259, col: 37, offset: 6623},
+											pos:  position{line: 259, col: 37, offset: 8627},
 											name: "fieldName",
 										},
 									},
@@ -1836,12 +2550,12 @@ var g = &grammar{
 		},
 		{
 			name: "countOp",
-			pos:  position{line: 267, col: 1, offset: 6816},
+			pos:  position{line: 266, col: 1, offset: 8816},
 			expr: &actionExpr{
-				pos: position{line: 268, col: 5, offset: 6828},
+				pos: position{line: 267, col: 5, offset: 8828},
 				run: (*parser).calloncountOp1,
 				expr: &litMatcher{
-					pos:        position{line: 268, col: 5, offset: 6828},
+					pos:        position{line: 267, col: 5, offset: 8828},
 					val:        "count",
 					ignoreCase: true,
 				},
@@ -1849,105 +2563,105 @@ var g = &grammar{
 		},
 		{
 			name: "fieldReducerOp",
-			pos:  position{line: 270, col: 1, offset: 6862},
+			pos:  position{line: 268, col: 1, offset: 8861},
 			expr: &choiceExpr{
-				pos: position{line: 271, col: 5, offset: 6881},
+				pos: position{line: 269, col: 5, offset: 8880},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 271, col: 5, offset: 6881},
+						pos: position{line: 269, col: 5, offset: 8880},
 						run: (*parser).callonfieldReducerOp2,
 						expr: &litMatcher{
-							pos:        position{line: 271, col: 5, offset: 6881},
+							pos:        position{line: 269, col: 5, offset: 8880},
 							val:        "sum",
 							ignoreCase: true,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 272, col: 5, offset: 6915},
+						pos: position{line: 270, col: 5, offset: 8913},
 						run: (*parser).callonfieldReducerOp4,
 						expr: &litMatcher{
-							pos:        position{line: 272, col: 5, offset: 6915},
+							pos:        position{line: 270, col: 5, offset: 8913},
 							val:        "avg",
 							ignoreCase: true,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 273, col: 5, offset: 6949},
+						pos: position{line: 271, col: 5, offset: 8946},
 						run: (*parser).callonfieldReducerOp6,
 						expr: &litMatcher{
-							pos:        position{line: 273, col: 5, offset: 6949},
+							pos:        position{line: 271, col: 5, offset: 8946},
 							val:        "stdev",
 							ignoreCase: true,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 274, col: 5, offset: 6986},
+						pos: position{line: 272, col: 5, offset: 8983},
 						run: (*parser).callonfieldReducerOp8,
 						expr: &litMatcher{
-							pos:        position{line: 274, col: 5, offset: 6986},
+							pos:        position{line: 272, col: 5, offset: 8983},
 							val:        "sd",
 							ignoreCase: true,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 275, col: 5, offset: 7022},
+						pos: position{line: 273, col: 5, offset: 9017},
 						run: (*parser).callonfieldReducerOp10,
 						expr: &litMatcher{
-							pos:        position{line: 275, col: 5, offset: 7022},
+							pos:        position{line: 273, col: 5, offset: 9017},
 							val:        "var",
 							ignoreCase: true,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 276, col: 5, offset: 7056},
+						pos: position{line: 274, col: 5, offset: 9050},
 						run: (*parser).callonfieldReducerOp12,
 						expr: &litMatcher{
-							pos:        position{line: 276, col: 5, offset: 7056},
+							pos:        position{line: 274, col: 5, offset: 9050},
 							val:        "entropy",
 							ignoreCase: true,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 277, col: 5, offset: 7097},
+						pos: position{line: 275, col: 5, offset: 9091},
 						run: (*parser).callonfieldReducerOp14,
 						expr: &litMatcher{
-							pos:        position{line: 277, col: 5, offset: 7097},
+							pos:        position{line: 275, col: 5, offset: 9091},
 							val:        "min",
 							ignoreCase: true,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 278, col: 5, offset: 7131},
+						pos: position{line: 276, col: 5, offset: 9124},
 						run: (*parser).callonfieldReducerOp16,
 						expr: &litMatcher{
-							pos:        position{line: 278, col: 5, offset: 7131},
+							pos:        position{line: 276, col: 5, offset: 9124},
 							val:        "max",
 							ignoreCase: true,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 279, col: 5, offset: 7165},
+						pos: position{line: 277, col: 5, offset: 9157},
 						run: (*parser).callonfieldReducerOp18,
 						expr: &litMatcher{
-							pos:        position{line: 279, col: 5, offset: 7165},
+							pos:        position{line: 277, col: 5, offset: 9157},
 							val:        "first",
 							ignoreCase: true,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 280, col: 5, offset: 7203},
+						pos: position{line: 278, col: 5, offset: 9194},
 						run: (*parser).callonfieldReducerOp20,
 						expr: &litMatcher{
-							pos:        position{line: 280, col: 5, offset: 7203},
+							pos:        position{line: 278, col: 5, offset: 9194},
 							val:        "last",
 							ignoreCase: true,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 281, col: 5, offset: 7239},
+						pos: position{line: 279, col: 5, offset: 9229},
 						run: (*parser).callonfieldReducerOp22,
 						expr: &litMatcher{
-							pos:        position{line: 281, col: 5, offset: 7239},
+							pos:        position{line: 279, col: 5, offset: 9229},
 							val:        "countdistinct",
 							ignoreCase: true,
 						},
@@ -1957,32 +2671,32 @@ var g = &grammar{
 		},
 		{
 			name: "paddedFieldExpr",
-			pos:  position{line: 283, col: 1, offset: 7289},
+			pos:  position{line: 280, col: 1, offset: 9278},
 			expr: &actionExpr{
-				pos: position{line: 283, col: 19, offset: 7307},
+				pos: position{line: 280, col: 19, offset: 9296},
 				run: (*parser).callonpaddedFieldExpr1,
 				expr: &seqExpr{
-					pos: position{line: 283, col: 19, offset: 7307},
+					pos: position{line: 280, col: 19, offset: 9296},
 					exprs: []interface{}{
 						&zeroOrOneExpr{
-							pos: position{line: 283, col: 19, offset: 7307},
+							pos: position{line: 280, col: 19, offset: 9296},
 							expr: &ruleRefExpr{
-								pos:  position{line: 283, col: 19, offset: 7307},
+								pos:  position{line: 280, col: 19, offset: 9296},
 								name: "_",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 283, col: 22, offset: 7310},
+							pos:   position{line: 280, col: 22, offset: 9299},
 							label: "field",
 							expr: &ruleRefExpr{
-								pos:  position{line: 283, col: 28, offset: 7316},
+								pos:  position{line: 280, col: 28, offset: 9305},
 								name: "fieldExpr",
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 283, col: 38, offset: 7326},
+							pos: position{line: 280, col: 38, offset: 9315},
 							expr: &ruleRefExpr{
-								pos:  position{line: 283, col: 38, offset: 7326},
+								pos:  position{line: 280, col: 38, offset: 9315},
 								name: "_",
 							},
 						},
@@ -1992,53 +2706,53 @@ var g = &grammar{
 		},
 		{
 			name: "countReducer",
-			pos:  position{line: 285, col: 1, offset: 7352},
+			pos:  position{line: 281, col: 1, offset: 9340},
 			expr: &actionExpr{
-				pos: position{line: 286, col: 5, offset: 7369},
+				pos: position{line: 282, col: 5, offset: 9357},
 				run: (*parser).calloncountReducer1,
 				expr: &seqExpr{
-					pos: position{line: 286, col: 5, offset: 7369},
+					pos: position{line: 282, col: 5, offset: 9357},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 286, col: 5, offset: 7369},
+							pos:   position{line: 282, col: 5, offset: 9357},
 							label: "op",
 							expr: &ruleRefExpr{
-								pos:  position{line: 286, col: 8, offset: 7372},
+								pos:  position{line: 282, col: 8, offset: 9360},
 								name: "countOp",
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 286, col: 16, offset: 7380},
+							pos: position{line: 282, col: 16, offset: 9368},
 							expr: &ruleRefExpr{
-								pos:  position{line: 286, col: 16, offset: 7380},
+								pos:  position{line: 282, col: 16, offset: 9368},
 								name: "_",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 286, col: 19, offset: 7383},
+							pos:        position{line: 282, col: 19, offset: 9371},
 							val:        "(",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 286, col: 23, offset: 7387},
+							pos:   position{line: 282, col: 23, offset: 9375},
 							label: "field",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 286, col: 29, offset: 7393},
+								pos: position{line: 282, col: 29, offset: 9381},
 								expr: &ruleRefExpr{
-									pos:  position{line: 286, col: 29, offset: 7393},
+									pos:  position{line: 282, col: 29, offset: 9381},
 									name: "paddedFieldExpr",
 								},
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 286, col: 47, offset: 7411},
+							pos: position{line: 282, col: 46, offset: 9398},
 							expr: &ruleRefExpr{
-								pos:  position{line: 286, col: 47, offset: 7411},
+								pos:  position{line: 282, col: 46, offset: 9398},
 								name: "_",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 286, col: 50, offset: 7414},
+							pos:        position{line: 282, col: 49, offset: 9401},
 							val:        ")",
 							ignoreCase: false,
 						},
@@ -2048,57 +2762,57 @@ var g = &grammar{
 		},
 		{
 			name: "fieldReducer",
-			pos:  position{line: 290, col: 1, offset: 7473},
+			pos:  position{line: 285, col: 1, offset: 9459},
 			expr: &actionExpr{
-				pos: position{line: 291, col: 5, offset: 7490},
+				pos: position{line: 286, col: 5, offset: 9476},
 				run: (*parser).callonfieldReducer1,
 				expr: &seqExpr{
-					pos: position{line: 291, col: 5, offset: 7490},
+					pos: position{line: 286, col: 5, offset: 9476},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 291, col: 5, offset: 7490},
+							pos:   position{line: 286, col: 5, offset: 9476},
 							label: "op",
 							expr: &ruleRefExpr{
-								pos:  position{line: 291, col: 8, offset: 7493},
+								pos:  position{line: 286, col: 8, offset: 9479},
 								name: "fieldReducerOp",
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 291, col: 23, offset: 7508},
+							pos: position{line: 286, col: 23, offset: 9494},
 							expr: &ruleRefExpr{
-								pos:  position{line: 291, col: 23, offset: 7508},
+								pos:  position{line: 286, col: 23, offset: 9494},
 								name: "_",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 291, col: 26, offset: 7511},
+							pos:        position{line: 286, col: 26, offset: 9497},
 							val:        "(",
 							ignoreCase: false,
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 291, col: 30, offset: 7515},
+							pos: position{line: 286, col: 30, offset: 9501},
 							expr: &ruleRefExpr{
-								pos:  position{line: 291, col: 30, offset: 7515},
+								pos:  position{line: 286, col: 30, offset: 9501},
 								name: "_",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 291, col: 33, offset: 7518},
+							pos:   position{line: 286, col: 33, offset: 9504},
 							label: "field",
 							expr: &ruleRefExpr{
-								pos:  position{line: 291, col: 39, offset: 7524},
+								pos:  position{line: 286, col: 39, offset: 9510},
 								name: "fieldExpr",
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 291, col: 50, offset: 7535},
+							pos: position{line: 286, col: 49, offset: 9520},
 							expr: &ruleRefExpr{
-								pos:  position{line: 291, col: 50, offset: 7535},
+								pos:  position{line: 286, col: 49, offset: 9520},
 								name: "_",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 291, col: 53, offset: 7538},
+							pos:        position{line: 286, col: 52, offset: 9523},
 							val:        ")",
 							ignoreCase: false,
 						},
@@ -2108,27 +2822,27 @@ var g = &grammar{
 		},
 		{
 			name: "reducerProc",
-			pos:  position{line: 295, col: 1, offset: 7605},
+			pos:  position{line: 289, col: 1, offset: 9589},
 			expr: &actionExpr{
-				pos: position{line: 296, col: 5, offset: 7621},
+				pos: position{line: 290, col: 5, offset: 9605},
 				run: (*parser).callonreducerProc1,
 				expr: &seqExpr{
-					pos: position{line: 296, col: 5, offset: 7621},
+					pos: position{line: 290, col: 5, offset: 9605},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 296, col: 5, offset: 7621},
+							pos:   position{line: 290, col: 5, offset: 9605},
 							label: "every",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 296, col: 11, offset: 7627},
+								pos: position{line: 290, col: 11, offset: 9611},
 								expr: &seqExpr{
-									pos: position{line: 296, col: 12, offset: 7628},
+									pos: position{line: 290, col: 12, offset: 9612},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 296, col: 12, offset: 7628},
+											pos:  position{line: 290, col: 12, offset: 9612},
 											name: "everyDur",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 296, col: 21, offset: 7637},
+											pos:  position{line: 290, col: 21, offset: 9621},
 											name: "_",
 										},
 									},
@@ -2136,27 +2850,27 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 296, col: 25, offset: 7641},
+							pos:   position{line: 290, col: 25, offset: 9625},
 							label: "reducers",
 							expr: &ruleRefExpr{
-								pos:  position{line: 296, col: 34, offset: 7650},
+								pos:  position{line: 290, col: 34, offset: 9634},
 								name: "reducerList",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 296, col: 46, offset: 7662},
+							pos:   position{line: 290, col: 46, offset: 9646},
 							label: "keys",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 296, col: 51, offset: 7667},
+								pos: position{line: 290, col: 51, offset: 9651},
 								expr: &seqExpr{
-									pos: position{line: 296, col: 52, offset: 7668},
+									pos: position{line: 290, col: 52, offset: 9652},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 296, col: 52, offset: 7668},
+											pos:  position{line: 290, col: 52, offset: 9652},
 											name: "_",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 296, col: 54, offset: 7670},
+											pos:  position{line: 290, col: 54, offset: 9654},
 											name: "groupBy",
 										},
 									},
@@ -2164,12 +2878,12 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 296, col: 64, offset: 7680},
+							pos:   position{line: 290, col: 64, offset: 9664},
 							label: "limit",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 296, col: 70, offset: 7686},
+								pos: position{line: 290, col: 70, offset: 9670},
 								expr: &ruleRefExpr{
-									pos:  position{line: 296, col: 70, offset: 7686},
+									pos:  position{line: 290, col: 70, offset: 9670},
 									name: "procLimitArg",
 								},
 							},
@@ -2180,27 +2894,27 @@ var g = &grammar{
 		},
 		{
 			name: "asClause",
-			pos:  position{line: 314, col: 1, offset: 8043},
+			pos:  position{line: 304, col: 1, offset: 10023},
 			expr: &actionExpr{
-				pos: position{line: 315, col: 5, offset: 8056},
+				pos: position{line: 305, col: 5, offset: 10036},
 				run: (*parser).callonasClause1,
 				expr: &seqExpr{
-					pos: position{line: 315, col: 5, offset: 8056},
+					pos: position{line: 305, col: 5, offset: 10036},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 315, col: 5, offset: 8056},
+							pos:        position{line: 305, col: 5, offset: 10036},
 							val:        "as",
 							ignoreCase: true,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 315, col: 11, offset: 8062},
+							pos:  position{line: 305, col: 11, offset: 10042},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 315, col: 13, offset: 8064},
+							pos:   position{line: 305, col: 13, offset: 10044},
 							label: "v",
 							expr: &ruleRefExpr{
-								pos:  position{line: 315, col: 15, offset: 8066},
+								pos:  position{line: 305, col: 15, offset: 10046},
 								name: "fieldName",
 							},
 						},
@@ -2210,48 +2924,48 @@ var g = &grammar{
 		},
 		{
 			name: "reducerExpr",
-			pos:  position{line: 317, col: 1, offset: 8095},
+			pos:  position{line: 306, col: 1, offset: 10074},
 			expr: &choiceExpr{
-				pos: position{line: 318, col: 5, offset: 8111},
+				pos: position{line: 307, col: 5, offset: 10090},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 318, col: 5, offset: 8111},
+						pos: position{line: 307, col: 5, offset: 10090},
 						run: (*parser).callonreducerExpr2,
 						expr: &seqExpr{
-							pos: position{line: 318, col: 5, offset: 8111},
+							pos: position{line: 307, col: 5, offset: 10090},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 318, col: 5, offset: 8111},
+									pos:   position{line: 307, col: 5, offset: 10090},
 									label: "field",
 									expr: &ruleRefExpr{
-										pos:  position{line: 318, col: 11, offset: 8117},
+										pos:  position{line: 307, col: 11, offset: 10096},
 										name: "fieldExpr",
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 318, col: 21, offset: 8127},
+									pos: position{line: 307, col: 21, offset: 10106},
 									expr: &ruleRefExpr{
-										pos:  position{line: 318, col: 21, offset: 8127},
+										pos:  position{line: 307, col: 21, offset: 10106},
 										name: "_",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 318, col: 24, offset: 8130},
+									pos:        position{line: 307, col: 24, offset: 10109},
 									val:        "=",
 									ignoreCase: false,
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 318, col: 28, offset: 8134},
+									pos: position{line: 307, col: 28, offset: 10113},
 									expr: &ruleRefExpr{
-										pos:  position{line: 318, col: 28, offset: 8134},
+										pos:  position{line: 307, col: 28, offset: 10113},
 										name: "_",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 318, col: 31, offset: 8137},
+									pos:   position{line: 307, col: 31, offset: 10116},
 									label: "f",
 									expr: &ruleRefExpr{
-										pos:  position{line: 318, col: 33, offset: 8139},
+										pos:  position{line: 307, col: 33, offset: 10118},
 										name: "reducer",
 									},
 								},
@@ -2259,28 +2973,28 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 321, col: 5, offset: 8202},
+						pos: position{line: 310, col: 5, offset: 10181},
 						run: (*parser).callonreducerExpr13,
 						expr: &seqExpr{
-							pos: position{line: 321, col: 5, offset: 8202},
+							pos: position{line: 310, col: 5, offset: 10181},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 321, col: 5, offset: 8202},
+									pos:   position{line: 310, col: 5, offset: 10181},
 									label: "f",
 									expr: &ruleRefExpr{
-										pos:  position{line: 321, col: 7, offset: 8204},
+										pos:  position{line: 310, col: 7, offset: 10183},
 										name: "reducer",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 321, col: 15, offset: 8212},
+									pos:  position{line: 310, col: 15, offset: 10191},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 321, col: 17, offset: 8214},
+									pos:   position{line: 310, col: 17, offset: 10193},
 									label: "field",
 									expr: &ruleRefExpr{
-										pos:  position{line: 321, col: 23, offset: 8220},
+										pos:  position{line: 310, col: 23, offset: 10199},
 										name: "asClause",
 									},
 								},
@@ -2288,7 +3002,7 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 324, col: 5, offset: 8284},
+						pos:  position{line: 313, col: 5, offset: 10263},
 						name: "reducer",
 					},
 				},
@@ -2296,16 +3010,16 @@ var g = &grammar{
 		},
 		{
 			name: "reducer",
-			pos:  position{line: 326, col: 1, offset: 8293},
+			pos:  position{line: 314, col: 1, offset: 10271},
 			expr: &choiceExpr{
-				pos: position{line: 327, col: 5, offset: 8305},
+				pos: position{line: 315, col: 5, offset: 10283},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 327, col: 5, offset: 8305},
+						pos:  position{line: 315, col: 5, offset: 10283},
 						name: "countReducer",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 328, col: 5, offset: 8322},
+						pos:  position{line: 316, col: 5, offset: 10300},
 						name: "fieldReducer",
 					},
 				},
@@ -2313,50 +3027,50 @@ var g = &grammar{
 		},
 		{
 			name: "reducerList",
-			pos:  position{line: 330, col: 1, offset: 8336},
+			pos:  position{line: 317, col: 1, offset: 10313},
 			expr: &actionExpr{
-				pos: position{line: 331, col: 5, offset: 8352},
+				pos: position{line: 318, col: 5, offset: 10329},
 				run: (*parser).callonreducerList1,
 				expr: &seqExpr{
-					pos: position{line: 331, col: 5, offset: 8352},
+					pos: position{line: 318, col: 5, offset: 10329},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 331, col: 5, offset: 8352},
+							pos:   position{line: 318, col: 5, offset: 10329},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 331, col: 11, offset: 8358},
+								pos:  position{line: 318, col: 11, offset: 10335},
 								name: "reducerExpr",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 331, col: 23, offset: 8370},
+							pos:   position{line: 318, col: 23, offset: 10347},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 331, col: 28, offset: 8375},
+								pos: position{line: 318, col: 28, offset: 10352},
 								expr: &seqExpr{
-									pos: position{line: 331, col: 29, offset: 8376},
+									pos: position{line: 318, col: 29, offset: 10353},
 									exprs: []interface{}{
 										&zeroOrOneExpr{
-											pos: position{line: 331, col: 29, offset: 8376},
+											pos: position{line: 318, col: 29, offset: 10353},
 											expr: &ruleRefExpr{
-												pos:  position{line: 331, col: 29, offset: 8376},
+												pos:  position{line: 318, col: 29, offset: 10353},
 												name: "_",
 											},
 										},
 										&litMatcher{
-											pos:        position{line: 331, col: 32, offset: 8379},
+											pos:        position{line: 318, col: 32, offset: 10356},
 											val:        ",",
 											ignoreCase: false,
 										},
 										&zeroOrOneExpr{
-											pos: position{line: 331, col: 36, offset: 8383},
+											pos: position{line: 318, col: 36, offset: 10360},
 											expr: &ruleRefExpr{
-												pos:  position{line: 331, col: 36, offset: 8383},
+												pos:  position{line: 318, col: 36, offset: 10360},
 												name: "_",
 											},
 										},
 										&ruleRefExpr{
-											pos:  position{line: 331, col: 39, offset: 8386},
+											pos:  position{line: 318, col: 39, offset: 10363},
 											name: "reducerExpr",
 										},
 									},
@@ -2369,87 +3083,123 @@ var g = &grammar{
 		},
 		{
 			name: "simpleProc",
-			pos:  position{line: 339, col: 1, offset: 8583},
+			pos:  position{line: 325, col: 1, offset: 10556},
 			expr: &choiceExpr{
-				pos: position{line: 340, col: 5, offset: 8598},
+				pos: position{line: 326, col: 5, offset: 10571},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 340, col: 5, offset: 8598},
+						pos:  position{line: 326, col: 5, offset: 10571},
 						name: "sort",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 341, col: 5, offset: 8607},
+						pos:  position{line: 327, col: 5, offset: 10580},
 						name: "top",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 342, col: 5, offset: 8615},
+						pos:  position{line: 328, col: 5, offset: 10588},
 						name: "cut",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 343, col: 5, offset: 8623},
+						pos:  position{line: 329, col: 5, offset: 10596},
 						name: "head",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 344, col: 5, offset: 8632},
+						pos:  position{line: 330, col: 5, offset: 10605},
 						name: "tail",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 345, col: 5, offset: 8641},
+						pos:  position{line: 331, col: 5, offset: 10614},
 						name: "filter",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 346, col: 5, offset: 8652},
+						pos:  position{line: 332, col: 5, offset: 10625},
 						name: "uniq",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 347, col: 5, offset: 8661},
+						pos:  position{line: 333, col: 5, offset: 10634},
 						name: "put",
 					},
-				},
-			},
-		},
-		{
-			name: "sort",
-			pos:  position{line: 349, col: 1, offset: 8666},
-			expr: &actionExpr{
-				pos: position{line: 350, col: 5, offset: 8675},
-				run: (*parser).callonsort1,
-				expr: &seqExpr{
-					pos: position{line: 350, col: 5, offset: 8675},
-					exprs: []interface{}{
-						&litMatcher{
-							pos:        position{line: 350, col: 5, offset: 8675},
+					&ruleRefExpr{
+						pos:  position{line: 334, col: 5, offset: 10642},
+						name: "window",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 335, col: 5, offset: 10653},
+						name: "session",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 336, col: 5, offset: 10665},
+						name: "sample",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 337, col: 5, offset: 10676},
+						name: "histogram",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 338, col: 5, offset: 10690},
+						name: "lookup",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 339, col: 5, offset: 10701},
+						name: "intel",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 340, col: 5, offset: 10711},
+						name: "parse",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 341, col: 5, offset: 10721},
+						name: "unnest",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 342, col: 5, offset: 10732},
+						name: "pass",
+					},
+				},
+			},
+		},
+		{
+			name: "sort",
+			pos:  position{line: 343, col: 1, offset: 10737},
+			expr: &actionExpr{
+				pos: position{line: 344, col: 5, offset: 10746},
+				run: (*parser).callonsort1,
+				expr: &seqExpr{
+					pos: position{line: 344, col: 5, offset: 10746},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 344, col: 5, offset: 10746},
 							val:        "sort",
 							ignoreCase: true,
 						},
 						&labeledExpr{
-							pos:   position{line: 350, col: 13, offset: 8683},
+							pos:   position{line: 344, col: 13, offset: 10754},
 							label: "args",
 							expr: &ruleRefExpr{
-								pos:  position{line: 350, col: 18, offset: 8688},
+								pos:  position{line: 344, col: 18, offset: 10759},
 								name: "sortArgs",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 350, col: 27, offset: 8697},
+							pos:   position{line: 344, col: 27, offset: 10768},
 							label: "list",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 350, col: 32, offset: 8702},
+								pos: position{line: 344, col: 32, offset: 10773},
 								expr: &actionExpr{
-									pos: position{line: 350, col: 33, offset: 8703},
+									pos: position{line: 344, col: 33, offset: 10774},
 									run: (*parser).callonsort8,
 									expr: &seqExpr{
-										pos: position{line: 350, col: 33, offset: 8703},
+										pos: position{line: 344, col: 33, offset: 10774},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 350, col: 33, offset: 8703},
+												pos:  position{line: 344, col: 33, offset: 10774},
 												name: "_",
 											},
 											&labeledExpr{
-												pos:   position{line: 350, col: 35, offset: 8705},
+												pos:   position{line: 344, col: 35, offset: 10776},
 												label: "l",
 												expr: &ruleRefExpr{
-													pos:  position{line: 350, col: 37, offset: 8707},
+													pos:  position{line: 344, col: 37, offset: 10778},
 													name: "fieldExprList",
 												},
 											},
@@ -2464,24 +3214,24 @@ var g = &grammar{
 		},
 		{
 			name: "sortArgs",
-			pos:  position{line: 354, col: 1, offset: 8784},
+			pos:  position{line: 347, col: 1, offset: 10854},
 			expr: &zeroOrMoreExpr{
-				pos: position{line: 354, col: 12, offset: 8795},
+				pos: position{line: 347, col: 12, offset: 10865},
 				expr: &actionExpr{
-					pos: position{line: 354, col: 13, offset: 8796},
+					pos: position{line: 347, col: 13, offset: 10866},
 					run: (*parser).callonsortArgs2,
 					expr: &seqExpr{
-						pos: position{line: 354, col: 13, offset: 8796},
+						pos: position{line: 347, col: 13, offset: 10866},
 						exprs: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 354, col: 13, offset: 8796},
+								pos:  position{line: 347, col: 13, offset: 10866},
 								name: "_",
 							},
 							&labeledExpr{
-								pos:   position{line: 354, col: 15, offset: 8798},
+								pos:   position{line: 347, col: 15, offset: 10868},
 								label: "a",
 								expr: &ruleRefExpr{
-									pos:  position{line: 354, col: 17, offset: 8800},
+									pos:  position{line: 347, col: 17, offset: 10870},
 									name: "sortArg",
 								},
 							},
@@ -2492,30 +3242,30 @@ var g = &grammar{
 		},
 		{
 			name: "sortArg",
-			pos:  position{line: 356, col: 1, offset: 8829},
+			pos:  position{line: 348, col: 1, offset: 10898},
 			expr: &choiceExpr{
-				pos: position{line: 357, col: 5, offset: 8841},
+				pos: position{line: 349, col: 5, offset: 10910},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 357, col: 5, offset: 8841},
+						pos: position{line: 349, col: 5, offset: 10910},
 						run: (*parser).callonsortArg2,
 						expr: &seqExpr{
-							pos: position{line: 357, col: 5, offset: 8841},
+							pos: position{line: 349, col: 5, offset: 10910},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 357, col: 5, offset: 8841},
+									pos:        position{line: 349, col: 5, offset: 10910},
 									val:        "-limit",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 357, col: 14, offset: 8850},
+									pos:  position{line: 349, col: 14, offset: 10919},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 357, col: 16, offset: 8852},
+									pos:   position{line: 349, col: 16, offset: 10921},
 									label: "limit",
 									expr: &ruleRefExpr{
-										pos:  position{line: 357, col: 22, offset: 8858},
+										pos:  position{line: 349, col: 22, offset: 10927},
 										name: "suint",
 									},
 								},
@@ -2523,45 +3273,45 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 358, col: 5, offset: 8908},
+						pos: position{line: 350, col: 5, offset: 10977},
 						run: (*parser).callonsortArg8,
 						expr: &litMatcher{
-							pos:        position{line: 358, col: 5, offset: 8908},
+							pos:        position{line: 350, col: 5, offset: 10977},
 							val:        "-r",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 359, col: 5, offset: 8951},
+						pos: position{line: 351, col: 5, offset: 11020},
 						run: (*parser).callonsortArg10,
 						expr: &seqExpr{
-							pos: position{line: 359, col: 5, offset: 8951},
+							pos: position{line: 351, col: 5, offset: 11020},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 359, col: 5, offset: 8951},
+									pos:        position{line: 351, col: 5, offset: 11020},
 									val:        "-nulls",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 359, col: 14, offset: 8960},
+									pos:  position{line: 351, col: 14, offset: 11029},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 359, col: 16, offset: 8962},
+									pos:   position{line: 351, col: 16, offset: 11031},
 									label: "where",
 									expr: &actionExpr{
-										pos: position{line: 359, col: 23, offset: 8969},
+										pos: position{line: 351, col: 23, offset: 11038},
 										run: (*parser).callonsortArg15,
 										expr: &choiceExpr{
-											pos: position{line: 359, col: 24, offset: 8970},
+											pos: position{line: 351, col: 24, offset: 11039},
 											alternatives: []interface{}{
 												&litMatcher{
-													pos:        position{line: 359, col: 24, offset: 8970},
+													pos:        position{line: 351, col: 24, offset: 11039},
 													val:        "first",
 													ignoreCase: false,
 												},
 												&litMatcher{
-													pos:        position{line: 359, col: 34, offset: 8980},
+													pos:        position{line: 351, col: 34, offset: 11049},
 													val:        "last",
 													ignoreCase: false,
 												},
@@ -2577,38 +3327,38 @@ var g = &grammar{
 		},
 		{
 			name: "top",
-			pos:  position{line: 361, col: 1, offset: 9062},
+			pos:  position{line: 352, col: 1, offset: 11130},
 			expr: &actionExpr{
-				pos: position{line: 362, col: 5, offset: 9070},
+				pos: position{line: 353, col: 5, offset: 11138},
 				run: (*parser).callontop1,
 				expr: &seqExpr{
-					pos: position{line: 362, col: 5, offset: 9070},
+					pos: position{line: 353, col: 5, offset: 11138},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 362, col: 5, offset: 9070},
+							pos:        position{line: 353, col: 5, offset: 11138},
 							val:        "top",
 							ignoreCase: true,
 						},
 						&labeledExpr{
-							pos:   position{line: 362, col: 12, offset: 9077},
+							pos:   position{line: 353, col: 12, offset: 11145},
 							label: "limit",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 362, col: 18, offset: 9083},
+								pos: position{line: 353, col: 18, offset: 11151},
 								expr: &actionExpr{
-									pos: position{line: 362, col: 19, offset: 9084},
+									pos: position{line: 353, col: 19, offset: 11152},
 									run: (*parser).callontop6,
 									expr: &seqExpr{
-										pos: position{line: 362, col: 19, offset: 9084},
+										pos: position{line: 353, col: 19, offset: 11152},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 362, col: 19, offset: 9084},
+												pos:  position{line: 353, col: 19, offset: 11152},
 												name: "_",
 											},
 											&labeledExpr{
-												pos:   position{line: 362, col: 21, offset: 9086},
+												pos:   position{line: 353, col: 21, offset: 11154},
 												label: "n",
 												expr: &ruleRefExpr{
-													pos:  position{line: 362, col: 23, offset: 9088},
+													pos:  position{line: 353, col: 23, offset: 11156},
 													name: "unsignedInteger",
 												},
 											},
@@ -2618,19 +3368,19 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 362, col: 58, offset: 9123},
+							pos:   position{line: 353, col: 58, offset: 11191},
 							label: "flush",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 362, col: 64, offset: 9129},
+								pos: position{line: 353, col: 64, offset: 11197},
 								expr: &seqExpr{
-									pos: position{line: 362, col: 65, offset: 9130},
+									pos: position{line: 353, col: 65, offset: 11198},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 362, col: 65, offset: 9130},
+											pos:  position{line: 353, col: 65, offset: 11198},
 											name: "_",
 										},
 										&litMatcher{
-											pos:        position{line: 362, col: 67, offset: 9132},
+											pos:        position{line: 353, col: 67, offset: 11200},
 											val:        "-flush",
 											ignoreCase: false,
 										},
@@ -2639,25 +3389,25 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 362, col: 78, offset: 9143},
+							pos:   position{line: 353, col: 78, offset: 11211},
 							label: "list",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 362, col: 83, offset: 9148},
+								pos: position{line: 353, col: 83, offset: 11216},
 								expr: &actionExpr{
-									pos: position{line: 362, col: 84, offset: 9149},
+									pos: position{line: 353, col: 84, offset: 11217},
 									run: (*parser).callontop18,
 									expr: &seqExpr{
-										pos: position{line: 362, col: 84, offset: 9149},
+										pos: position{line: 353, col: 84, offset: 11217},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 362, col: 84, offset: 9149},
+												pos:  position{line: 353, col: 84, offset: 11217},
 												name: "_",
 											},
 											&labeledExpr{
-												pos:   position{line: 362, col: 86, offset: 9151},
+												pos:   position{line: 353, col: 86, offset: 11219},
 												label: "f",
 												expr: &ruleRefExpr{
-													pos:  position{line: 362, col: 88, offset: 9153},
+													pos:  position{line: 353, col: 88, offset: 11221},
 													name: "fieldExprList",
 												},
 											},
@@ -2672,31 +3422,31 @@ var g = &grammar{
 		},
 		{
 			name: "procLimitArg",
-			pos:  position{line: 366, col: 1, offset: 9242},
+			pos:  position{line: 356, col: 1, offset: 11309},
 			expr: &actionExpr{
-				pos: position{line: 367, col: 5, offset: 9259},
+				pos: position{line: 357, col: 5, offset: 11326},
 				run: (*parser).callonprocLimitArg1,
 				expr: &seqExpr{
-					pos: position{line: 367, col: 5, offset: 9259},
+					pos: position{line: 357, col: 5, offset: 11326},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 367, col: 5, offset: 9259},
+							pos:  position{line: 357, col: 5, offset: 11326},
 							name: "_",
 						},
 						&litMatcher{
-							pos:        position{line: 367, col: 7, offset: 9261},
+							pos:        position{line: 357, col: 7, offset: 11328},
 							val:        "-limit",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 367, col: 16, offset: 9270},
+							pos:  position{line: 357, col: 16, offset: 11337},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 367, col: 18, offset: 9272},
+							pos:   position{line: 357, col: 18, offset: 11339},
 							label: "limit",
 							expr: &ruleRefExpr{
-								pos:  position{line: 367, col: 24, offset: 9278},
+								pos:  position{line: 357, col: 24, offset: 11345},
 								name: "unsignedInteger",
 							},
 						},
@@ -2706,27 +3456,48 @@ var g = &grammar{
 		},
 		{
 			name: "cut",
-			pos:  position{line: 369, col: 1, offset: 9317},
+			pos:  position{line: 358, col: 1, offset: 11383},
 			expr: &actionExpr{
-				pos: position{line: 370, col: 5, offset: 9325},
+				pos: position{line: 359, col: 5, offset: 11391},
 				run: (*parser).calloncut1,
 				expr: &seqExpr{
-					pos: position{line: 370, col: 5, offset: 9325},
+					pos: position{line: 359, col: 5, offset: 11391},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 370, col: 5, offset: 9325},
+							pos:        position{line: 359, col: 5, offset: 11391},
 							val:        "cut",
 							ignoreCase: true,
 						},
+						&labeledExpr{
+							pos:   position{line: 359, col: 12, offset: 11398},
+							label: "partial",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 359, col: 20, offset: 11406},
+								expr: &seqExpr{
+									pos: position{line: 359, col: 21, offset: 11407},
+									exprs: []interface{}{
+										&ruleRefExpr{
+											pos:  position{line: 359, col: 21, offset: 11407},
+											name: "_",
+										},
+										&litMatcher{
+											pos:        position{line: 359, col: 23, offset: 11409},
+											val:        "-partial",
+											ignoreCase: false,
+										},
+									},
+								},
+							},
+						},
 						&ruleRefExpr{
-							pos:  position{line: 370, col: 12, offset: 9332},
+							pos:  position{line: 359, col: 36, offset: 11422},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 370, col: 14, offset: 9334},
+							pos:   position{line: 359, col: 38, offset: 11424},
 							label: "list",
 							expr: &ruleRefExpr{
-								pos:  position{line: 370, col: 19, offset: 9339},
+								pos:  position{line: 359, col: 43, offset: 11429},
 								name: "fieldRefDotOnlyList",
 							},
 						},
@@ -2736,41 +3507,69 @@ var g = &grammar{
 		},
 		{
 			name: "head",
-			pos:  position{line: 371, col: 1, offset: 9393},
+			pos:  position{line: 360, col: 1, offset: 11492},
 			expr: &choiceExpr{
-				pos: position{line: 372, col: 5, offset: 9402},
+				pos: position{line: 361, col: 5, offset: 11501},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 372, col: 5, offset: 9402},
+						pos: position{line: 361, col: 5, offset: 11501},
 						run: (*parser).callonhead2,
 						expr: &seqExpr{
-							pos: position{line: 372, col: 5, offset: 9402},
+							pos: position{line: 361, col: 5, offset: 11501},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 372, col: 5, offset: 9402},
+									pos:        position{line: 361, col: 5, offset: 11501},
 									val:        "head",
 									ignoreCase: true,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 372, col: 13, offset: 9410},
+									pos:  position{line: 361, col: 13, offset: 11509},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 372, col: 15, offset: 9412},
+									pos:   position{line: 361, col: 15, offset: 11511},
 									label: "count",
 									expr: &ruleRefExpr{
-										pos:  position{line: 372, col: 21, offset: 9418},
+										pos:  position{line: 361, col: 21, offset: 11517},
 										name: "unsignedInteger",
 									},
 								},
+								&labeledExpr{
+									pos:   position{line: 361, col: 37, offset: 11533},
+									label: "keys",
+									expr: &zeroOrOneExpr{
+										pos: position{line: 361, col: 42, offset: 11538},
+										expr: &actionExpr{
+											pos: position{line: 361, col: 43, offset: 11539},
+											run: (*parser).callonhead10,
+											expr: &seqExpr{
+												pos: position{line: 361, col: 43, offset: 11539},
+												exprs: []interface{}{
+													&ruleRefExpr{
+														pos:  position{line: 361, col: 43, offset: 11539},
+														name: "_",
+													},
+													&labeledExpr{
+														pos:   position{line: 361, col: 45, offset: 11541},
+														label: "k",
+														expr: &ruleRefExpr{
+															pos:  position{line: 361, col: 47, offset: 11543},
+															name: "groupBy",
+														},
+													},
+												},
+											},
+										},
+									},
+								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 373, col: 5, offset: 9474},
-						run: (*parser).callonhead8,
+						pos: position{line: 362, col: 5, offset: 11617},
+						run: (*parser).callonhead15,
 						expr: &litMatcher{
-							pos:        position{line: 373, col: 5, offset: 9474},
+							pos:        position{line: 362, col: 5, offset: 11617},
 							val:        "head",
 							ignoreCase: true,
 						},
@@ -2780,41 +3579,69 @@ var g = &grammar{
 		},
 		{
 			name: "tail",
-			pos:  position{line: 374, col: 1, offset: 9514},
+			pos:  position{line: 363, col: 1, offset: 11662},
 			expr: &choiceExpr{
-				pos: position{line: 375, col: 5, offset: 9523},
+				pos: position{line: 364, col: 5, offset: 11671},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 375, col: 5, offset: 9523},
+						pos: position{line: 364, col: 5, offset: 11671},
 						run: (*parser).callontail2,
 						expr: &seqExpr{
-							pos: position{line: 375, col: 5, offset: 9523},
+							pos: position{line: 364, col: 5, offset: 11671},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 375, col: 5, offset: 9523},
+									pos:        position{line: 364, col: 5, offset: 11671},
 									val:        "tail",
 									ignoreCase: true,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 375, col: 13, offset: 9531},
+									pos:  position{line: 364, col: 13, offset: 11679},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 375, col: 15, offset: 9533},
+									pos:   position{line: 364, col: 15, offset: 11681},
 									label: "count",
 									expr: &ruleRefExpr{
-										pos:  position{line: 375, col: 21, offset: 9539},
+										pos:  position{line: 364, col: 21, offset: 11687},
 										name: "unsignedInteger",
 									},
 								},
+								&labeledExpr{
+									pos:   position{line: 364, col: 37, offset: 11703},
+									label: "keys",
+									expr: &zeroOrOneExpr{
+										pos: position{line: 364, col: 42, offset: 11708},
+										expr: &actionExpr{
+											pos: position{line: 364, col: 43, offset: 11709},
+											run: (*parser).callontail10,
+											expr: &seqExpr{
+												pos: position{line: 364, col: 43, offset: 11709},
+												exprs: []interface{}{
+													&ruleRefExpr{
+														pos:  position{line: 364, col: 43, offset: 11709},
+														name: "_",
+													},
+													&labeledExpr{
+														pos:   position{line: 364, col: 45, offset: 11711},
+														label: "k",
+														expr: &ruleRefExpr{
+															pos:  position{line: 364, col: 47, offset: 11713},
+															name: "groupBy",
+														},
+													},
+												},
+											},
+										},
+									},
+								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 376, col: 5, offset: 9595},
-						run: (*parser).callontail8,
+						pos: position{line: 365, col: 5, offset: 11787},
+						run: (*parser).callontail15,
 						expr: &litMatcher{
-							pos:        position{line: 376, col: 5, offset: 9595},
+							pos:        position{line: 365, col: 5, offset: 11787},
 							val:        "tail",
 							ignoreCase: true,
 						},
@@ -2824,27 +3651,27 @@ var g = &grammar{
 		},
 		{
 			name: "filter",
-			pos:  position{line: 378, col: 1, offset: 9636},
+			pos:  position{line: 366, col: 1, offset: 11832},
 			expr: &actionExpr{
-				pos: position{line: 379, col: 5, offset: 9647},
+				pos: position{line: 367, col: 5, offset: 11843},
 				run: (*parser).callonfilter1,
 				expr: &seqExpr{
-					pos: position{line: 379, col: 5, offset: 9647},
+					pos: position{line: 367, col: 5, offset: 11843},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 379, col: 5, offset: 9647},
+							pos:        position{line: 367, col: 5, offset: 11843},
 							val:        "filter",
 							ignoreCase: true,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 379, col: 15, offset: 9657},
+							pos:  position{line: 367, col: 15, offset: 11853},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 379, col: 17, offset: 9659},
+							pos:   position{line: 367, col: 17, offset: 11855},
 							label: "expr",
 							expr: &ruleRefExpr{
-								pos:  position{line: 379, col: 22, offset: 9664},
+								pos:  position{line: 367, col: 22, offset: 11860},
 								name: "searchExpr",
 							},
 						},
@@ -2854,27 +3681,73 @@ var g = &grammar{
 		},
 		{
 			name: "uniq",
-			pos:  position{line: 382, col: 1, offset: 9722},
+			pos:  position{line: 370, col: 1, offset: 11918},
 			expr: &choiceExpr{
-				pos: position{line: 383, col: 5, offset: 9731},
+				pos: position{line: 371, col: 5, offset: 11927},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 383, col: 5, offset: 9731},
+						pos: position{line: 371, col: 5, offset: 11927},
 						run: (*parser).callonuniq2,
 						expr: &seqExpr{
-							pos: position{line: 383, col: 5, offset: 9731},
+							pos: position{line: 371, col: 5, offset: 11927},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 371, col: 5, offset: 11927},
+									val:        "uniq",
+									ignoreCase: true,
+								},
+								&ruleRefExpr{
+									pos:  position{line: 371, col: 13, offset: 11935},
+									name: "_",
+								},
+								&litMatcher{
+									pos:        position{line: 371, col: 15, offset: 11937},
+									val:        "-by",
+									ignoreCase: false,
+								},
+								&ruleRefExpr{
+									pos:  position{line: 371, col: 21, offset: 11943},
+									name: "_",
+								},
+								&labeledExpr{
+									pos:   position{line: 371, col: 23, offset: 11945},
+									label: "keys",
+									expr: &ruleRefExpr{
+										pos:  position{line: 371, col: 28, offset: 11950},
+										name: "fieldExprList",
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 371, col: 42, offset: 11964},
+									label: "limit",
+									expr: &zeroOrOneExpr{
+										pos: position{line: 371, col: 48, offset: 11970},
+										expr: &ruleRefExpr{
+											pos:  position{line: 371, col: 48, offset: 11970},
+											name: "procLimitArg",
+										},
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 374, col: 5, offset: 12042},
+						run: (*parser).callonuniq13,
+						expr: &seqExpr{
+							pos: position{line: 374, col: 5, offset: 12042},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 383, col: 5, offset: 9731},
+									pos:        position{line: 374, col: 5, offset: 12042},
 									val:        "uniq",
 									ignoreCase: true,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 383, col: 13, offset: 9739},
+									pos:  position{line: 374, col: 13, offset: 12050},
 									name: "_",
 								},
 								&litMatcher{
-									pos:        position{line: 383, col: 15, offset: 9741},
+									pos:        position{line: 374, col: 15, offset: 12052},
 									val:        "-c",
 									ignoreCase: false,
 								},
@@ -2882,10 +3755,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 386, col: 5, offset: 9795},
-						run: (*parser).callonuniq7,
+						pos: position{line: 377, col: 5, offset: 12106},
+						run: (*parser).callonuniq18,
 						expr: &litMatcher{
-							pos:        position{line: 386, col: 5, offset: 9795},
+							pos:        position{line: 377, col: 5, offset: 12106},
 							val:        "uniq",
 							ignoreCase: true,
 						},
@@ -2895,48 +3768,48 @@ var g = &grammar{
 		},
 		{
 			name: "put",
-			pos:  position{line: 390, col: 1, offset: 9850},
+			pos:  position{line: 380, col: 1, offset: 12160},
 			expr: &actionExpr{
-				pos: position{line: 391, col: 5, offset: 9858},
+				pos: position{line: 381, col: 5, offset: 12168},
 				run: (*parser).callonput1,
 				expr: &seqExpr{
-					pos: position{line: 391, col: 5, offset: 9858},
+					pos: position{line: 381, col: 5, offset: 12168},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 391, col: 5, offset: 9858},
+							pos:        position{line: 381, col: 5, offset: 12168},
 							val:        "put",
 							ignoreCase: true,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 391, col: 12, offset: 9865},
+							pos:  position{line: 381, col: 12, offset: 12175},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 391, col: 14, offset: 9867},
+							pos:   position{line: 381, col: 14, offset: 12177},
 							label: "f",
 							expr: &ruleRefExpr{
-								pos:  position{line: 391, col: 16, offset: 9869},
+								pos:  position{line: 381, col: 16, offset: 12179},
 								name: "fieldName",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 391, col: 26, offset: 9879},
+							pos:  position{line: 381, col: 26, offset: 12189},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 391, col: 29, offset: 9882},
+							pos:        position{line: 381, col: 29, offset: 12192},
 							val:        "=",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 391, col: 33, offset: 9886},
+							pos:  position{line: 381, col: 33, offset: 12196},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 391, col: 36, offset: 9889},
+							pos:   position{line: 381, col: 36, offset: 12199},
 							label: "e",
 							expr: &ruleRefExpr{
-								pos:  position{line: 391, col: 38, offset: 9891},
+								pos:  position{line: 381, col: 38, offset: 12201},
 								name: "Expression",
 							},
 						},
@@ -2945,153 +3818,54 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "PrimaryExpression",
-			pos:  position{line: 395, col: 1, offset: 9947},
-			expr: &choiceExpr{
-				pos: position{line: 396, col: 5, offset: 9969},
-				alternatives: []interface{}{
-					&ruleRefExpr{
-						pos:  position{line: 396, col: 5, offset: 9969},
-						name: "StringLiteral",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 397, col: 5, offset: 9987},
-						name: "RegexpLiteral",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 398, col: 5, offset: 10005},
-						name: "PortLiteral",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 399, col: 5, offset: 10021},
-						name: "SubnetLiteral",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 400, col: 5, offset: 10039},
-						name: "AddressLiteral",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 401, col: 5, offset: 10058},
-						name: "FloatLiteral",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 402, col: 5, offset: 10075},
-						name: "IntegerLiteral",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 403, col: 5, offset: 10094},
-						name: "BooleanLiteral",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 404, col: 5, offset: 10113},
-						name: "NullLiteral",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 405, col: 5, offset: 10129},
-						name: "FieldReference",
-					},
-					&actionExpr{
-						pos: position{line: 406, col: 5, offset: 10148},
-						run: (*parser).callonPrimaryExpression12,
-						expr: &seqExpr{
-							pos: position{line: 406, col: 5, offset: 10148},
-							exprs: []interface{}{
-								&litMatcher{
-									pos:        position{line: 406, col: 5, offset: 10148},
-									val:        "(",
-									ignoreCase: false,
-								},
-								&ruleRefExpr{
-									pos:  position{line: 406, col: 9, offset: 10152},
-									name: "__",
-								},
-								&labeledExpr{
-									pos:   position{line: 406, col: 12, offset: 10155},
-									label: "expr",
-									expr: &ruleRefExpr{
-										pos:  position{line: 406, col: 17, offset: 10160},
-										name: "Expression",
-									},
-								},
-								&ruleRefExpr{
-									pos:  position{line: 406, col: 28, offset: 10171},
-									name: "__",
-								},
-								&litMatcher{
-									pos:        position{line: 406, col: 31, offset: 10174},
-									val:        ")",
-									ignoreCase: false,
-								},
-							},
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "FieldReference",
-			pos:  position{line: 408, col: 1, offset: 10200},
-			expr: &actionExpr{
-				pos: position{line: 409, col: 5, offset: 10219},
-				run: (*parser).callonFieldReference1,
-				expr: &labeledExpr{
-					pos:   position{line: 409, col: 5, offset: 10219},
-					label: "f",
-					expr: &ruleRefExpr{
-						pos:  position{line: 409, col: 7, offset: 10221},
-						name: "fieldName",
-					},
-				},
-			},
-		},
-		{
-			name: "Expression",
-			pos:  position{line: 419, col: 1, offset: 10470},
-			expr: &ruleRefExpr{
-				pos:  position{line: 419, col: 14, offset: 10483},
-				name: "LogicalORExpression",
-			},
-		},
-		{
-			name: "LogicalORExpression",
-			pos:  position{line: 421, col: 1, offset: 10504},
+			name: "window",
+			pos:  position{line: 384, col: 1, offset: 12256},
 			expr: &actionExpr{
-				pos: position{line: 422, col: 5, offset: 10528},
-				run: (*parser).callonLogicalORExpression1,
+				pos: position{line: 385, col: 5, offset: 12267},
+				run: (*parser).callonwindow1,
 				expr: &seqExpr{
-					pos: position{line: 422, col: 5, offset: 10528},
+					pos: position{line: 385, col: 5, offset: 12267},
 					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 385, col: 5, offset: 12267},
+							val:        "window",
+							ignoreCase: true,
+						},
+						&ruleRefExpr{
+							pos:  position{line: 385, col: 15, offset: 12277},
+							name: "_",
+						},
 						&labeledExpr{
-							pos:   position{line: 422, col: 5, offset: 10528},
-							label: "first",
+							pos:   position{line: 385, col: 17, offset: 12279},
+							label: "assignments",
 							expr: &ruleRefExpr{
-								pos:  position{line: 422, col: 11, offset: 10534},
-								name: "LogicalANDExpression",
+								pos:  position{line: 385, col: 29, offset: 12291},
+								name: "assignmentList",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 423, col: 5, offset: 10559},
-							label: "rest",
-							expr: &zeroOrMoreExpr{
-								pos: position{line: 423, col: 10, offset: 10564},
-								expr: &seqExpr{
-									pos: position{line: 423, col: 11, offset: 10565},
-									exprs: []interface{}{
-										&ruleRefExpr{
-											pos:  position{line: 423, col: 11, offset: 10565},
-											name: "__",
-										},
-										&ruleRefExpr{
-											pos:  position{line: 423, col: 14, offset: 10568},
-											name: "orToken",
-										},
-										&ruleRefExpr{
-											pos:  position{line: 423, col: 22, offset: 10576},
-											name: "__",
-										},
-										&ruleRefExpr{
-											pos:  position{line: 423, col: 25, offset: 10579},
-											name: "LogicalANDExpression",
+							pos:   position{line: 385, col: 44, offset: 12306},
+							label: "keys",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 385, col: 49, offset: 12311},
+								expr: &actionExpr{
+									pos: position{line: 385, col: 50, offset: 12312},
+									run: (*parser).callonwindow9,
+									expr: &seqExpr{
+										pos: position{line: 385, col: 50, offset: 12312},
+										exprs: []interface{}{
+											&ruleRefExpr{
+												pos:  position{line: 385, col: 50, offset: 12312},
+												name: "_",
+											},
+											&labeledExpr{
+												pos:   position{line: 385, col: 52, offset: 12314},
+												label: "k",
+												expr: &ruleRefExpr{
+													pos:  position{line: 385, col: 54, offset: 12316},
+													name: "groupBy",
+												},
+											},
 										},
 									},
 								},
@@ -3102,94 +3876,91 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "LogicalANDExpression",
-			pos:  position{line: 427, col: 1, offset: 10664},
+			name: "session",
+			pos:  position{line: 388, col: 1, offset: 12404},
 			expr: &actionExpr{
-				pos: position{line: 428, col: 5, offset: 10689},
-				run: (*parser).callonLogicalANDExpression1,
+				pos: position{line: 389, col: 5, offset: 12416},
+				run: (*parser).callonsession1,
 				expr: &seqExpr{
-					pos: position{line: 428, col: 5, offset: 10689},
+					pos: position{line: 389, col: 5, offset: 12416},
 					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 389, col: 5, offset: 12416},
+							val:        "session",
+							ignoreCase: true,
+						},
+						&ruleRefExpr{
+							pos:  position{line: 389, col: 16, offset: 12427},
+							name: "_",
+						},
+						&litMatcher{
+							pos:        position{line: 389, col: 18, offset: 12429},
+							val:        "gap",
+							ignoreCase: true,
+						},
+						&ruleRefExpr{
+							pos:  position{line: 389, col: 25, offset: 12436},
+							name: "_",
+						},
 						&labeledExpr{
-							pos:   position{line: 428, col: 5, offset: 10689},
-							label: "first",
+							pos:   position{line: 389, col: 27, offset: 12438},
+							label: "gap",
 							expr: &ruleRefExpr{
-								pos:  position{line: 428, col: 11, offset: 10695},
-								name: "EqualityCompareExpression",
+								pos:  position{line: 389, col: 31, offset: 12442},
+								name: "duration",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 429, col: 5, offset: 10725},
-							label: "rest",
-							expr: &zeroOrMoreExpr{
-								pos: position{line: 429, col: 10, offset: 10730},
-								expr: &seqExpr{
-									pos: position{line: 429, col: 11, offset: 10731},
-									exprs: []interface{}{
-										&ruleRefExpr{
-											pos:  position{line: 429, col: 11, offset: 10731},
-											name: "__",
-										},
-										&ruleRefExpr{
-											pos:  position{line: 429, col: 14, offset: 10734},
-											name: "andToken",
-										},
-										&ruleRefExpr{
-											pos:  position{line: 429, col: 23, offset: 10743},
-											name: "__",
-										},
-										&ruleRefExpr{
-											pos:  position{line: 429, col: 26, offset: 10746},
-											name: "EqualityCompareExpression",
+							pos:   position{line: 389, col: 40, offset: 12451},
+							label: "reducers",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 389, col: 49, offset: 12460},
+								expr: &actionExpr{
+									pos: position{line: 389, col: 50, offset: 12461},
+									run: (*parser).callonsession11,
+									expr: &seqExpr{
+										pos: position{line: 389, col: 50, offset: 12461},
+										exprs: []interface{}{
+											&ruleRefExpr{
+												pos:  position{line: 389, col: 50, offset: 12461},
+												name: "_",
+											},
+											&labeledExpr{
+												pos:   position{line: 389, col: 52, offset: 12463},
+												label: "r",
+												expr: &ruleRefExpr{
+													pos:  position{line: 389, col: 54, offset: 12465},
+													name: "reducerList",
+												},
+											},
 										},
 									},
 								},
 							},
 						},
-					},
-				},
-			},
-		},
-		{
-			name: "EqualityCompareExpression",
-			pos:  position{line: 433, col: 1, offset: 10836},
-			expr: &actionExpr{
-				pos: position{line: 434, col: 5, offset: 10866},
-				run: (*parser).callonEqualityCompareExpression1,
-				expr: &seqExpr{
-					pos: position{line: 434, col: 5, offset: 10866},
-					exprs: []interface{}{
-						&labeledExpr{
-							pos:   position{line: 434, col: 5, offset: 10866},
-							label: "first",
-							expr: &ruleRefExpr{
-								pos:  position{line: 434, col: 11, offset: 10872},
-								name: "RelativeExpression",
-							},
-						},
 						&labeledExpr{
-							pos:   position{line: 435, col: 5, offset: 10895},
-							label: "rest",
-							expr: &zeroOrMoreExpr{
-								pos: position{line: 435, col: 10, offset: 10900},
-								expr: &seqExpr{
-									pos: position{line: 435, col: 11, offset: 10901},
-									exprs: []interface{}{
-										&ruleRefExpr{
-											pos:  position{line: 435, col: 11, offset: 10901},
-											name: "__",
-										},
-										&ruleRefExpr{
-											pos:  position{line: 435, col: 14, offset: 10904},
-											name: "EqualityOperator",
-										},
-										&ruleRefExpr{
-											pos:  position{line: 435, col: 31, offset: 10921},
-											name: "__",
-										},
-										&ruleRefExpr{
-											pos:  position{line: 435, col: 34, offset: 10924},
-											name: "RelativeExpression",
+							pos:   position{line: 389, col: 86, offset: 12497},
+							label: "keys",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 389, col: 91, offset: 12502},
+								expr: &actionExpr{
+									pos: position{line: 389, col: 92, offset: 12503},
+									run: (*parser).callonsession18,
+									expr: &seqExpr{
+										pos: position{line: 389, col: 92, offset: 12503},
+										exprs: []interface{}{
+											&ruleRefExpr{
+												pos:  position{line: 389, col: 92, offset: 12503},
+												name: "_",
+											},
+											&labeledExpr{
+												pos:   position{line: 389, col: 94, offset: 12505},
+												label: "k",
+												expr: &ruleRefExpr{
+													pos:  position{line: 389, col: 96, offset: 12507},
+													name: "groupBy",
+												},
+											},
 										},
 									},
 								},
@@ -3200,150 +3971,149 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "EqualityOperator",
-			pos:  position{line: 439, col: 1, offset: 11007},
-			expr: &actionExpr{
-				pos: position{line: 439, col: 20, offset: 11026},
-				run: (*parser).callonEqualityOperator1,
-				expr: &choiceExpr{
-					pos: position{line: 439, col: 21, offset: 11027},
-					alternatives: []interface{}{
-						&litMatcher{
-							pos:        position{line: 439, col: 21, offset: 11027},
-							val:        "=",
-							ignoreCase: false,
-						},
-						&litMatcher{
-							pos:        position{line: 439, col: 27, offset: 11033},
-							val:        "!=",
-							ignoreCase: false,
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "RelativeExpression",
-			pos:  position{line: 441, col: 1, offset: 11071},
-			expr: &actionExpr{
-				pos: position{line: 442, col: 5, offset: 11094},
-				run: (*parser).callonRelativeExpression1,
-				expr: &seqExpr{
-					pos: position{line: 442, col: 5, offset: 11094},
-					exprs: []interface{}{
-						&labeledExpr{
-							pos:   position{line: 442, col: 5, offset: 11094},
-							label: "first",
-							expr: &ruleRefExpr{
-								pos:  position{line: 442, col: 11, offset: 11100},
-								name: "AdditiveExpression",
-							},
-						},
-						&labeledExpr{
-							pos:   position{line: 443, col: 5, offset: 11123},
-							label: "rest",
-							expr: &zeroOrMoreExpr{
-								pos: position{line: 443, col: 10, offset: 11128},
-								expr: &seqExpr{
-									pos: position{line: 443, col: 11, offset: 11129},
-									exprs: []interface{}{
-										&ruleRefExpr{
-											pos:  position{line: 443, col: 11, offset: 11129},
-											name: "__",
-										},
-										&ruleRefExpr{
-											pos:  position{line: 443, col: 14, offset: 11132},
-											name: "RelativeOperator",
+			name: "sample",
+			pos:  position{line: 392, col: 1, offset: 12598},
+			expr: &choiceExpr{
+				pos: position{line: 393, col: 5, offset: 12609},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 393, col: 5, offset: 12609},
+						run: (*parser).callonsample2,
+						expr: &seqExpr{
+							pos: position{line: 393, col: 5, offset: 12609},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 393, col: 5, offset: 12609},
+									val:        "sample",
+									ignoreCase: true,
+								},
+								&ruleRefExpr{
+									pos:  position{line: 393, col: 15, offset: 12619},
+									name: "_",
+								},
+								&labeledExpr{
+									pos:   position{line: 393, col: 17, offset: 12621},
+									label: "rate",
+									expr: &choiceExpr{
+										pos: position{line: 393, col: 23, offset: 12627},
+										alternatives: []interface{}{
+											&ruleRefExpr{
+												pos:  position{line: 393, col: 23, offset: 12627},
+												name: "double",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 393, col: 32, offset: 12636},
+												name: "unsignedInteger",
+											},
 										},
-										&ruleRefExpr{
-											pos:  position{line: 443, col: 31, offset: 11149},
-											name: "__",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 393, col: 49, offset: 12653},
+									val:        "%",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 393, col: 53, offset: 12657},
+									label: "keys",
+									expr: &zeroOrOneExpr{
+										pos: position{line: 393, col: 58, offset: 12662},
+										expr: &actionExpr{
+											pos: position{line: 393, col: 59, offset: 12663},
+											run: (*parser).callonsample13,
+											expr: &seqExpr{
+												pos: position{line: 393, col: 59, offset: 12663},
+												exprs: []interface{}{
+													&ruleRefExpr{
+														pos:  position{line: 393, col: 59, offset: 12663},
+														name: "_",
+													},
+													&labeledExpr{
+														pos:   position{line: 393, col: 61, offset: 12665},
+														label: "k",
+														expr: &ruleRefExpr{
+															pos:  position{line: 393, col: 63, offset: 12667},
+															name: "groupBy",
+														},
+													},
+												},
+											},
 										},
-										&ruleRefExpr{
-											pos:  position{line: 443, col: 34, offset: 11152},
-											name: "AdditiveExpression",
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 393, col: 91, offset: 12695},
+									label: "seed",
+									expr: &zeroOrOneExpr{
+										pos: position{line: 393, col: 96, offset: 12700},
+										expr: &ruleRefExpr{
+											pos:  position{line: 393, col: 96, offset: 12700},
+											name: "sampleSeedArg",
 										},
 									},
 								},
 							},
 						},
 					},
-				},
-			},
-		},
-		{
-			name: "RelativeOperator",
-			pos:  position{line: 447, col: 1, offset: 11235},
-			expr: &actionExpr{
-				pos: position{line: 447, col: 20, offset: 11254},
-				run: (*parser).callonRelativeOperator1,
-				expr: &choiceExpr{
-					pos: position{line: 447, col: 21, offset: 11255},
-					alternatives: []interface{}{
-						&litMatcher{
-							pos:        position{line: 447, col: 21, offset: 11255},
-							val:        "<=",
-							ignoreCase: false,
-						},
-						&litMatcher{
-							pos:        position{line: 447, col: 28, offset: 11262},
-							val:        "<",
-							ignoreCase: false,
-						},
-						&litMatcher{
-							pos:        position{line: 447, col: 34, offset: 11268},
-							val:        ">=",
-							ignoreCase: false,
-						},
-						&litMatcher{
-							pos:        position{line: 447, col: 41, offset: 11275},
-							val:        ">",
-							ignoreCase: false,
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "AdditiveExpression",
-			pos:  position{line: 449, col: 1, offset: 11312},
-			expr: &actionExpr{
-				pos: position{line: 450, col: 5, offset: 11335},
-				run: (*parser).callonAdditiveExpression1,
-				expr: &seqExpr{
-					pos: position{line: 450, col: 5, offset: 11335},
-					exprs: []interface{}{
-						&labeledExpr{
-							pos:   position{line: 450, col: 5, offset: 11335},
-							label: "first",
-							expr: &ruleRefExpr{
-								pos:  position{line: 450, col: 11, offset: 11341},
-								name: "MultiplicativeExpression",
-							},
-						},
-						&labeledExpr{
-							pos:   position{line: 451, col: 5, offset: 11370},
-							label: "rest",
-							expr: &zeroOrMoreExpr{
-								pos: position{line: 451, col: 10, offset: 11375},
-								expr: &seqExpr{
-									pos: position{line: 451, col: 11, offset: 11376},
-									exprs: []interface{}{
-										&ruleRefExpr{
-											pos:  position{line: 451, col: 11, offset: 11376},
-											name: "__",
-										},
-										&ruleRefExpr{
-											pos:  position{line: 451, col: 14, offset: 11379},
-											name: "AdditiveOperator",
-										},
-										&ruleRefExpr{
-											pos:  position{line: 451, col: 31, offset: 11396},
-											name: "__",
+					&actionExpr{
+						pos: position{line: 396, col: 5, offset: 12783},
+						run: (*parser).callonsample21,
+						expr: &seqExpr{
+							pos: position{line: 396, col: 5, offset: 12783},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 396, col: 5, offset: 12783},
+									val:        "sample",
+									ignoreCase: true,
+								},
+								&ruleRefExpr{
+									pos:  position{line: 396, col: 15, offset: 12793},
+									name: "_",
+								},
+								&labeledExpr{
+									pos:   position{line: 396, col: 17, offset: 12795},
+									label: "size",
+									expr: &ruleRefExpr{
+										pos:  position{line: 396, col: 22, offset: 12800},
+										name: "unsignedInteger",
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 396, col: 38, offset: 12816},
+									label: "keys",
+									expr: &zeroOrOneExpr{
+										pos: position{line: 396, col: 43, offset: 12821},
+										expr: &actionExpr{
+											pos: position{line: 396, col: 44, offset: 12822},
+											run: (*parser).callonsample29,
+											expr: &seqExpr{
+												pos: position{line: 396, col: 44, offset: 12822},
+												exprs: []interface{}{
+													&ruleRefExpr{
+														pos:  position{line: 396, col: 44, offset: 12822},
+														name: "_",
+													},
+													&labeledExpr{
+														pos:   position{line: 396, col: 46, offset: 12824},
+														label: "k",
+														expr: &ruleRefExpr{
+															pos:  position{line: 396, col: 48, offset: 12826},
+															name: "groupBy",
+														},
+													},
+												},
+											},
 										},
-										&ruleRefExpr{
-											pos:  position{line: 451, col: 34, offset: 11399},
-											name: "MultiplicativeExpression",
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 396, col: 76, offset: 12854},
+									label: "seed",
+									expr: &zeroOrOneExpr{
+										pos: position{line: 396, col: 81, offset: 12859},
+										expr: &ruleRefExpr{
+											pos:  position{line: 396, col: 81, offset: 12859},
+											name: "sampleSeedArg",
 										},
 									},
 								},
@@ -3354,305 +4124,264 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "AdditiveOperator",
-			pos:  position{line: 455, col: 1, offset: 11488},
+			name: "sampleSeedArg",
+			pos:  position{line: 399, col: 1, offset: 12938},
 			expr: &actionExpr{
-				pos: position{line: 455, col: 20, offset: 11507},
-				run: (*parser).callonAdditiveOperator1,
-				expr: &choiceExpr{
-					pos: position{line: 455, col: 21, offset: 11508},
-					alternatives: []interface{}{
-						&litMatcher{
-							pos:        position{line: 455, col: 21, offset: 11508},
-							val:        "+",
-							ignoreCase: false,
+				pos: position{line: 400, col: 5, offset: 12956},
+				run: (*parser).callonsampleSeedArg1,
+				expr: &seqExpr{
+					pos: position{line: 400, col: 5, offset: 12956},
+					exprs: []interface{}{
+						&ruleRefExpr{
+							pos:  position{line: 400, col: 5, offset: 12956},
+							name: "_",
 						},
 						&litMatcher{
-							pos:        position{line: 455, col: 27, offset: 11514},
-							val:        "-",
+							pos:        position{line: 400, col: 7, offset: 12958},
+							val:        "-seed",
 							ignoreCase: false,
 						},
+						&ruleRefExpr{
+							pos:  position{line: 400, col: 15, offset: 12966},
+							name: "_",
+						},
+						&labeledExpr{
+							pos:   position{line: 400, col: 17, offset: 12968},
+							label: "seed",
+							expr: &ruleRefExpr{
+								pos:  position{line: 400, col: 22, offset: 12973},
+								name: "unsignedInteger",
+							},
+						},
 					},
 				},
 			},
 		},
 		{
-			name: "MultiplicativeExpression",
-			pos:  position{line: 457, col: 1, offset: 11551},
-			expr: &actionExpr{
-				pos: position{line: 458, col: 5, offset: 11580},
-				run: (*parser).callonMultiplicativeExpression1,
-				expr: &seqExpr{
-					pos: position{line: 458, col: 5, offset: 11580},
-					exprs: []interface{}{
-						&labeledExpr{
-							pos:   position{line: 458, col: 5, offset: 11580},
-							label: "first",
-							expr: &ruleRefExpr{
-								pos:  position{line: 458, col: 11, offset: 11586},
-								name: "NotExpression",
-							},
-						},
-						&labeledExpr{
-							pos:   position{line: 459, col: 5, offset: 11604},
-							label: "rest",
-							expr: &zeroOrMoreExpr{
-								pos: position{line: 459, col: 10, offset: 11609},
-								expr: &seqExpr{
-									pos: position{line: 459, col: 11, offset: 11610},
-									exprs: []interface{}{
-										&ruleRefExpr{
-											pos:  position{line: 459, col: 11, offset: 11610},
-											name: "__",
-										},
-										&labeledExpr{
-											pos:   position{line: 459, col: 14, offset: 11613},
-											label: "op",
-											expr: &ruleRefExpr{
-												pos:  position{line: 459, col: 17, offset: 11616},
-												name: "MultiplicativeOperator",
-											},
-										},
-										&ruleRefExpr{
-											pos:  position{line: 459, col: 40, offset: 11639},
-											name: "__",
-										},
-										&labeledExpr{
-											pos:   position{line: 459, col: 43, offset: 11642},
-											label: "operand",
-											expr: &ruleRefExpr{
-												pos:  position{line: 459, col: 51, offset: 11650},
-												name: "NotExpression",
-											},
-										},
-									},
-								},
-							},
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "MultiplicativeOperator",
-			pos:  position{line: 463, col: 1, offset: 11728},
-			expr: &actionExpr{
-				pos: position{line: 463, col: 26, offset: 11753},
-				run: (*parser).callonMultiplicativeOperator1,
-				expr: &choiceExpr{
-					pos: position{line: 463, col: 27, offset: 11754},
-					alternatives: []interface{}{
-						&litMatcher{
-							pos:        position{line: 463, col: 27, offset: 11754},
-							val:        "*",
-							ignoreCase: false,
-						},
-						&litMatcher{
-							pos:        position{line: 463, col: 33, offset: 11760},
-							val:        "/",
-							ignoreCase: false,
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "NotExpression",
-			pos:  position{line: 465, col: 1, offset: 11797},
+			name: "histogram",
+			pos:  position{line: 401, col: 1, offset: 13010},
 			expr: &choiceExpr{
-				pos: position{line: 466, col: 5, offset: 11815},
+				pos: position{line: 402, col: 5, offset: 13024},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 466, col: 5, offset: 11815},
-						run: (*parser).callonNotExpression2,
+						pos: position{line: 402, col: 5, offset: 13024},
+						run: (*parser).callonhistogram2,
 						expr: &seqExpr{
-							pos: position{line: 466, col: 5, offset: 11815},
+							pos: position{line: 402, col: 5, offset: 13024},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 466, col: 5, offset: 11815},
-									val:        "!",
-									ignoreCase: false,
+									pos:        position{line: 402, col: 5, offset: 13024},
+									val:        "histogram",
+									ignoreCase: true,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 466, col: 9, offset: 11819},
-									name: "__",
+									pos:  position{line: 402, col: 18, offset: 13037},
+									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 466, col: 12, offset: 11822},
-									label: "e",
+									pos:   position{line: 402, col: 20, offset: 13039},
+									label: "field",
 									expr: &ruleRefExpr{
-										pos:  position{line: 466, col: 14, offset: 11824},
-										name: "CallExpression",
+										pos:  position{line: 402, col: 26, offset: 13045},
+										name: "fieldExpr",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 402, col: 36, offset: 13055},
+									name: "_",
+								},
+								&litMatcher{
+									pos:        position{line: 402, col: 38, offset: 13057},
+									val:        "bounds",
+									ignoreCase: true,
+								},
+								&ruleRefExpr{
+									pos:  position{line: 402, col: 48, offset: 13067},
+									name: "_",
+								},
+								&labeledExpr{
+									pos:   position{line: 402, col: 50, offset: 13069},
+									label: "bounds",
+									expr: &ruleRefExpr{
+										pos:  position{line: 402, col: 57, offset: 13076},
+										name: "histogramBounds",
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 402, col: 73, offset: 13092},
+									label: "keys",
+									expr: &zeroOrOneExpr{
+										pos: position{line: 402, col: 78, offset: 13097},
+										expr: &actionExpr{
+											pos: position{line: 402, col: 79, offset: 13098},
+											run: (*parser).callonhistogram15,
+											expr: &seqExpr{
+												pos: position{line: 402, col: 79, offset: 13098},
+												exprs: []interface{}{
+													&ruleRefExpr{
+														pos:  position{line: 402, col: 79, offset: 13098},
+														name: "_",
+													},
+													&labeledExpr{
+														pos:   position{line: 402, col: 81, offset: 13100},
+														label: "k",
+														expr: &ruleRefExpr{
+															pos:  position{line: 402, col: 83, offset: 13102},
+															name: "groupBy",
+														},
+													},
+												},
+											},
+										},
 									},
 								},
 							},
 						},
 					},
-					&ruleRefExpr{
-						pos:  position{line: 469, col: 5, offset: 11889},
-						name: "CallExpression",
-					},
-				},
-			},
-		},
-		{
-			name: "CallExpression",
-			pos:  position{line: 472, col: 1, offset: 11906},
-			expr: &choiceExpr{
-				pos: position{line: 473, col: 5, offset: 11925},
-				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 473, col: 5, offset: 11925},
-						run: (*parser).callonCallExpression2,
+						pos: position{line: 405, col: 5, offset: 13211},
+						run: (*parser).callonhistogram20,
 						expr: &seqExpr{
-							pos: position{line: 473, col: 5, offset: 11925},
+							pos: position{line: 405, col: 5, offset: 13211},
 							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 405, col: 5, offset: 13211},
+									val:        "histogram",
+									ignoreCase: true,
+								},
+								&ruleRefExpr{
+									pos:  position{line: 405, col: 18, offset: 13224},
+									name: "_",
+								},
 								&labeledExpr{
-									pos:   position{line: 473, col: 5, offset: 11925},
-									label: "fn",
+									pos:   position{line: 405, col: 20, offset: 13226},
+									label: "field",
 									expr: &ruleRefExpr{
-										pos:  position{line: 473, col: 8, offset: 11928},
-										name: "FunctionName",
+										pos:  position{line: 405, col: 26, offset: 13232},
+										name: "fieldExpr",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 473, col: 21, offset: 11941},
-									name: "__",
+									pos:  position{line: 405, col: 36, offset: 13242},
+									name: "_",
 								},
 								&litMatcher{
-									pos:        position{line: 473, col: 24, offset: 11944},
-									val:        "(",
-									ignoreCase: false,
+									pos:        position{line: 405, col: 38, offset: 13244},
+									val:        "log",
+									ignoreCase: true,
 								},
 								&labeledExpr{
-									pos:   position{line: 473, col: 28, offset: 11948},
-									label: "args",
-									expr: &ruleRefExpr{
-										pos:  position{line: 473, col: 33, offset: 11953},
-										name: "ArgumentList",
+									pos:   position{line: 405, col: 45, offset: 13251},
+									label: "keys",
+									expr: &zeroOrOneExpr{
+										pos: position{line: 405, col: 50, offset: 13256},
+										expr: &actionExpr{
+											pos: position{line: 405, col: 51, offset: 13257},
+											run: (*parser).callonhistogram30,
+											expr: &seqExpr{
+												pos: position{line: 405, col: 51, offset: 13257},
+												exprs: []interface{}{
+													&ruleRefExpr{
+														pos:  position{line: 405, col: 51, offset: 13257},
+														name: "_",
+													},
+													&labeledExpr{
+														pos:   position{line: 405, col: 53, offset: 13259},
+														label: "k",
+														expr: &ruleRefExpr{
+															pos:  position{line: 405, col: 55, offset: 13261},
+															name: "groupBy",
+														},
+													},
+												},
+											},
+										},
 									},
 								},
-								&litMatcher{
-									pos:        position{line: 473, col: 46, offset: 11966},
-									val:        ")",
-									ignoreCase: false,
-								},
-							},
-						},
-					},
-					&ruleRefExpr{
-						pos:  position{line: 476, col: 5, offset: 12029},
-						name: "DereferenceExpression",
-					},
-				},
-			},
-		},
-		{
-			name: "FunctionName",
-			pos:  position{line: 478, col: 1, offset: 12052},
-			expr: &actionExpr{
-				pos: position{line: 479, col: 5, offset: 12069},
-				run: (*parser).callonFunctionName1,
-				expr: &seqExpr{
-					pos: position{line: 479, col: 5, offset: 12069},
-					exprs: []interface{}{
-						&ruleRefExpr{
-							pos:  position{line: 479, col: 5, offset: 12069},
-							name: "FunctionNameStart",
-						},
-						&zeroOrMoreExpr{
-							pos: position{line: 479, col: 23, offset: 12087},
-							expr: &ruleRefExpr{
-								pos:  position{line: 479, col: 23, offset: 12087},
-								name: "FunctionNameRest",
 							},
 						},
 					},
-				},
-			},
-		},
-		{
-			name: "FunctionNameStart",
-			pos:  position{line: 481, col: 1, offset: 12137},
-			expr: &charClassMatcher{
-				pos:        position{line: 481, col: 21, offset: 12157},
-				val:        "[A-Za-z]",
-				ranges:     []rune{'A', 'Z', 'a', 'z'},
-				ignoreCase: false,
-				inverted:   false,
-			},
-		},
-		{
-			name: "FunctionNameRest",
-			pos:  position{line: 482, col: 1, offset: 12166},
-			expr: &choiceExpr{
-				pos: position{line: 482, col: 20, offset: 12185},
-				alternatives: []interface{}{
-					&ruleRefExpr{
-						pos:  position{line: 482, col: 20, offset: 12185},
-						name: "FunctionNameStart",
-					},
-					&charClassMatcher{
-						pos:        position{line: 482, col: 40, offset: 12205},
-						val:        "[.0-9]",
-						chars:      []rune{'.'},
-						ranges:     []rune{'0', '9'},
-						ignoreCase: false,
-						inverted:   false,
-					},
-				},
-			},
-		},
-		{
-			name: "ArgumentList",
-			pos:  position{line: 484, col: 1, offset: 12213},
-			expr: &choiceExpr{
-				pos: position{line: 485, col: 5, offset: 12230},
-				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 485, col: 5, offset: 12230},
-						run: (*parser).callonArgumentList2,
+						pos: position{line: 408, col: 5, offset: 13366},
+						run: (*parser).callonhistogram35,
 						expr: &seqExpr{
-							pos: position{line: 485, col: 5, offset: 12230},
+							pos: position{line: 408, col: 5, offset: 13366},
 							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 408, col: 5, offset: 13366},
+									val:        "histogram",
+									ignoreCase: true,
+								},
+								&ruleRefExpr{
+									pos:  position{line: 408, col: 18, offset: 13379},
+									name: "_",
+								},
 								&labeledExpr{
-									pos:   position{line: 485, col: 5, offset: 12230},
-									label: "first",
+									pos:   position{line: 408, col: 20, offset: 13381},
+									label: "field",
 									expr: &ruleRefExpr{
-										pos:  position{line: 485, col: 11, offset: 12236},
-										name: "Expression",
+										pos:  position{line: 408, col: 26, offset: 13387},
+										name: "fieldExpr",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 485, col: 22, offset: 12247},
-									label: "rest",
-									expr: &zeroOrMoreExpr{
-										pos: position{line: 485, col: 27, offset: 12252},
+									pos:   position{line: 408, col: 36, offset: 13397},
+									label: "bins",
+									expr: &zeroOrOneExpr{
+										pos: position{line: 408, col: 41, offset: 13402},
 										expr: &actionExpr{
-											pos: position{line: 485, col: 28, offset: 12253},
-											run: (*parser).callonArgumentList8,
+											pos: position{line: 408, col: 42, offset: 13403},
+											run: (*parser).callonhistogram43,
 											expr: &seqExpr{
-												pos: position{line: 485, col: 28, offset: 12253},
+												pos: position{line: 408, col: 42, offset: 13403},
 												exprs: []interface{}{
 													&ruleRefExpr{
-														pos:  position{line: 485, col: 28, offset: 12253},
-														name: "__",
+														pos:  position{line: 408, col: 42, offset: 13403},
+														name: "_",
 													},
 													&litMatcher{
-														pos:        position{line: 485, col: 31, offset: 12256},
-														val:        ",",
-														ignoreCase: false,
+														pos:        position{line: 408, col: 44, offset: 13405},
+														val:        "bins",
+														ignoreCase: true,
 													},
 													&ruleRefExpr{
-														pos:  position{line: 485, col: 35, offset: 12260},
-														name: "__",
+														pos:  position{line: 408, col: 52, offset: 13413},
+														name: "_",
 													},
 													&labeledExpr{
-														pos:   position{line: 485, col: 38, offset: 12263},
-														label: "e",
+														pos:   position{line: 408, col: 54, offset: 13415},
+														label: "n",
 														expr: &ruleRefExpr{
-															pos:  position{line: 485, col: 40, offset: 12265},
-															name: "Expression",
+															pos:  position{line: 408, col: 56, offset: 13417},
+															name: "unsignedInteger",
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 408, col: 92, offset: 13453},
+									label: "keys",
+									expr: &zeroOrOneExpr{
+										pos: position{line: 408, col: 97, offset: 13458},
+										expr: &actionExpr{
+											pos: position{line: 408, col: 98, offset: 13459},
+											run: (*parser).callonhistogram52,
+											expr: &seqExpr{
+												pos: position{line: 408, col: 98, offset: 13459},
+												exprs: []interface{}{
+													&ruleRefExpr{
+														pos:  position{line: 408, col: 98, offset: 13459},
+														name: "_",
+													},
+													&labeledExpr{
+														pos:   position{line: 408, col: 100, offset: 13461},
+														label: "k",
+														expr: &ruleRefExpr{
+															pos:  position{line: 408, col: 102, offset: 13463},
+															name: "groupBy",
 														},
 													},
 												},
@@ -3663,104 +4392,56 @@ var g = &grammar{
 							},
 						},
 					},
-					&actionExpr{
-						pos: position{line: 488, col: 5, offset: 12381},
-						run: (*parser).callonArgumentList15,
-						expr: &ruleRefExpr{
-							pos:  position{line: 488, col: 5, offset: 12381},
-							name: "__",
-						},
-					},
 				},
 			},
 		},
 		{
-			name: "DereferenceExpression",
-			pos:  position{line: 490, col: 1, offset: 12417},
+			name: "histogramBounds",
+			pos:  position{line: 411, col: 1, offset: 13566},
 			expr: &actionExpr{
-				pos: position{line: 491, col: 5, offset: 12443},
-				run: (*parser).callonDereferenceExpression1,
+				pos: position{line: 412, col: 5, offset: 13586},
+				run: (*parser).callonhistogramBounds1,
 				expr: &seqExpr{
-					pos: position{line: 491, col: 5, offset: 12443},
+					pos: position{line: 412, col: 5, offset: 13586},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 491, col: 5, offset: 12443},
-							label: "base",
+							pos:   position{line: 412, col: 5, offset: 13586},
+							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 491, col: 10, offset: 12448},
-								name: "PrimaryExpression",
+								pos:  position{line: 412, col: 11, offset: 13592},
+								name: "histogramBound",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 492, col: 5, offset: 12470},
-							label: "derefs",
+							pos:   position{line: 412, col: 26, offset: 13607},
+							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 492, col: 12, offset: 12477},
-								expr: &choiceExpr{
-									pos: position{line: 493, col: 9, offset: 12487},
-									alternatives: []interface{}{
-										&seqExpr{
-											pos: position{line: 493, col: 9, offset: 12487},
-											exprs: []interface{}{
-												&ruleRefExpr{
-													pos:  position{line: 493, col: 9, offset: 12487},
-													name: "__",
-												},
-												&litMatcher{
-													pos:        position{line: 493, col: 12, offset: 12490},
-													val:        "[",
-													ignoreCase: false,
-												},
-												&ruleRefExpr{
-													pos:  position{line: 493, col: 16, offset: 12494},
-													name: "__",
-												},
-												&labeledExpr{
-													pos:   position{line: 493, col: 19, offset: 12497},
-													label: "index",
-													expr: &ruleRefExpr{
-														pos:  position{line: 493, col: 25, offset: 12503},
-														name: "Expression",
-													},
-												},
-												&ruleRefExpr{
-													pos:  position{line: 493, col: 36, offset: 12514},
-													name: "__",
-												},
-												&litMatcher{
-													pos:        position{line: 493, col: 39, offset: 12517},
-													val:        "]",
-													ignoreCase: false,
-												},
+								pos: position{line: 412, col: 31, offset: 13612},
+								expr: &actionExpr{
+									pos: position{line: 412, col: 32, offset: 13613},
+									run: (*parser).callonhistogramBounds7,
+									expr: &seqExpr{
+										pos: position{line: 412, col: 32, offset: 13613},
+										exprs: []interface{}{
+											&ruleRefExpr{
+												pos:  position{line: 412, col: 32, offset: 13613},
+												name: "__",
 											},
-										},
-										&seqExpr{
-											pos: position{line: 494, col: 9, offset: 12529},
-											exprs: []interface{}{
-												&ruleRefExpr{
-													pos:  position{line: 494, col: 9, offset: 12529},
-													name: "__",
-												},
-												&litMatcher{
-													pos:        position{line: 494, col: 12, offset: 12532},
-													val:        ".",
-													ignoreCase: false,
-												},
-												&ruleRefExpr{
-													pos:  position{line: 494, col: 16, offset: 12536},
-													name: "__",
-												},
-												&actionExpr{
-													pos: position{line: 494, col: 20, offset: 12540},
-													run: (*parser).callonDereferenceExpression20,
-													expr: &labeledExpr{
-														pos:   position{line: 494, col: 20, offset: 12540},
-														label: "field",
-														expr: &ruleRefExpr{
-															pos:  position{line: 494, col: 26, offset: 12546},
-															name: "fieldName",
-														},
-													},
+											&litMatcher{
+												pos:        position{line: 412, col: 35, offset: 13616},
+												val:        ",",
+												ignoreCase: false,
+											},
+											&ruleRefExpr{
+												pos:  position{line: 412, col: 39, offset: 13620},
+												name: "__",
+											},
+											&labeledExpr{
+												pos:   position{line: 412, col: 42, offset: 13623},
+												label: "b",
+												expr: &ruleRefExpr{
+													pos:  position{line: 412, col: 44, offset: 13625},
+													name: "histogramBound",
 												},
 											},
 										},
@@ -3773,260 +4454,498 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "duration",
-			pos:  position{line: 499, col: 1, offset: 12681},
+			name: "histogramBound",
+			pos:  position{line: 415, col: 1, offset: 13742},
 			expr: &choiceExpr{
-				pos: position{line: 500, col: 5, offset: 12694},
+				pos: position{line: 416, col: 5, offset: 13761},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 500, col: 5, offset: 12694},
-						name: "seconds",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 501, col: 5, offset: 12706},
-						name: "minutes",
+						pos:  position{line: 416, col: 5, offset: 13761},
+						name: "double",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 502, col: 5, offset: 12718},
-						name: "hours",
+						pos:  position{line: 417, col: 5, offset: 13772},
+						name: "integer",
 					},
-					&seqExpr{
-						pos: position{line: 503, col: 5, offset: 12728},
-						exprs: []interface{}{
-							&ruleRefExpr{
-								pos:  position{line: 503, col: 5, offset: 12728},
-								name: "hours",
-							},
-							&ruleRefExpr{
-								pos:  position{line: 503, col: 11, offset: 12734},
-								name: "_",
+				},
+			},
+		},
+		{
+			name: "lookup",
+			pos:  position{line: 418, col: 1, offset: 13780},
+			expr: &actionExpr{
+				pos: position{line: 419, col: 5, offset: 13791},
+				run: (*parser).callonlookup1,
+				expr: &seqExpr{
+					pos: position{line: 419, col: 5, offset: 13791},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 419, col: 5, offset: 13791},
+							val:        "lookup",
+							ignoreCase: true,
+						},
+						&ruleRefExpr{
+							pos:  position{line: 419, col: 15, offset: 13801},
+							name: "_",
+						},
+						&litMatcher{
+							pos:        position{line: 419, col: 17, offset: 13803},
+							val:        "file",
+							ignoreCase: true,
+						},
+						&ruleRefExpr{
+							pos:  position{line: 419, col: 25, offset: 13811},
+							name: "__",
+						},
+						&litMatcher{
+							pos:        position{line: 419, col: 28, offset: 13814},
+							val:        "=",
+							ignoreCase: false,
+						},
+						&ruleRefExpr{
+							pos:  position{line: 419, col: 32, offset: 13818},
+							name: "__",
+						},
+						&labeledExpr{
+							pos:   position{line: 419, col: 35, offset: 13821},
+							label: "file",
+							expr: &choiceExpr{
+								pos: position{line: 419, col: 41, offset: 13827},
+								alternatives: []interface{}{
+									&ruleRefExpr{
+										pos:  position{line: 419, col: 41, offset: 13827},
+										name: "quotedString",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 419, col: 56, offset: 13842},
+										name: "searchWord",
+									},
+								},
 							},
-							&litMatcher{
-								pos:        position{line: 503, col: 13, offset: 12736},
-								val:        "and",
-								ignoreCase: false,
+						},
+						&ruleRefExpr{
+							pos:  position{line: 419, col: 68, offset: 13854},
+							name: "_",
+						},
+						&litMatcher{
+							pos:        position{line: 419, col: 70, offset: 13856},
+							val:        "on",
+							ignoreCase: true,
+						},
+						&ruleRefExpr{
+							pos:  position{line: 419, col: 76, offset: 13862},
+							name: "_",
+						},
+						&labeledExpr{
+							pos:   position{line: 419, col: 78, offset: 13864},
+							label: "key",
+							expr: &ruleRefExpr{
+								pos:  position{line: 419, col: 82, offset: 13868},
+								name: "fieldExpr",
 							},
-							&ruleRefExpr{
-								pos:  position{line: 503, col: 19, offset: 12742},
-								name: "_",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 419, col: 92, offset: 13878},
+							name: "__",
+						},
+						&litMatcher{
+							pos:        position{line: 419, col: 95, offset: 13881},
+							val:        "=",
+							ignoreCase: false,
+						},
+						&ruleRefExpr{
+							pos:  position{line: 419, col: 99, offset: 13885},
+							name: "__",
+						},
+						&labeledExpr{
+							pos:   position{line: 419, col: 102, offset: 13888},
+							label: "tableKey",
+							expr: &ruleRefExpr{
+								pos:  position{line: 419, col: 111, offset: 13897},
+								name: "fieldName",
 							},
-							&ruleRefExpr{
-								pos:  position{line: 503, col: 21, offset: 12744},
-								name: "minutes",
+						},
+						&labeledExpr{
+							pos:   position{line: 419, col: 121, offset: 13907},
+							label: "fields",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 419, col: 128, offset: 13914},
+								expr: &actionExpr{
+									pos: position{line: 419, col: 129, offset: 13915},
+									run: (*parser).callonlookup25,
+									expr: &seqExpr{
+										pos: position{line: 419, col: 129, offset: 13915},
+										exprs: []interface{}{
+											&ruleRefExpr{
+												pos:  position{line: 419, col: 129, offset: 13915},
+												name: "_",
+											},
+											&litMatcher{
+												pos:        position{line: 419, col: 131, offset: 13917},
+												val:        "fields",
+												ignoreCase: true,
+											},
+											&ruleRefExpr{
+												pos:  position{line: 419, col: 141, offset: 13927},
+												name: "_",
+											},
+											&labeledExpr{
+												pos:   position{line: 419, col: 143, offset: 13929},
+												label: "f",
+												expr: &ruleRefExpr{
+													pos:  position{line: 419, col: 145, offset: 13931},
+													name: "fieldNameList",
+												},
+											},
+										},
+									},
+								},
 							},
 						},
 					},
-					&ruleRefExpr{
-						pos:  position{line: 504, col: 5, offset: 12756},
-						name: "days",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 505, col: 5, offset: 12765},
-						name: "weeks",
-					},
 				},
 			},
 		},
 		{
-			name: "sec_abbrev",
-			pos:  position{line: 507, col: 1, offset: 12772},
-			expr: &choiceExpr{
-				pos: position{line: 508, col: 5, offset: 12787},
-				alternatives: []interface{}{
-					&litMatcher{
-						pos:        position{line: 508, col: 5, offset: 12787},
-						val:        "seconds",
-						ignoreCase: false,
-					},
-					&litMatcher{
-						pos:        position{line: 509, col: 5, offset: 12801},
-						val:        "second",
-						ignoreCase: false,
-					},
-					&litMatcher{
-						pos:        position{line: 510, col: 5, offset: 12814},
-						val:        "secs",
-						ignoreCase: false,
-					},
-					&litMatcher{
-						pos:        position{line: 511, col: 5, offset: 12825},
-						val:        "sec",
-						ignoreCase: false,
-					},
-					&litMatcher{
-						pos:        position{line: 512, col: 5, offset: 12835},
-						val:        "s",
-						ignoreCase: false,
+			name: "intel",
+			pos:  position{line: 422, col: 1, offset: 14035},
+			expr: &actionExpr{
+				pos: position{line: 423, col: 5, offset: 14045},
+				run: (*parser).callonintel1,
+				expr: &seqExpr{
+					pos: position{line: 423, col: 5, offset: 14045},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 423, col: 5, offset: 14045},
+							val:        "intel",
+							ignoreCase: true,
+						},
+						&ruleRefExpr{
+							pos:  position{line: 423, col: 14, offset: 14054},
+							name: "_",
+						},
+						&litMatcher{
+							pos:        position{line: 423, col: 16, offset: 14056},
+							val:        "file",
+							ignoreCase: true,
+						},
+						&ruleRefExpr{
+							pos:  position{line: 423, col: 24, offset: 14064},
+							name: "__",
+						},
+						&litMatcher{
+							pos:        position{line: 423, col: 27, offset: 14067},
+							val:        "=",
+							ignoreCase: false,
+						},
+						&ruleRefExpr{
+							pos:  position{line: 423, col: 31, offset: 14071},
+							name: "__",
+						},
+						&labeledExpr{
+							pos:   position{line: 423, col: 34, offset: 14074},
+							label: "first",
+							expr: &ruleRefExpr{
+								pos:  position{line: 423, col: 40, offset: 14080},
+								name: "intelFile",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 423, col: 50, offset: 14090},
+							label: "rest",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 423, col: 55, offset: 14095},
+								expr: &actionExpr{
+									pos: position{line: 423, col: 56, offset: 14096},
+									run: (*parser).callonintel13,
+									expr: &seqExpr{
+										pos: position{line: 423, col: 56, offset: 14096},
+										exprs: []interface{}{
+											&ruleRefExpr{
+												pos:  position{line: 423, col: 56, offset: 14096},
+												name: "__",
+											},
+											&litMatcher{
+												pos:        position{line: 423, col: 59, offset: 14099},
+												val:        ",",
+												ignoreCase: false,
+											},
+											&ruleRefExpr{
+												pos:  position{line: 423, col: 63, offset: 14103},
+												name: "__",
+											},
+											&labeledExpr{
+												pos:   position{line: 423, col: 66, offset: 14106},
+												label: "f",
+												expr: &ruleRefExpr{
+													pos:  position{line: 423, col: 68, offset: 14108},
+													name: "intelFile",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
 					},
 				},
 			},
 		},
 		{
-			name: "min_abbrev",
-			pos:  position{line: 514, col: 1, offset: 12840},
+			name: "intelFile",
+			pos:  position{line: 426, col: 1, offset: 14235},
 			expr: &choiceExpr{
-				pos: position{line: 515, col: 5, offset: 12855},
+				pos: position{line: 427, col: 5, offset: 14249},
 				alternatives: []interface{}{
-					&litMatcher{
-						pos:        position{line: 515, col: 5, offset: 12855},
-						val:        "minutes",
-						ignoreCase: false,
-					},
-					&litMatcher{
-						pos:        position{line: 516, col: 5, offset: 12869},
-						val:        "minute",
-						ignoreCase: false,
-					},
-					&litMatcher{
-						pos:        position{line: 517, col: 5, offset: 12882},
-						val:        "mins",
-						ignoreCase: false,
-					},
-					&litMatcher{
-						pos:        position{line: 518, col: 5, offset: 12893},
-						val:        "min",
-						ignoreCase: false,
+					&ruleRefExpr{
+						pos:  position{line: 427, col: 5, offset: 14249},
+						name: "quotedString",
 					},
-					&litMatcher{
-						pos:        position{line: 519, col: 5, offset: 12903},
-						val:        "m",
-						ignoreCase: false,
+					&ruleRefExpr{
+						pos:  position{line: 428, col: 5, offset: 14266},
+						name: "searchWord",
 					},
 				},
 			},
 		},
 		{
-			name: "hour_abbrev",
-			pos:  position{line: 521, col: 1, offset: 12908},
-			expr: &choiceExpr{
-				pos: position{line: 522, col: 5, offset: 12924},
-				alternatives: []interface{}{
-					&litMatcher{
-						pos:        position{line: 522, col: 5, offset: 12924},
-						val:        "hours",
-						ignoreCase: false,
-					},
-					&litMatcher{
-						pos:        position{line: 523, col: 5, offset: 12936},
-						val:        "hrs",
-						ignoreCase: false,
-					},
-					&litMatcher{
-						pos:        position{line: 524, col: 5, offset: 12946},
-						val:        "hr",
-						ignoreCase: false,
-					},
-					&litMatcher{
-						pos:        position{line: 525, col: 5, offset: 12955},
-						val:        "h",
-						ignoreCase: false,
-					},
-					&litMatcher{
-						pos:        position{line: 526, col: 5, offset: 12963},
-						val:        "hour",
-						ignoreCase: false,
+			name: "parse",
+			pos:  position{line: 429, col: 1, offset: 14277},
+			expr: &actionExpr{
+				pos: position{line: 430, col: 5, offset: 14287},
+				run: (*parser).callonparse1,
+				expr: &seqExpr{
+					pos: position{line: 430, col: 5, offset: 14287},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 430, col: 5, offset: 14287},
+							val:        "parse",
+							ignoreCase: true,
+						},
+						&ruleRefExpr{
+							pos:  position{line: 430, col: 14, offset: 14296},
+							name: "_",
+						},
+						&labeledExpr{
+							pos:   position{line: 430, col: 16, offset: 14298},
+							label: "field",
+							expr: &ruleRefExpr{
+								pos:  position{line: 430, col: 22, offset: 14304},
+								name: "fieldExpr",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 430, col: 32, offset: 14314},
+							name: "_",
+						},
+						&litMatcher{
+							pos:        position{line: 430, col: 34, offset: 14316},
+							val:        "with",
+							ignoreCase: true,
+						},
+						&ruleRefExpr{
+							pos:  position{line: 430, col: 42, offset: 14324},
+							name: "_",
+						},
+						&labeledExpr{
+							pos:   position{line: 430, col: 44, offset: 14326},
+							label: "pattern",
+							expr: &ruleRefExpr{
+								pos:  position{line: 430, col: 52, offset: 14334},
+								name: "quotedString",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 430, col: 65, offset: 14347},
+							label: "patternFile",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 430, col: 77, offset: 14359},
+								expr: &actionExpr{
+									pos: position{line: 430, col: 78, offset: 14360},
+									run: (*parser).callonparse14,
+									expr: &seqExpr{
+										pos: position{line: 430, col: 78, offset: 14360},
+										exprs: []interface{}{
+											&ruleRefExpr{
+												pos:  position{line: 430, col: 78, offset: 14360},
+												name: "_",
+											},
+											&litMatcher{
+												pos:        position{line: 430, col: 80, offset: 14362},
+												val:        "-patterns",
+												ignoreCase: false,
+											},
+											&ruleRefExpr{
+												pos:  position{line: 430, col: 92, offset: 14374},
+												name: "_",
+											},
+											&labeledExpr{
+												pos:   position{line: 430, col: 94, offset: 14376},
+												label: "f",
+												expr: &choiceExpr{
+													pos: position{line: 430, col: 97, offset: 14379},
+													alternatives: []interface{}{
+														&ruleRefExpr{
+															pos:  position{line: 430, col: 97, offset: 14379},
+															name: "quotedString",
+														},
+														&ruleRefExpr{
+															pos:  position{line: 430, col: 112, offset: 14394},
+															name: "searchWord",
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 430, col: 144, offset: 14426},
+							label: "warn",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 430, col: 149, offset: 14431},
+								expr: &seqExpr{
+									pos: position{line: 430, col: 150, offset: 14432},
+									exprs: []interface{}{
+										&ruleRefExpr{
+											pos:  position{line: 430, col: 150, offset: 14432},
+											name: "_",
+										},
+										&litMatcher{
+											pos:        position{line: 430, col: 152, offset: 14434},
+											val:        "-warn",
+											ignoreCase: false,
+										},
+									},
+								},
+							},
+						},
 					},
 				},
 			},
 		},
 		{
-			name: "day_abbrev",
-			pos:  position{line: 528, col: 1, offset: 12971},
-			expr: &choiceExpr{
-				pos: position{line: 528, col: 14, offset: 12984},
-				alternatives: []interface{}{
-					&litMatcher{
-						pos:        position{line: 528, col: 14, offset: 12984},
-						val:        "days",
-						ignoreCase: false,
-					},
-					&litMatcher{
-						pos:        position{line: 528, col: 21, offset: 12991},
-						val:        "day",
-						ignoreCase: false,
-					},
-					&litMatcher{
-						pos:        position{line: 528, col: 27, offset: 12997},
-						val:        "d",
-						ignoreCase: false,
+			name: "unnest",
+			pos:  position{line: 433, col: 1, offset: 14519},
+			expr: &actionExpr{
+				pos: position{line: 434, col: 5, offset: 14530},
+				run: (*parser).callonunnest1,
+				expr: &seqExpr{
+					pos: position{line: 434, col: 5, offset: 14530},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 434, col: 5, offset: 14530},
+							val:        "unnest",
+							ignoreCase: true,
+						},
+						&ruleRefExpr{
+							pos:  position{line: 434, col: 15, offset: 14540},
+							name: "_",
+						},
+						&labeledExpr{
+							pos:   position{line: 434, col: 17, offset: 14542},
+							label: "field",
+							expr: &ruleRefExpr{
+								pos:  position{line: 434, col: 23, offset: 14548},
+								name: "fieldName",
+							},
+						},
 					},
 				},
 			},
 		},
 		{
-			name: "week_abbrev",
-			pos:  position{line: 529, col: 1, offset: 13001},
-			expr: &choiceExpr{
-				pos: position{line: 529, col: 15, offset: 13015},
-				alternatives: []interface{}{
-					&litMatcher{
-						pos:        position{line: 529, col: 15, offset: 13015},
-						val:        "weeks",
-						ignoreCase: false,
-					},
-					&litMatcher{
-						pos:        position{line: 529, col: 23, offset: 13023},
-						val:        "week",
-						ignoreCase: false,
-					},
-					&litMatcher{
-						pos:        position{line: 529, col: 30, offset: 13030},
-						val:        "wks",
-						ignoreCase: false,
-					},
-					&litMatcher{
-						pos:        position{line: 529, col: 36, offset: 13036},
-						val:        "wk",
-						ignoreCase: false,
-					},
-					&litMatcher{
-						pos:        position{line: 529, col: 41, offset: 13041},
-						val:        "w",
-						ignoreCase: false,
+			name: "pass",
+			pos:  position{line: 435, col: 1, offset: 14596},
+			expr: &actionExpr{
+				pos: position{line: 436, col: 5, offset: 14605},
+				run: (*parser).callonpass1,
+				expr: &seqExpr{
+					pos: position{line: 436, col: 5, offset: 14605},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 436, col: 5, offset: 14605},
+							val:        "pass",
+							ignoreCase: true,
+						},
+						&andExpr{
+							pos: position{line: 436, col: 13, offset: 14613},
+							expr: &seqExpr{
+								pos: position{line: 436, col: 15, offset: 14615},
+								exprs: []interface{}{
+									&ruleRefExpr{
+										pos:  position{line: 436, col: 15, offset: 14615},
+										name: "__",
+									},
+									&choiceExpr{
+										pos: position{line: 436, col: 19, offset: 14619},
+										alternatives: []interface{}{
+											&litMatcher{
+												pos:        position{line: 436, col: 19, offset: 14619},
+												val:        "|",
+												ignoreCase: false,
+											},
+											&litMatcher{
+												pos:        position{line: 436, col: 25, offset: 14625},
+												val:        ";",
+												ignoreCase: false,
+											},
+											&litMatcher{
+												pos:        position{line: 436, col: 31, offset: 14631},
+												val:        ")",
+												ignoreCase: false,
+											},
+											&ruleRefExpr{
+												pos:  position{line: 436, col: 37, offset: 14637},
+												name: "EOF",
+											},
+										},
+									},
+								},
+							},
+						},
 					},
 				},
 			},
 		},
 		{
-			name: "seconds",
-			pos:  position{line: 531, col: 1, offset: 13046},
-			expr: &choiceExpr{
-				pos: position{line: 532, col: 5, offset: 13058},
-				alternatives: []interface{}{
-					&actionExpr{
-						pos: position{line: 532, col: 5, offset: 13058},
-						run: (*parser).callonseconds2,
-						expr: &litMatcher{
-							pos:        position{line: 532, col: 5, offset: 13058},
-							val:        "second",
+			name: "assignment",
+			pos:  position{line: 437, col: 1, offset: 14674},
+			expr: &actionExpr{
+				pos: position{line: 438, col: 5, offset: 14689},
+				run: (*parser).callonassignment1,
+				expr: &seqExpr{
+					pos: position{line: 438, col: 5, offset: 14689},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 438, col: 5, offset: 14689},
+							label: "f",
+							expr: &ruleRefExpr{
+								pos:  position{line: 438, col: 7, offset: 14691},
+								name: "fieldName",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 438, col: 17, offset: 14701},
+							name: "__",
+						},
+						&litMatcher{
+							pos:        position{line: 438, col: 20, offset: 14704},
+							val:        "=",
 							ignoreCase: false,
 						},
-					},
-					&actionExpr{
-						pos: position{line: 533, col: 5, offset: 13103},
-						run: (*parser).callonseconds4,
-						expr: &seqExpr{
-							pos: position{line: 533, col: 5, offset: 13103},
-							exprs: []interface{}{
-								&labeledExpr{
-									pos:   position{line: 533, col: 5, offset: 13103},
-									label: "num",
-									expr: &ruleRefExpr{
-										pos:  position{line: 533, col: 9, offset: 13107},
-										name: "number",
-									},
-								},
-								&zeroOrOneExpr{
-									pos: position{line: 533, col: 16, offset: 13114},
-									expr: &ruleRefExpr{
-										pos:  position{line: 533, col: 16, offset: 13114},
-										name: "_",
-									},
-								},
-								&ruleRefExpr{
-									pos:  position{line: 533, col: 19, offset: 13117},
-									name: "sec_abbrev",
-								},
+						&ruleRefExpr{
+							pos:  position{line: 438, col: 24, offset: 14708},
+							name: "__",
+						},
+						&labeledExpr{
+							pos:   position{line: 438, col: 27, offset: 14711},
+							label: "e",
+							expr: &ruleRefExpr{
+								pos:  position{line: 438, col: 29, offset: 14713},
+								name: "Expression",
 							},
 						},
 					},
@@ -4034,45 +4953,57 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "minutes",
-			pos:  position{line: 535, col: 1, offset: 13163},
-			expr: &choiceExpr{
-				pos: position{line: 536, col: 5, offset: 13175},
-				alternatives: []interface{}{
-					&actionExpr{
-						pos: position{line: 536, col: 5, offset: 13175},
-						run: (*parser).callonminutes2,
-						expr: &litMatcher{
-							pos:        position{line: 536, col: 5, offset: 13175},
-							val:        "minute",
-							ignoreCase: false,
+			name: "assignmentList",
+			pos:  position{line: 439, col: 1, offset: 14761},
+			expr: &actionExpr{
+				pos: position{line: 440, col: 5, offset: 14780},
+				run: (*parser).callonassignmentList1,
+				expr: &seqExpr{
+					pos: position{line: 440, col: 5, offset: 14780},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 440, col: 5, offset: 14780},
+							label: "first",
+							expr: &ruleRefExpr{
+								pos:  position{line: 440, col: 11, offset: 14786},
+								name: "assignment",
+							},
 						},
-					},
-					&actionExpr{
-						pos: position{line: 537, col: 5, offset: 13221},
-						run: (*parser).callonminutes4,
-						expr: &seqExpr{
-							pos: position{line: 537, col: 5, offset: 13221},
-							exprs: []interface{}{
-								&labeledExpr{
-									pos:   position{line: 537, col: 5, offset: 13221},
-									label: "num",
-									expr: &ruleRefExpr{
-										pos:  position{line: 537, col: 9, offset: 13225},
-										name: "number",
-									},
-								},
-								&zeroOrOneExpr{
-									pos: position{line: 537, col: 16, offset: 13232},
-									expr: &ruleRefExpr{
-										pos:  position{line: 537, col: 16, offset: 13232},
-										name: "_",
+						&labeledExpr{
+							pos:   position{line: 440, col: 22, offset: 14797},
+							label: "rest",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 440, col: 27, offset: 14802},
+								expr: &actionExpr{
+									pos: position{line: 440, col: 28, offset: 14803},
+									run: (*parser).callonassignmentList7,
+									expr: &seqExpr{
+										pos: position{line: 440, col: 28, offset: 14803},
+										exprs: []interface{}{
+											&ruleRefExpr{
+												pos:  position{line: 440, col: 28, offset: 14803},
+												name: "__",
+											},
+											&litMatcher{
+												pos:        position{line: 440, col: 31, offset: 14806},
+												val:        ",",
+												ignoreCase: false,
+											},
+											&ruleRefExpr{
+												pos:  position{line: 440, col: 35, offset: 14810},
+												name: "__",
+											},
+											&labeledExpr{
+												pos:   position{line: 440, col: 38, offset: 14813},
+												label: "a",
+												expr: &ruleRefExpr{
+													pos:  position{line: 440, col: 40, offset: 14815},
+													name: "assignment",
+												},
+											},
+										},
 									},
 								},
-								&ruleRefExpr{
-									pos:  position{line: 537, col: 19, offset: 13235},
-									name: "min_abbrev",
-								},
 							},
 						},
 					},
@@ -4080,44 +5011,86 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "hours",
-			pos:  position{line: 539, col: 1, offset: 13290},
+			name: "PrimaryExpression",
+			pos:  position{line: 443, col: 1, offset: 14928},
 			expr: &choiceExpr{
-				pos: position{line: 540, col: 5, offset: 13300},
+				pos: position{line: 444, col: 5, offset: 14950},
 				alternatives: []interface{}{
-					&actionExpr{
-						pos: position{line: 540, col: 5, offset: 13300},
-						run: (*parser).callonhours2,
-						expr: &litMatcher{
-							pos:        position{line: 540, col: 5, offset: 13300},
-							val:        "hour",
-							ignoreCase: false,
-						},
+					&ruleRefExpr{
+						pos:  position{line: 444, col: 5, offset: 14950},
+						name: "ParamLiteral",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 445, col: 5, offset: 14967},
+						name: "StringLiteral",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 446, col: 5, offset: 14985},
+						name: "RegexpLiteral",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 447, col: 5, offset: 15003},
+						name: "PortLiteral",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 448, col: 5, offset: 15019},
+						name: "SubnetLiteral",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 449, col: 5, offset: 15037},
+						name: "AddressLiteral",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 450, col: 5, offset: 15056},
+						name: "FloatLiteral",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 451, col: 5, offset: 15073},
+						name: "IntegerLiteral",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 452, col: 5, offset: 15092},
+						name: "BooleanLiteral",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 453, col: 5, offset: 15111},
+						name: "NullLiteral",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 454, col: 5, offset: 15127},
+						name: "FieldReference",
 					},
 					&actionExpr{
-						pos: position{line: 541, col: 5, offset: 13346},
-						run: (*parser).callonhours4,
+						pos: position{line: 455, col: 5, offset: 15146},
+						run: (*parser).callonPrimaryExpression13,
 						expr: &seqExpr{
-							pos: position{line: 541, col: 5, offset: 13346},
+							pos: position{line: 455, col: 5, offset: 15146},
 							exprs: []interface{}{
-								&labeledExpr{
-									pos:   position{line: 541, col: 5, offset: 13346},
-									label: "num",
-									expr: &ruleRefExpr{
-										pos:  position{line: 541, col: 9, offset: 13350},
-										name: "number",
-									},
+								&litMatcher{
+									pos:        position{line: 455, col: 5, offset: 15146},
+									val:        "(",
+									ignoreCase: false,
 								},
-								&zeroOrOneExpr{
-									pos: position{line: 541, col: 16, offset: 13357},
+								&ruleRefExpr{
+									pos:  position{line: 455, col: 9, offset: 15150},
+									name: "__",
+								},
+								&labeledExpr{
+									pos:   position{line: 455, col: 12, offset: 15153},
+									label: "expr",
 									expr: &ruleRefExpr{
-										pos:  position{line: 541, col: 16, offset: 13357},
-										name: "_",
+										pos:  position{line: 455, col: 17, offset: 15158},
+										name: "Expression",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 541, col: 19, offset: 13360},
-									name: "hour_abbrev",
+									pos:  position{line: 455, col: 28, offset: 15169},
+									name: "__",
+								},
+								&litMatcher{
+									pos:        position{line: 455, col: 31, offset: 15172},
+									val:        ")",
+									ignoreCase: false,
 								},
 							},
 						},
@@ -4126,45 +5099,72 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "days",
-			pos:  position{line: 543, col: 1, offset: 13418},
-			expr: &choiceExpr{
-				pos: position{line: 544, col: 5, offset: 13427},
-				alternatives: []interface{}{
-					&actionExpr{
-						pos: position{line: 544, col: 5, offset: 13427},
-						run: (*parser).callondays2,
-						expr: &litMatcher{
-							pos:        position{line: 544, col: 5, offset: 13427},
-							val:        "day",
-							ignoreCase: false,
-						},
+			name: "FieldReference",
+			pos:  position{line: 456, col: 1, offset: 15197},
+			expr: &actionExpr{
+				pos: position{line: 457, col: 5, offset: 15216},
+				run: (*parser).callonFieldReference1,
+				expr: &labeledExpr{
+					pos:   position{line: 457, col: 5, offset: 15216},
+					label: "f",
+					expr: &ruleRefExpr{
+						pos:  position{line: 457, col: 7, offset: 15218},
+						name: "fieldName",
 					},
-					&actionExpr{
-						pos: position{line: 545, col: 5, offset: 13475},
-						run: (*parser).callondays4,
-						expr: &seqExpr{
-							pos: position{line: 545, col: 5, offset: 13475},
-							exprs: []interface{}{
-								&labeledExpr{
-									pos:   position{line: 545, col: 5, offset: 13475},
-									label: "num",
-									expr: &ruleRefExpr{
-										pos:  position{line: 545, col: 9, offset: 13479},
-										name: "number",
-									},
-								},
-								&zeroOrOneExpr{
-									pos: position{line: 545, col: 16, offset: 13486},
-									expr: &ruleRefExpr{
-										pos:  position{line: 545, col: 16, offset: 13486},
-										name: "_",
+				},
+			},
+		},
+		{
+			name: "Expression",
+			pos:  position{line: 460, col: 1, offset: 15290},
+			expr: &ruleRefExpr{
+				pos:  position{line: 460, col: 14, offset: 15303},
+				name: "LogicalORExpression",
+			},
+		},
+		{
+			name: "LogicalORExpression",
+			pos:  position{line: 461, col: 1, offset: 15323},
+			expr: &actionExpr{
+				pos: position{line: 462, col: 5, offset: 15347},
+				run: (*parser).callonLogicalORExpression1,
+				expr: &seqExpr{
+					pos: position{line: 462, col: 5, offset: 15347},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 462, col: 5, offset: 15347},
+							label: "first",
+							expr: &ruleRefExpr{
+								pos:  position{line: 462, col: 11, offset: 15353},
+								name: "LogicalANDExpression",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 463, col: 5, offset: 15378},
+							label: "rest",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 463, col: 10, offset: 15383},
+								expr: &seqExpr{
+									pos: position{line: 463, col: 11, offset: 15384},
+									exprs: []interface{}{
+										&ruleRefExpr{
+											pos:  position{line: 463, col: 11, offset: 15384},
+											name: "__",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 463, col: 14, offset: 15387},
+											name: "orToken",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 463, col: 22, offset: 15395},
+											name: "__",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 463, col: 25, offset: 15398},
+											name: "LogicalANDExpression",
+										},
 									},
 								},
-								&ruleRefExpr{
-									pos:  position{line: 545, col: 19, offset: 13489},
-									name: "day_abbrev",
-								},
 							},
 						},
 					},
@@ -4172,87 +5172,97 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "weeks",
-			pos:  position{line: 547, col: 1, offset: 13549},
+			name: "LogicalANDExpression",
+			pos:  position{line: 466, col: 1, offset: 15482},
 			expr: &actionExpr{
-				pos: position{line: 548, col: 5, offset: 13559},
-				run: (*parser).callonweeks1,
+				pos: position{line: 467, col: 5, offset: 15507},
+				run: (*parser).callonLogicalANDExpression1,
 				expr: &seqExpr{
-					pos: position{line: 548, col: 5, offset: 13559},
+					pos: position{line: 467, col: 5, offset: 15507},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 548, col: 5, offset: 13559},
-							label: "num",
+							pos:   position{line: 467, col: 5, offset: 15507},
+							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 548, col: 9, offset: 13563},
-								name: "number",
+								pos:  position{line: 467, col: 11, offset: 15513},
+								name: "EqualityCompareExpression",
 							},
 						},
-						&zeroOrOneExpr{
-							pos: position{line: 548, col: 16, offset: 13570},
-							expr: &ruleRefExpr{
-								pos:  position{line: 548, col: 16, offset: 13570},
-								name: "_",
+						&labeledExpr{
+							pos:   position{line: 468, col: 5, offset: 15543},
+							label: "rest",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 468, col: 10, offset: 15548},
+								expr: &seqExpr{
+									pos: position{line: 468, col: 11, offset: 15549},
+									exprs: []interface{}{
+										&ruleRefExpr{
+											pos:  position{line: 468, col: 11, offset: 15549},
+											name: "__",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 468, col: 14, offset: 15552},
+											name: "andToken",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 468, col: 23, offset: 15561},
+											name: "__",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 468, col: 26, offset: 15564},
+											name: "EqualityCompareExpression",
+										},
+									},
+								},
 							},
 						},
-						&ruleRefExpr{
-							pos:  position{line: 548, col: 19, offset: 13573},
-							name: "week_abbrev",
-						},
 					},
 				},
 			},
 		},
 		{
-			name: "number",
-			pos:  position{line: 550, col: 1, offset: 13636},
-			expr: &ruleRefExpr{
-				pos:  position{line: 550, col: 10, offset: 13645},
-				name: "unsignedInteger",
-			},
-		},
-		{
-			name: "addr",
-			pos:  position{line: 554, col: 1, offset: 13691},
+			name: "EqualityCompareExpression",
+			pos:  position{line: 471, col: 1, offset: 15653},
 			expr: &actionExpr{
-				pos: position{line: 555, col: 5, offset: 13700},
-				run: (*parser).callonaddr1,
-				expr: &labeledExpr{
-					pos:   position{line: 555, col: 5, offset: 13700},
-					label: "a",
-					expr: &seqExpr{
-						pos: position{line: 555, col: 8, offset: 13703},
-						exprs: []interface{}{
-							&ruleRefExpr{
-								pos:  position{line: 555, col: 8, offset: 13703},
-								name: "unsignedInteger",
-							},
-							&litMatcher{
-								pos:        position{line: 555, col: 24, offset: 13719},
-								val:        ".",
-								ignoreCase: false,
-							},
-							&ruleRefExpr{
-								pos:  position{line: 555, col: 28, offset: 13723},
-								name: "unsignedInteger",
-							},
-							&litMatcher{
-								pos:        position{line: 555, col: 44, offset: 13739},
-								val:        ".",
-								ignoreCase: false,
-							},
-							&ruleRefExpr{
-								pos:  position{line: 555, col: 48, offset: 13743},
-								name: "unsignedInteger",
-							},
-							&litMatcher{
-								pos:        position{line: 555, col: 64, offset: 13759},
-								val:        ".",
-								ignoreCase: false,
+				pos: position{line: 472, col: 5, offset: 15683},
+				run: (*parser).callonEqualityCompareExpression1,
+				expr: &seqExpr{
+					pos: position{line: 472, col: 5, offset: 15683},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 472, col: 5, offset: 15683},
+							label: "first",
+							expr: &ruleRefExpr{
+								pos:  position{line: 472, col: 11, offset: 15689},
+								name: "RelativeExpression",
 							},
-							&ruleRefExpr{
-								pos:  position{line: 555, col: 68, offset: 13763},
-								name: "unsignedInteger",
+						},
+						&labeledExpr{
+							pos:   position{line: 473, col: 5, offset: 15712},
+							label: "rest",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 473, col: 10, offset: 15717},
+								expr: &seqExpr{
+									pos: position{line: 473, col: 11, offset: 15718},
+									exprs: []interface{}{
+										&ruleRefExpr{
+											pos:  position{line: 473, col: 11, offset: 15718},
+											name: "__",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 473, col: 14, offset: 15721},
+											name: "EqualityOperator",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 473, col: 31, offset: 15738},
+											name: "__",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 473, col: 34, offset: 15741},
+											name: "RelativeExpression",
+										},
+									},
+								},
 							},
 						},
 					},
@@ -4260,189 +5270,104 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "port",
-			pos:  position{line: 557, col: 1, offset: 13812},
+			name: "EqualityOperator",
+			pos:  position{line: 476, col: 1, offset: 15823},
 			expr: &actionExpr{
-				pos: position{line: 558, col: 5, offset: 13821},
-				run: (*parser).callonport1,
-				expr: &seqExpr{
-					pos: position{line: 558, col: 5, offset: 13821},
-					exprs: []interface{}{
+				pos: position{line: 476, col: 20, offset: 15842},
+				run: (*parser).callonEqualityOperator1,
+				expr: &choiceExpr{
+					pos: position{line: 476, col: 21, offset: 15843},
+					alternatives: []interface{}{
 						&litMatcher{
-							pos:        position{line: 558, col: 5, offset: 13821},
-							val:        ":",
+							pos:        position{line: 476, col: 21, offset: 15843},
+							val:        "=",
 							ignoreCase: false,
 						},
-						&labeledExpr{
-							pos:   position{line: 558, col: 9, offset: 13825},
-							label: "v",
-							expr: &ruleRefExpr{
-								pos:  position{line: 558, col: 11, offset: 13827},
-								name: "suint",
-							},
+						&litMatcher{
+							pos:        position{line: 476, col: 27, offset: 15849},
+							val:        "!=",
+							ignoreCase: false,
 						},
 					},
 				},
 			},
 		},
 		{
-			name: "ip6addr",
-			pos:  position{line: 562, col: 1, offset: 13983},
-			expr: &choiceExpr{
-				pos: position{line: 563, col: 5, offset: 13995},
-				alternatives: []interface{}{
-					&actionExpr{
-						pos: position{line: 563, col: 5, offset: 13995},
-						run: (*parser).callonip6addr2,
-						expr: &seqExpr{
-							pos: position{line: 563, col: 5, offset: 13995},
-							exprs: []interface{}{
-								&labeledExpr{
-									pos:   position{line: 563, col: 5, offset: 13995},
-									label: "a",
-									expr: &oneOrMoreExpr{
-										pos: position{line: 563, col: 7, offset: 13997},
-										expr: &ruleRefExpr{
-											pos:  position{line: 563, col: 8, offset: 13998},
-											name: "h_prepend",
-										},
-									},
-								},
-								&labeledExpr{
-									pos:   position{line: 563, col: 20, offset: 14010},
-									label: "b",
-									expr: &ruleRefExpr{
-										pos:  position{line: 563, col: 22, offset: 14012},
-										name: "ip6tail",
-									},
-								},
+			name: "RelativeExpression",
+			pos:  position{line: 477, col: 1, offset: 15886},
+			expr: &actionExpr{
+				pos: position{line: 478, col: 5, offset: 15909},
+				run: (*parser).callonRelativeExpression1,
+				expr: &seqExpr{
+					pos: position{line: 478, col: 5, offset: 15909},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 478, col: 5, offset: 15909},
+							label: "first",
+							expr: &ruleRefExpr{
+								pos:  position{line: 478, col: 11, offset: 15915},
+								name: "AdditiveExpression",
 							},
 						},
-					},
-					&actionExpr{
-						pos: position{line: 566, col: 5, offset: 14076},
-						run: (*parser).callonip6addr9,
-						expr: &seqExpr{
-							pos: position{line: 566, col: 5, offset: 14076},
-							exprs: []interface{}{
-								&labeledExpr{
-									pos:   position{line: 566, col: 5, offset: 14076},
-									label: "a",
-									expr: &ruleRefExpr{
-										pos:  position{line: 566, col: 7, offset: 14078},
-										name: "h16",
-									},
-								},
-								&labeledExpr{
-									pos:   position{line: 566, col: 11, offset: 14082},
-									label: "b",
-									expr: &zeroOrMoreExpr{
-										pos: position{line: 566, col: 13, offset: 14084},
-										expr: &ruleRefExpr{
-											pos:  position{line: 566, col: 14, offset: 14085},
-											name: "h_append",
+						&labeledExpr{
+							pos:   position{line: 479, col: 5, offset: 15938},
+							label: "rest",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 479, col: 10, offset: 15943},
+								expr: &seqExpr{
+									pos: position{line: 479, col: 11, offset: 15944},
+									exprs: []interface{}{
+										&ruleRefExpr{
+											pos:  position{line: 479, col: 11, offset: 15944},
+											name: "__",
 										},
-									},
-								},
-								&litMatcher{
-									pos:        position{line: 566, col: 25, offset: 14096},
-									val:        "::",
-									ignoreCase: false,
-								},
-								&labeledExpr{
-									pos:   position{line: 566, col: 30, offset: 14101},
-									label: "d",
-									expr: &zeroOrMoreExpr{
-										pos: position{line: 566, col: 32, offset: 14103},
-										expr: &ruleRefExpr{
-											pos:  position{line: 566, col: 33, offset: 14104},
-											name: "h_prepend",
+										&ruleRefExpr{
+											pos:  position{line: 479, col: 14, offset: 15947},
+											name: "RelativeOperator",
 										},
-									},
-								},
-								&labeledExpr{
-									pos:   position{line: 566, col: 45, offset: 14116},
-									label: "e",
-									expr: &ruleRefExpr{
-										pos:  position{line: 566, col: 47, offset: 14118},
-										name: "ip6tail",
-									},
-								},
-							},
-						},
-					},
-					&actionExpr{
-						pos: position{line: 569, col: 5, offset: 14217},
-						run: (*parser).callonip6addr22,
-						expr: &seqExpr{
-							pos: position{line: 569, col: 5, offset: 14217},
-							exprs: []interface{}{
-								&litMatcher{
-									pos:        position{line: 569, col: 5, offset: 14217},
-									val:        "::",
-									ignoreCase: false,
-								},
-								&labeledExpr{
-									pos:   position{line: 569, col: 10, offset: 14222},
-									label: "a",
-									expr: &zeroOrMoreExpr{
-										pos: position{line: 569, col: 12, offset: 14224},
-										expr: &ruleRefExpr{
-											pos:  position{line: 569, col: 13, offset: 14225},
-											name: "h_prepend",
+										&ruleRefExpr{
+											pos:  position{line: 479, col: 31, offset: 15964},
+											name: "__",
 										},
-									},
-								},
-								&labeledExpr{
-									pos:   position{line: 569, col: 25, offset: 14237},
-									label: "b",
-									expr: &ruleRefExpr{
-										pos:  position{line: 569, col: 27, offset: 14239},
-										name: "ip6tail",
-									},
-								},
-							},
-						},
-					},
-					&actionExpr{
-						pos: position{line: 572, col: 5, offset: 14310},
-						run: (*parser).callonip6addr30,
-						expr: &seqExpr{
-							pos: position{line: 572, col: 5, offset: 14310},
-							exprs: []interface{}{
-								&labeledExpr{
-									pos:   position{line: 572, col: 5, offset: 14310},
-									label: "a",
-									expr: &ruleRefExpr{
-										pos:  position{line: 572, col: 7, offset: 14312},
-										name: "h16",
-									},
-								},
-								&labeledExpr{
-									pos:   position{line: 572, col: 11, offset: 14316},
-									label: "b",
-									expr: &zeroOrMoreExpr{
-										pos: position{line: 572, col: 13, offset: 14318},
-										expr: &ruleRefExpr{
-											pos:  position{line: 572, col: 14, offset: 14319},
-											name: "h_append",
+										&ruleRefExpr{
+											pos:  position{line: 479, col: 34, offset: 15967},
+											name: "AdditiveExpression",
 										},
 									},
 								},
-								&litMatcher{
-									pos:        position{line: 572, col: 25, offset: 14330},
-									val:        "::",
-									ignoreCase: false,
-								},
 							},
 						},
 					},
-					&actionExpr{
-						pos: position{line: 575, col: 5, offset: 14398},
-						run: (*parser).callonip6addr38,
-						expr: &litMatcher{
-							pos:        position{line: 575, col: 5, offset: 14398},
-							val:        "::",
+				},
+			},
+		},
+		{
+			name: "RelativeOperator",
+			pos:  position{line: 482, col: 1, offset: 16049},
+			expr: &actionExpr{
+				pos: position{line: 482, col: 20, offset: 16068},
+				run: (*parser).callonRelativeOperator1,
+				expr: &choiceExpr{
+					pos: position{line: 482, col: 21, offset: 16069},
+					alternatives: []interface{}{
+						&litMatcher{
+							pos:        position{line: 482, col: 21, offset: 16069},
+							val:        "<=",
+							ignoreCase: false,
+						},
+						&litMatcher{
+							pos:        position{line: 482, col: 28, offset: 16076},
+							val:        "<",
+							ignoreCase: false,
+						},
+						&litMatcher{
+							pos:        position{line: 482, col: 34, offset: 16082},
+							val:        ">=",
+							ignoreCase: false,
+						},
+						&litMatcher{
+							pos:        position{line: 482, col: 41, offset: 16089},
+							val:        ">",
 							ignoreCase: false,
 						},
 					},
@@ -4450,68 +5375,151 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "ip6tail",
-			pos:  position{line: 579, col: 1, offset: 14435},
-			expr: &choiceExpr{
-				pos: position{line: 580, col: 5, offset: 14447},
-				alternatives: []interface{}{
-					&ruleRefExpr{
-						pos:  position{line: 580, col: 5, offset: 14447},
-						name: "addr",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 581, col: 5, offset: 14456},
-						name: "h16",
+			name: "AdditiveExpression",
+			pos:  position{line: 483, col: 1, offset: 16125},
+			expr: &actionExpr{
+				pos: position{line: 484, col: 5, offset: 16148},
+				run: (*parser).callonAdditiveExpression1,
+				expr: &seqExpr{
+					pos: position{line: 484, col: 5, offset: 16148},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 484, col: 5, offset: 16148},
+							label: "first",
+							expr: &ruleRefExpr{
+								pos:  position{line: 484, col: 11, offset: 16154},
+								name: "MultiplicativeExpression",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 485, col: 5, offset: 16183},
+							label: "rest",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 485, col: 10, offset: 16188},
+								expr: &seqExpr{
+									pos: position{line: 485, col: 11, offset: 16189},
+									exprs: []interface{}{
+										&ruleRefExpr{
+											pos:  position{line: 485, col: 11, offset: 16189},
+											name: "__",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 485, col: 14, offset: 16192},
+											name: "AdditiveOperator",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 485, col: 31, offset: 16209},
+											name: "__",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 485, col: 34, offset: 16212},
+											name: "MultiplicativeExpression",
+										},
+									},
+								},
+							},
+						},
 					},
 				},
 			},
 		},
 		{
-			name: "h_append",
-			pos:  position{line: 583, col: 1, offset: 14461},
+			name: "AdditiveOperator",
+			pos:  position{line: 488, col: 1, offset: 16300},
 			expr: &actionExpr{
-				pos: position{line: 583, col: 12, offset: 14472},
-				run: (*parser).callonh_append1,
-				expr: &seqExpr{
-					pos: position{line: 583, col: 12, offset: 14472},
-					exprs: []interface{}{
+				pos: position{line: 488, col: 20, offset: 16319},
+				run: (*parser).callonAdditiveOperator1,
+				expr: &choiceExpr{
+					pos: position{line: 488, col: 21, offset: 16320},
+					alternatives: []interface{}{
 						&litMatcher{
-							pos:        position{line: 583, col: 12, offset: 14472},
-							val:        ":",
+							pos:        position{line: 488, col: 21, offset: 16320},
+							val:        "+",
 							ignoreCase: false,
 						},
-						&labeledExpr{
-							pos:   position{line: 583, col: 16, offset: 14476},
-							label: "v",
-							expr: &ruleRefExpr{
-								pos:  position{line: 583, col: 18, offset: 14478},
-								name: "h16",
-							},
+						&litMatcher{
+							pos:        position{line: 488, col: 27, offset: 16326},
+							val:        "-",
+							ignoreCase: false,
 						},
 					},
 				},
 			},
 		},
 		{
-			name: "h_prepend",
-			pos:  position{line: 584, col: 1, offset: 14515},
+			name: "MultiplicativeExpression",
+			pos:  position{line: 489, col: 1, offset: 16362},
 			expr: &actionExpr{
-				pos: position{line: 584, col: 13, offset: 14527},
-				run: (*parser).callonh_prepend1,
+				pos: position{line: 490, col: 5, offset: 16391},
+				run: (*parser).callonMultiplicativeExpression1,
 				expr: &seqExpr{
-					pos: position{line: 584, col: 13, offset: 14527},
+					pos: position{line: 490, col: 5, offset: 16391},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 584, col: 13, offset: 14527},
-							label: "v",
+							pos:   position{line: 490, col: 5, offset: 16391},
+							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 584, col: 15, offset: 14529},
-								name: "h16",
+								pos:  position{line: 490, col: 11, offset: 16397},
+								name: "NotExpression",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 491, col: 5, offset: 16415},
+							label: "rest",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 491, col: 10, offset: 16420},
+								expr: &seqExpr{
+									pos: position{line: 491, col: 11, offset: 16421},
+									exprs: []interface{}{
+										&ruleRefExpr{
+											pos:  position{line: 491, col: 11, offset: 16421},
+											name: "__",
+										},
+										&labeledExpr{
+											pos:   position{line: 491, col: 14, offset: 16424},
+											label: "op",
+											expr: &ruleRefExpr{
+												pos:  position{line: 491, col: 17, offset: 16427},
+												name: "MultiplicativeOperator",
+											},
+										},
+										&ruleRefExpr{
+											pos:  position{line: 491, col: 40, offset: 16450},
+											name: "__",
+										},
+										&labeledExpr{
+											pos:   position{line: 491, col: 43, offset: 16453},
+											label: "operand",
+											expr: &ruleRefExpr{
+												pos:  position{line: 491, col: 51, offset: 16461},
+												name: "NotExpression",
+											},
+										},
+									},
+								},
 							},
 						},
+					},
+				},
+			},
+		},
+		{
+			name: "MultiplicativeOperator",
+			pos:  position{line: 494, col: 1, offset: 16538},
+			expr: &actionExpr{
+				pos: position{line: 494, col: 26, offset: 16563},
+				run: (*parser).callonMultiplicativeOperator1,
+				expr: &choiceExpr{
+					pos: position{line: 494, col: 27, offset: 16564},
+					alternatives: []interface{}{
+						&litMatcher{
+							pos:        position{line: 494, col: 27, offset: 16564},
+							val:        "*",
+							ignoreCase: false,
+						},
 						&litMatcher{
-							pos:        position{line: 584, col: 19, offset: 14533},
-							val:        ":",
+							pos:        position{line: 494, col: 33, offset: 16570},
+							val:        "/",
 							ignoreCase: false,
 						},
 					},
@@ -4519,153 +5527,114 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "sub_addr",
-			pos:  position{line: 586, col: 1, offset: 14571},
+			name: "NotExpression",
+			pos:  position{line: 495, col: 1, offset: 16606},
 			expr: &choiceExpr{
-				pos: position{line: 587, col: 5, offset: 14584},
+				pos: position{line: 496, col: 5, offset: 16624},
 				alternatives: []interface{}{
-					&ruleRefExpr{
-						pos:  position{line: 587, col: 5, offset: 14584},
-						name: "addr",
-					},
 					&actionExpr{
-						pos: position{line: 588, col: 5, offset: 14593},
-						run: (*parser).callonsub_addr3,
-						expr: &labeledExpr{
-							pos:   position{line: 588, col: 5, offset: 14593},
-							label: "a",
-							expr: &seqExpr{
-								pos: position{line: 588, col: 8, offset: 14596},
-								exprs: []interface{}{
-									&ruleRefExpr{
-										pos:  position{line: 588, col: 8, offset: 14596},
-										name: "unsignedInteger",
-									},
-									&litMatcher{
-										pos:        position{line: 588, col: 24, offset: 14612},
-										val:        ".",
-										ignoreCase: false,
-									},
-									&ruleRefExpr{
-										pos:  position{line: 588, col: 28, offset: 14616},
-										name: "unsignedInteger",
-									},
-									&litMatcher{
-										pos:        position{line: 588, col: 44, offset: 14632},
-										val:        ".",
-										ignoreCase: false,
-									},
-									&ruleRefExpr{
-										pos:  position{line: 588, col: 48, offset: 14636},
-										name: "unsignedInteger",
-									},
+						pos: position{line: 496, col: 5, offset: 16624},
+						run: (*parser).callonNotExpression2,
+						expr: &seqExpr{
+							pos: position{line: 496, col: 5, offset: 16624},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 496, col: 5, offset: 16624},
+									val:        "!",
+									ignoreCase: false,
 								},
-							},
-						},
-					},
-					&actionExpr{
-						pos: position{line: 589, col: 5, offset: 14696},
-						run: (*parser).callonsub_addr11,
-						expr: &labeledExpr{
-							pos:   position{line: 589, col: 5, offset: 14696},
-							label: "a",
-							expr: &seqExpr{
-								pos: position{line: 589, col: 8, offset: 14699},
-								exprs: []interface{}{
-									&ruleRefExpr{
-										pos:  position{line: 589, col: 8, offset: 14699},
-										name: "unsignedInteger",
-									},
-									&litMatcher{
-										pos:        position{line: 589, col: 24, offset: 14715},
-										val:        ".",
-										ignoreCase: false,
-									},
-									&ruleRefExpr{
-										pos:  position{line: 589, col: 28, offset: 14719},
-										name: "unsignedInteger",
+								&ruleRefExpr{
+									pos:  position{line: 496, col: 9, offset: 16628},
+									name: "__",
+								},
+								&labeledExpr{
+									pos:   position{line: 496, col: 12, offset: 16631},
+									label: "e",
+									expr: &ruleRefExpr{
+										pos:  position{line: 496, col: 14, offset: 16633},
+										name: "CallExpression",
 									},
 								},
 							},
 						},
 					},
-					&actionExpr{
-						pos: position{line: 590, col: 5, offset: 14781},
-						run: (*parser).callonsub_addr17,
-						expr: &labeledExpr{
-							pos:   position{line: 590, col: 5, offset: 14781},
-							label: "a",
-							expr: &ruleRefExpr{
-								pos:  position{line: 590, col: 7, offset: 14783},
-								name: "unsignedInteger",
-							},
-						},
+					&ruleRefExpr{
+						pos:  position{line: 499, col: 5, offset: 16698},
+						name: "CallExpression",
 					},
 				},
 			},
 		},
 		{
-			name: "subnet",
-			pos:  position{line: 592, col: 1, offset: 14842},
-			expr: &actionExpr{
-				pos: position{line: 593, col: 5, offset: 14853},
-				run: (*parser).callonsubnet1,
-				expr: &seqExpr{
-					pos: position{line: 593, col: 5, offset: 14853},
-					exprs: []interface{}{
-						&labeledExpr{
-							pos:   position{line: 593, col: 5, offset: 14853},
-							label: "a",
-							expr: &ruleRefExpr{
-								pos:  position{line: 593, col: 7, offset: 14855},
-								name: "sub_addr",
-							},
-						},
-						&litMatcher{
-							pos:        position{line: 593, col: 16, offset: 14864},
-							val:        "/",
-							ignoreCase: false,
-						},
-						&labeledExpr{
-							pos:   position{line: 593, col: 20, offset: 14868},
-							label: "m",
-							expr: &ruleRefExpr{
-								pos:  position{line: 593, col: 22, offset: 14870},
-								name: "unsignedInteger",
+			name: "CallExpression",
+			pos:  position{line: 500, col: 1, offset: 16713},
+			expr: &choiceExpr{
+				pos: position{line: 501, col: 5, offset: 16732},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 501, col: 5, offset: 16732},
+						run: (*parser).callonCallExpression2,
+						expr: &seqExpr{
+							pos: position{line: 501, col: 5, offset: 16732},
+							exprs: []interface{}{
+								&labeledExpr{
+									pos:   position{line: 501, col: 5, offset: 16732},
+									label: "fn",
+									expr: &ruleRefExpr{
+										pos:  position{line: 501, col: 8, offset: 16735},
+										name: "FunctionName",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 501, col: 21, offset: 16748},
+									name: "__",
+								},
+								&litMatcher{
+									pos:        position{line: 501, col: 24, offset: 16751},
+									val:        "(",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 501, col: 28, offset: 16755},
+									label: "args",
+									expr: &ruleRefExpr{
+										pos:  position{line: 501, col: 33, offset: 16760},
+										name: "ArgumentList",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 501, col: 46, offset: 16773},
+									val:        ")",
+									ignoreCase: false,
+								},
 							},
 						},
 					},
+					&ruleRefExpr{
+						pos:  position{line: 504, col: 5, offset: 16836},
+						name: "DereferenceExpression",
+					},
 				},
 			},
 		},
 		{
-			name: "ip6subnet",
-			pos:  position{line: 597, col: 1, offset: 14954},
+			name: "FunctionName",
+			pos:  position{line: 505, col: 1, offset: 16858},
 			expr: &actionExpr{
-				pos: position{line: 598, col: 5, offset: 14968},
-				run: (*parser).callonip6subnet1,
+				pos: position{line: 506, col: 5, offset: 16875},
+				run: (*parser).callonFunctionName1,
 				expr: &seqExpr{
-					pos: position{line: 598, col: 5, offset: 14968},
+					pos: position{line: 506, col: 5, offset: 16875},
 					exprs: []interface{}{
-						&labeledExpr{
-							pos:   position{line: 598, col: 5, offset: 14968},
-							label: "a",
-							expr: &ruleRefExpr{
-								pos:  position{line: 598, col: 7, offset: 14970},
-								name: "ip6addr",
-							},
-						},
-						&litMatcher{
-							pos:        position{line: 598, col: 15, offset: 14978},
-							val:        "/",
-							ignoreCase: false,
+						&ruleRefExpr{
+							pos:  position{line: 506, col: 5, offset: 16875},
+							name: "FunctionNameStart",
 						},
-						&labeledExpr{
-							pos:   position{line: 598, col: 19, offset: 14982},
-							label: "m",
+						&zeroOrMoreExpr{
+							pos: position{line: 506, col: 23, offset: 16893},
 							expr: &ruleRefExpr{
-								pos:  position{line: 598, col: 21, offset: 14984},
-								name: "unsignedInteger",
+								pos:  position{line: 506, col: 23, offset: 16893},
+								name: "FunctionNameRest",
 							},
 						},
 					},
@@ -4673,32 +5642,30 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "unsignedInteger",
-			pos:  position{line: 602, col: 1, offset: 15058},
-			expr: &actionExpr{
-				pos: position{line: 603, col: 5, offset: 15078},
-				run: (*parser).callonunsignedInteger1,
-				expr: &labeledExpr{
-					pos:   position{line: 603, col: 5, offset: 15078},
-					label: "s",
-					expr: &ruleRefExpr{
-						pos:  position{line: 603, col: 7, offset: 15080},
-						name: "suint",
-					},
-				},
+			name: "FunctionNameStart",
+			pos:  position{line: 507, col: 1, offset: 16942},
+			expr: &charClassMatcher{
+				pos:        position{line: 507, col: 21, offset: 16962},
+				val:        "[A-Za-z]",
+				ranges:     []rune{'A', 'Z', 'a', 'z'},
+				ignoreCase: false,
+				inverted:   false,
 			},
 		},
 		{
-			name: "suint",
-			pos:  position{line: 605, col: 1, offset: 15115},
-			expr: &actionExpr{
-				pos: position{line: 606, col: 5, offset: 15125},
-				run: (*parser).callonsuint1,
-				expr: &oneOrMoreExpr{
-					pos: position{line: 606, col: 5, offset: 15125},
-					expr: &charClassMatcher{
-						pos:        position{line: 606, col: 5, offset: 15125},
-						val:        "[0-9]",
+			name: "FunctionNameRest",
+			pos:  position{line: 508, col: 1, offset: 16971},
+			expr: &choiceExpr{
+				pos: position{line: 508, col: 20, offset: 16990},
+				alternatives: []interface{}{
+					&ruleRefExpr{
+						pos:  position{line: 508, col: 20, offset: 16990},
+						name: "FunctionNameStart",
+					},
+					&charClassMatcher{
+						pos:        position{line: 508, col: 40, offset: 17010},
+						val:        "[.0-9]",
+						chars:      []rune{'.'},
 						ranges:     []rune{'0', '9'},
 						ignoreCase: false,
 						inverted:   false,
@@ -4707,144 +5674,166 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "integer",
-			pos:  position{line: 608, col: 1, offset: 15164},
-			expr: &actionExpr{
-				pos: position{line: 609, col: 5, offset: 15176},
-				run: (*parser).calloninteger1,
-				expr: &labeledExpr{
-					pos:   position{line: 609, col: 5, offset: 15176},
-					label: "s",
-					expr: &ruleRefExpr{
-						pos:  position{line: 609, col: 7, offset: 15178},
-						name: "sinteger",
-					},
-				},
-			},
-		},
-		{
-			name: "sinteger",
-			pos:  position{line: 611, col: 1, offset: 15216},
-			expr: &actionExpr{
-				pos: position{line: 612, col: 5, offset: 15229},
-				run: (*parser).callonsinteger1,
-				expr: &seqExpr{
-					pos: position{line: 612, col: 5, offset: 15229},
-					exprs: []interface{}{
-						&zeroOrOneExpr{
-							pos: position{line: 612, col: 5, offset: 15229},
-							expr: &charClassMatcher{
-								pos:        position{line: 612, col: 5, offset: 15229},
-								val:        "[+-]",
-								chars:      []rune{'+', '-'},
-								ignoreCase: false,
-								inverted:   false,
-							},
-						},
-						&ruleRefExpr{
-							pos:  position{line: 612, col: 11, offset: 15235},
-							name: "suint",
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "double",
-			pos:  position{line: 614, col: 1, offset: 15273},
-			expr: &actionExpr{
-				pos: position{line: 615, col: 5, offset: 15284},
-				run: (*parser).callondouble1,
-				expr: &labeledExpr{
-					pos:   position{line: 615, col: 5, offset: 15284},
-					label: "s",
-					expr: &ruleRefExpr{
-						pos:  position{line: 615, col: 7, offset: 15286},
-						name: "sdouble",
-					},
-				},
-			},
-		},
-		{
-			name: "sdouble",
-			pos:  position{line: 619, col: 1, offset: 15333},
+			name: "ArgumentList",
+			pos:  position{line: 509, col: 1, offset: 17017},
 			expr: &choiceExpr{
-				pos: position{line: 620, col: 5, offset: 15345},
+				pos: position{line: 510, col: 5, offset: 17034},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 620, col: 5, offset: 15345},
-						run: (*parser).callonsdouble2,
+						pos: position{line: 510, col: 5, offset: 17034},
+						run: (*parser).callonArgumentList2,
 						expr: &seqExpr{
-							pos: position{line: 620, col: 5, offset: 15345},
+							pos: position{line: 510, col: 5, offset: 17034},
 							exprs: []interface{}{
-								&zeroOrOneExpr{
-									pos: position{line: 620, col: 5, offset: 15345},
-									expr: &litMatcher{
-										pos:        position{line: 620, col: 5, offset: 15345},
-										val:        "-",
-										ignoreCase: false,
-									},
-								},
-								&oneOrMoreExpr{
-									pos: position{line: 620, col: 10, offset: 15350},
+								&labeledExpr{
+									pos:   position{line: 510, col: 5, offset: 17034},
+									label: "first",
 									expr: &ruleRefExpr{
-										pos:  position{line: 620, col: 10, offset: 15350},
-										name: "doubleInteger",
+										pos:  position{line: 510, col: 11, offset: 17040},
+										name: "Expression",
 									},
 								},
-								&litMatcher{
-									pos:        position{line: 620, col: 25, offset: 15365},
-									val:        ".",
-									ignoreCase: false,
-								},
-								&oneOrMoreExpr{
-									pos: position{line: 620, col: 29, offset: 15369},
-									expr: &ruleRefExpr{
-										pos:  position{line: 620, col: 29, offset: 15369},
-										name: "doubleDigit",
-									},
-								},
-								&zeroOrOneExpr{
-									pos: position{line: 620, col: 42, offset: 15382},
-									expr: &ruleRefExpr{
-										pos:  position{line: 620, col: 42, offset: 15382},
-										name: "exponentPart",
+								&labeledExpr{
+									pos:   position{line: 510, col: 22, offset: 17051},
+									label: "rest",
+									expr: &zeroOrMoreExpr{
+										pos: position{line: 510, col: 27, offset: 17056},
+										expr: &actionExpr{
+											pos: position{line: 510, col: 28, offset: 17057},
+											run: (*parser).callonArgumentList8,
+											expr: &seqExpr{
+												pos: position{line: 510, col: 28, offset: 17057},
+												exprs: []interface{}{
+													&ruleRefExpr{
+														pos:  position{line: 510, col: 28, offset: 17057},
+														name: "__",
+													},
+													&litMatcher{
+														pos:        position{line: 510, col: 31, offset: 17060},
+														val:        ",",
+														ignoreCase: false,
+													},
+													&ruleRefExpr{
+														pos:  position{line: 510, col: 35, offset: 17064},
+														name: "__",
+													},
+													&labeledExpr{
+														pos:   position{line: 510, col: 38, offset: 17067},
+														label: "e",
+														expr: &ruleRefExpr{
+															pos:  position{line: 510, col: 40, offset: 17069},
+															name: "Expression",
+														},
+													},
+												},
+											},
+										},
 									},
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 623, col: 5, offset: 15441},
-						run: (*parser).callonsdouble13,
-						expr: &seqExpr{
-							pos: position{line: 623, col: 5, offset: 15441},
-							exprs: []interface{}{
-								&zeroOrOneExpr{
-									pos: position{line: 623, col: 5, offset: 15441},
-									expr: &litMatcher{
-										pos:        position{line: 623, col: 5, offset: 15441},
-										val:        "-",
-										ignoreCase: false,
-									},
-								},
-								&litMatcher{
-									pos:        position{line: 623, col: 10, offset: 15446},
-									val:        ".",
-									ignoreCase: false,
-								},
-								&oneOrMoreExpr{
-									pos: position{line: 623, col: 14, offset: 15450},
-									expr: &ruleRefExpr{
-										pos:  position{line: 623, col: 14, offset: 15450},
-										name: "doubleDigit",
-									},
-								},
-								&zeroOrOneExpr{
-									pos: position{line: 623, col: 27, offset: 15463},
-									expr: &ruleRefExpr{
-										pos:  position{line: 623, col: 27, offset: 15463},
-										name: "exponentPart",
+						pos: position{line: 513, col: 5, offset: 17184},
+						run: (*parser).callonArgumentList15,
+						expr: &ruleRefExpr{
+							pos:  position{line: 513, col: 5, offset: 17184},
+							name: "__",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "DereferenceExpression",
+			pos:  position{line: 514, col: 1, offset: 17219},
+			expr: &actionExpr{
+				pos: position{line: 515, col: 5, offset: 17245},
+				run: (*parser).callonDereferenceExpression1,
+				expr: &seqExpr{
+					pos: position{line: 515, col: 5, offset: 17245},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 515, col: 5, offset: 17245},
+							label: "base",
+							expr: &ruleRefExpr{
+								pos:  position{line: 515, col: 10, offset: 17250},
+								name: "PrimaryExpression",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 516, col: 5, offset: 17272},
+							label: "derefs",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 516, col: 12, offset: 17279},
+								expr: &choiceExpr{
+									pos: position{line: 517, col: 9, offset: 17289},
+									alternatives: []interface{}{
+										&seqExpr{
+											pos: position{line: 517, col: 9, offset: 17289},
+											exprs: []interface{}{
+												&ruleRefExpr{
+													pos:  position{line: 517, col: 9, offset: 17289},
+													name: "__",
+												},
+												&litMatcher{
+													pos:        position{line: 517, col: 12, offset: 17292},
+													val:        "[",
+													ignoreCase: false,
+												},
+												&ruleRefExpr{
+													pos:  position{line: 517, col: 16, offset: 17296},
+													name: "__",
+												},
+												&labeledExpr{
+													pos:   position{line: 517, col: 19, offset: 17299},
+													label: "index",
+													expr: &ruleRefExpr{
+														pos:  position{line: 517, col: 25, offset: 17305},
+														name: "Expression",
+													},
+												},
+												&ruleRefExpr{
+													pos:  position{line: 517, col: 36, offset: 17316},
+													name: "__",
+												},
+												&litMatcher{
+													pos:        position{line: 517, col: 39, offset: 17319},
+													val:        "]",
+													ignoreCase: false,
+												},
+											},
+										},
+										&seqExpr{
+											pos: position{line: 518, col: 9, offset: 17331},
+											exprs: []interface{}{
+												&ruleRefExpr{
+													pos:  position{line: 518, col: 9, offset: 17331},
+													name: "__",
+												},
+												&litMatcher{
+													pos:        position{line: 518, col: 12, offset: 17334},
+													val:        ".",
+													ignoreCase: false,
+												},
+												&ruleRefExpr{
+													pos:  position{line: 518, col: 16, offset: 17338},
+													name: "__",
+												},
+												&actionExpr{
+													pos: position{line: 518, col: 20, offset: 17342},
+													run: (*parser).callonDereferenceExpression20,
+													expr: &labeledExpr{
+														pos:   position{line: 518, col: 20, offset: 17342},
+														label: "field",
+														expr: &ruleRefExpr{
+															pos:  position{line: 518, col: 26, offset: 17348},
+															name: "fieldName",
+														},
+													},
+												},
+											},
+										},
 									},
 								},
 							},
@@ -4854,312 +5843,430 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "doubleInteger",
-			pos:  position{line: 627, col: 1, offset: 15519},
+			name: "duration",
+			pos:  position{line: 522, col: 1, offset: 17482},
 			expr: &choiceExpr{
-				pos: position{line: 628, col: 5, offset: 15537},
+				pos: position{line: 523, col: 5, offset: 17495},
 				alternatives: []interface{}{
-					&litMatcher{
-						pos:        position{line: 628, col: 5, offset: 15537},
-						val:        "0",
-						ignoreCase: false,
+					&ruleRefExpr{
+						pos:  position{line: 523, col: 5, offset: 17495},
+						name: "subseconds",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 524, col: 5, offset: 17510},
+						name: "seconds",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 525, col: 5, offset: 17522},
+						name: "minutes",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 526, col: 5, offset: 17534},
+						name: "hours",
 					},
 					&seqExpr{
-						pos: position{line: 629, col: 5, offset: 15545},
+						pos: position{line: 527, col: 5, offset: 17544},
 						exprs: []interface{}{
-							&charClassMatcher{
-								pos:        position{line: 629, col: 5, offset: 15545},
-								val:        "[1-9]",
-								ranges:     []rune{'1', '9'},
+							&ruleRefExpr{
+								pos:  position{line: 527, col: 5, offset: 17544},
+								name: "hours",
+							},
+							&ruleRefExpr{
+								pos:  position{line: 527, col: 11, offset: 17550},
+								name: "_",
+							},
+							&litMatcher{
+								pos:        position{line: 527, col: 13, offset: 17552},
+								val:        "and",
 								ignoreCase: false,
-								inverted:   false,
 							},
-							&zeroOrMoreExpr{
-								pos: position{line: 629, col: 11, offset: 15551},
-								expr: &charClassMatcher{
-									pos:        position{line: 629, col: 11, offset: 15551},
-									val:        "[0-9]",
-									ranges:     []rune{'0', '9'},
-									ignoreCase: false,
-									inverted:   false,
-								},
+							&ruleRefExpr{
+								pos:  position{line: 527, col: 19, offset: 17558},
+								name: "_",
+							},
+							&ruleRefExpr{
+								pos:  position{line: 527, col: 21, offset: 17560},
+								name: "minutes",
 							},
 						},
 					},
+					&ruleRefExpr{
+						pos:  position{line: 528, col: 5, offset: 17572},
+						name: "days",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 529, col: 5, offset: 17581},
+						name: "weeks",
+					},
 				},
 			},
 		},
 		{
-			name: "doubleDigit",
-			pos:  position{line: 631, col: 1, offset: 15559},
-			expr: &charClassMatcher{
-				pos:        position{line: 631, col: 15, offset: 15573},
-				val:        "[0-9]",
-				ranges:     []rune{'0', '9'},
-				ignoreCase: false,
-				inverted:   false,
-			},
-		},
-		{
-			name: "exponentPart",
-			pos:  position{line: 633, col: 1, offset: 15580},
-			expr: &seqExpr{
-				pos: position{line: 633, col: 16, offset: 15595},
-				exprs: []interface{}{
+			name: "sec_abbrev",
+			pos:  position{line: 530, col: 1, offset: 17587},
+			expr: &choiceExpr{
+				pos: position{line: 531, col: 5, offset: 17602},
+				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 633, col: 16, offset: 15595},
-						val:        "e",
-						ignoreCase: true,
+						pos:        position{line: 531, col: 5, offset: 17602},
+						val:        "seconds",
+						ignoreCase: false,
 					},
-					&ruleRefExpr{
-						pos:  position{line: 633, col: 21, offset: 15600},
-						name: "sinteger",
+					&litMatcher{
+						pos:        position{line: 532, col: 5, offset: 17616},
+						val:        "second",
+						ignoreCase: false,
+					},
+					&litMatcher{
+						pos:        position{line: 533, col: 5, offset: 17629},
+						val:        "secs",
+						ignoreCase: false,
+					},
+					&litMatcher{
+						pos:        position{line: 534, col: 5, offset: 17640},
+						val:        "sec",
+						ignoreCase: false,
+					},
+					&litMatcher{
+						pos:        position{line: 535, col: 5, offset: 17650},
+						val:        "s",
+						ignoreCase: false,
 					},
 				},
 			},
 		},
 		{
-			name: "h16",
-			pos:  position{line: 635, col: 1, offset: 15610},
-			expr: &actionExpr{
-				pos: position{line: 635, col: 7, offset: 15616},
-				run: (*parser).callonh161,
-				expr: &labeledExpr{
-					pos:   position{line: 635, col: 7, offset: 15616},
-					label: "chars",
-					expr: &oneOrMoreExpr{
-						pos: position{line: 635, col: 13, offset: 15622},
-						expr: &ruleRefExpr{
-							pos:  position{line: 635, col: 13, offset: 15622},
-							name: "hexdigit",
-						},
+			name: "min_abbrev",
+			pos:  position{line: 536, col: 1, offset: 17654},
+			expr: &choiceExpr{
+				pos: position{line: 537, col: 5, offset: 17669},
+				alternatives: []interface{}{
+					&litMatcher{
+						pos:        position{line: 537, col: 5, offset: 17669},
+						val:        "minutes",
+						ignoreCase: false,
+					},
+					&litMatcher{
+						pos:        position{line: 538, col: 5, offset: 17683},
+						val:        "minute",
+						ignoreCase: false,
+					},
+					&litMatcher{
+						pos:        position{line: 539, col: 5, offset: 17696},
+						val:        "mins",
+						ignoreCase: false,
+					},
+					&litMatcher{
+						pos:        position{line: 540, col: 5, offset: 17707},
+						val:        "min",
+						ignoreCase: false,
+					},
+					&litMatcher{
+						pos:        position{line: 541, col: 5, offset: 17717},
+						val:        "m",
+						ignoreCase: false,
 					},
 				},
 			},
 		},
 		{
-			name: "hexdigit",
-			pos:  position{line: 637, col: 1, offset: 15664},
-			expr: &charClassMatcher{
-				pos:        position{line: 637, col: 12, offset: 15675},
-				val:        "[0-9a-fA-F]",
-				ranges:     []rune{'0', '9', 'a', 'f', 'A', 'F'},
-				ignoreCase: false,
-				inverted:   false,
-			},
-		},
-		{
-			name: "searchWord",
-			pos:  position{line: 639, col: 1, offset: 15688},
-			expr: &actionExpr{
-				pos: position{line: 640, col: 5, offset: 15703},
-				run: (*parser).callonsearchWord1,
-				expr: &labeledExpr{
-					pos:   position{line: 640, col: 5, offset: 15703},
-					label: "chars",
-					expr: &oneOrMoreExpr{
-						pos: position{line: 640, col: 11, offset: 15709},
-						expr: &ruleRefExpr{
-							pos:  position{line: 640, col: 11, offset: 15709},
-							name: "searchWordPart",
-						},
+			name: "hour_abbrev",
+			pos:  position{line: 542, col: 1, offset: 17721},
+			expr: &choiceExpr{
+				pos: position{line: 543, col: 5, offset: 17737},
+				alternatives: []interface{}{
+					&litMatcher{
+						pos:        position{line: 543, col: 5, offset: 17737},
+						val:        "hours",
+						ignoreCase: false,
+					},
+					&litMatcher{
+						pos:        position{line: 544, col: 5, offset: 17749},
+						val:        "hrs",
+						ignoreCase: false,
+					},
+					&litMatcher{
+						pos:        position{line: 545, col: 5, offset: 17759},
+						val:        "hr",
+						ignoreCase: false,
+					},
+					&litMatcher{
+						pos:        position{line: 546, col: 5, offset: 17768},
+						val:        "h",
+						ignoreCase: false,
+					},
+					&litMatcher{
+						pos:        position{line: 547, col: 5, offset: 17776},
+						val:        "hour",
+						ignoreCase: false,
 					},
 				},
 			},
 		},
 		{
-			name: "searchWordPart",
-			pos:  position{line: 642, col: 1, offset: 15759},
+			name: "day_abbrev",
+			pos:  position{line: 548, col: 1, offset: 17783},
 			expr: &choiceExpr{
-				pos: position{line: 643, col: 5, offset: 15778},
+				pos: position{line: 548, col: 14, offset: 17796},
 				alternatives: []interface{}{
-					&actionExpr{
-						pos: position{line: 643, col: 5, offset: 15778},
-						run: (*parser).callonsearchWordPart2,
-						expr: &seqExpr{
-							pos: position{line: 643, col: 5, offset: 15778},
-							exprs: []interface{}{
-								&litMatcher{
-									pos:        position{line: 643, col: 5, offset: 15778},
-									val:        "\\",
-									ignoreCase: false,
-								},
-								&labeledExpr{
-									pos:   position{line: 643, col: 10, offset: 15783},
-									label: "s",
-									expr: &choiceExpr{
-										pos: position{line: 643, col: 13, offset: 15786},
-										alternatives: []interface{}{
-											&ruleRefExpr{
-												pos:  position{line: 643, col: 13, offset: 15786},
-												name: "escapeSequence",
-											},
-											&ruleRefExpr{
-												pos:  position{line: 643, col: 30, offset: 15803},
-												name: "searchEscape",
-											},
-										},
-									},
-								},
-							},
-						},
+					&litMatcher{
+						pos:        position{line: 548, col: 14, offset: 17796},
+						val:        "days",
+						ignoreCase: false,
 					},
-					&actionExpr{
-						pos: position{line: 644, col: 5, offset: 15840},
-						run: (*parser).callonsearchWordPart9,
-						expr: &seqExpr{
-							pos: position{line: 644, col: 5, offset: 15840},
-							exprs: []interface{}{
-								&notExpr{
-									pos: position{line: 644, col: 5, offset: 15840},
-									expr: &choiceExpr{
-										pos: position{line: 644, col: 7, offset: 15842},
-										alternatives: []interface{}{
-											&charClassMatcher{
-												pos:        position{line: 644, col: 7, offset: 15842},
-												val:        "[\\x00-\\x1F\\x5C(),!><=\\x22|\\x27;]",
-												chars:      []rune{'\\', '(', ')', ',', '!', '>', '<', '=', '"', '|', '\'', ';'},
-												ranges:     []rune{'\x00', '\x1f'},
-												ignoreCase: false,
-												inverted:   false,
-											},
-											&ruleRefExpr{
-												pos:  position{line: 644, col: 42, offset: 15877},
-												name: "ws",
-											},
-										},
-									},
-								},
-								&anyMatcher{
-									line: 644, col: 46, offset: 15881,
-								},
-							},
-						},
+					&litMatcher{
+						pos:        position{line: 548, col: 21, offset: 17803},
+						val:        "day",
+						ignoreCase: false,
+					},
+					&litMatcher{
+						pos:        position{line: 548, col: 27, offset: 17809},
+						val:        "d",
+						ignoreCase: false,
 					},
 				},
 			},
 		},
 		{
-			name: "quotedString",
-			pos:  position{line: 646, col: 1, offset: 15915},
+			name: "week_abbrev",
+			pos:  position{line: 549, col: 1, offset: 17813},
+			expr: &choiceExpr{
+				pos: position{line: 549, col: 15, offset: 17827},
+				alternatives: []interface{}{
+					&litMatcher{
+						pos:        position{line: 549, col: 15, offset: 17827},
+						val:        "weeks",
+						ignoreCase: false,
+					},
+					&litMatcher{
+						pos:        position{line: 549, col: 23, offset: 17835},
+						val:        "week",
+						ignoreCase: false,
+					},
+					&litMatcher{
+						pos:        position{line: 549, col: 30, offset: 17842},
+						val:        "wks",
+						ignoreCase: false,
+					},
+					&litMatcher{
+						pos:        position{line: 549, col: 36, offset: 17848},
+						val:        "wk",
+						ignoreCase: false,
+					},
+					&litMatcher{
+						pos:        position{line: 549, col: 41, offset: 17853},
+						val:        "w",
+						ignoreCase: false,
+					},
+				},
+			},
+		},
+		{
+			name: "month_abbrev",
+			pos:  position{line: 550, col: 1, offset: 17857},
+			expr: &choiceExpr{
+				pos: position{line: 550, col: 16, offset: 17872},
+				alternatives: []interface{}{
+					&litMatcher{
+						pos:        position{line: 550, col: 16, offset: 17872},
+						val:        "months",
+						ignoreCase: false,
+					},
+					&litMatcher{
+						pos:        position{line: 550, col: 25, offset: 17881},
+						val:        "month",
+						ignoreCase: false,
+					},
+					&litMatcher{
+						pos:        position{line: 550, col: 33, offset: 17889},
+						val:        "mon",
+						ignoreCase: false,
+					},
+				},
+			},
+		},
+		{
+			name: "year_abbrev",
+			pos:  position{line: 551, col: 1, offset: 17895},
+			expr: &choiceExpr{
+				pos: position{line: 551, col: 15, offset: 17909},
+				alternatives: []interface{}{
+					&litMatcher{
+						pos:        position{line: 551, col: 15, offset: 17909},
+						val:        "years",
+						ignoreCase: false,
+					},
+					&litMatcher{
+						pos:        position{line: 551, col: 23, offset: 17917},
+						val:        "year",
+						ignoreCase: false,
+					},
+					&litMatcher{
+						pos:        position{line: 551, col: 30, offset: 17924},
+						val:        "yrs",
+						ignoreCase: false,
+					},
+					&litMatcher{
+						pos:        position{line: 551, col: 36, offset: 17930},
+						val:        "yr",
+						ignoreCase: false,
+					},
+					&litMatcher{
+						pos:        position{line: 551, col: 41, offset: 17935},
+						val:        "y",
+						ignoreCase: false,
+					},
+				},
+			},
+		},
+		{
+			name: "subseconds",
+			pos:  position{line: 552, col: 1, offset: 17939},
 			expr: &choiceExpr{
-				pos: position{line: 647, col: 5, offset: 15932},
+				pos: position{line: 553, col: 5, offset: 17954},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 647, col: 5, offset: 15932},
-						run: (*parser).callonquotedString2,
+						pos: position{line: 553, col: 5, offset: 17954},
+						run: (*parser).callonsubseconds2,
 						expr: &seqExpr{
-							pos: position{line: 647, col: 5, offset: 15932},
+							pos: position{line: 553, col: 5, offset: 17954},
 							exprs: []interface{}{
-								&litMatcher{
-									pos:        position{line: 647, col: 5, offset: 15932},
-									val:        "\"",
-									ignoreCase: false,
-								},
 								&labeledExpr{
-									pos:   position{line: 647, col: 9, offset: 15936},
-									label: "v",
-									expr: &zeroOrMoreExpr{
-										pos: position{line: 647, col: 11, offset: 15938},
-										expr: &ruleRefExpr{
-											pos:  position{line: 647, col: 11, offset: 15938},
-											name: "doubleQuotedChar",
-										},
+									pos:   position{line: 553, col: 5, offset: 17954},
+									label: "num",
+									expr: &ruleRefExpr{
+										pos:  position{line: 553, col: 9, offset: 17958},
+										name: "number",
 									},
 								},
-								&litMatcher{
-									pos:        position{line: 647, col: 29, offset: 15956},
-									val:        "\"",
-									ignoreCase: false,
+								&zeroOrOneExpr{
+									pos: position{line: 553, col: 16, offset: 17965},
+									expr: &ruleRefExpr{
+										pos:  position{line: 553, col: 16, offset: 17965},
+										name: "_",
+									},
+								},
+								&choiceExpr{
+									pos: position{line: 553, col: 20, offset: 17969},
+									alternatives: []interface{}{
+										&litMatcher{
+											pos:        position{line: 553, col: 20, offset: 17969},
+											val:        "milliseconds",
+											ignoreCase: false,
+										},
+										&litMatcher{
+											pos:        position{line: 553, col: 37, offset: 17986},
+											val:        "millisecond",
+											ignoreCase: false,
+										},
+										&litMatcher{
+											pos:        position{line: 553, col: 53, offset: 18002},
+											val:        "msec",
+											ignoreCase: false,
+										},
+										&litMatcher{
+											pos:        position{line: 553, col: 62, offset: 18011},
+											val:        "ms",
+											ignoreCase: false,
+										},
+									},
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 648, col: 5, offset: 15993},
-						run: (*parser).callonquotedString9,
+						pos: position{line: 556, col: 5, offset: 18083},
+						run: (*parser).callonsubseconds13,
 						expr: &seqExpr{
-							pos: position{line: 648, col: 5, offset: 15993},
+							pos: position{line: 556, col: 5, offset: 18083},
 							exprs: []interface{}{
-								&litMatcher{
-									pos:        position{line: 648, col: 5, offset: 15993},
-									val:        "'",
-									ignoreCase: false,
-								},
 								&labeledExpr{
-									pos:   position{line: 648, col: 9, offset: 15997},
-									label: "v",
-									expr: &zeroOrMoreExpr{
-										pos: position{line: 648, col: 11, offset: 15999},
-										expr: &ruleRefExpr{
-											pos:  position{line: 648, col: 11, offset: 15999},
-											name: "singleQuotedChar",
-										},
+									pos:   position{line: 556, col: 5, offset: 18083},
+									label: "num",
+									expr: &ruleRefExpr{
+										pos:  position{line: 556, col: 9, offset: 18087},
+										name: "number",
 									},
 								},
-								&litMatcher{
-									pos:        position{line: 648, col: 29, offset: 16017},
-									val:        "'",
-									ignoreCase: false,
+								&zeroOrOneExpr{
+									pos: position{line: 556, col: 16, offset: 18094},
+									expr: &ruleRefExpr{
+										pos:  position{line: 556, col: 16, offset: 18094},
+										name: "_",
+									},
 								},
-							},
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "doubleQuotedChar",
-			pos:  position{line: 650, col: 1, offset: 16051},
-			expr: &choiceExpr{
-				pos: position{line: 651, col: 5, offset: 16072},
-				alternatives: []interface{}{
-					&actionExpr{
-						pos: position{line: 651, col: 5, offset: 16072},
-						run: (*parser).callondoubleQuotedChar2,
-						expr: &seqExpr{
-							pos: position{line: 651, col: 5, offset: 16072},
-							exprs: []interface{}{
-								&notExpr{
-									pos: position{line: 651, col: 5, offset: 16072},
-									expr: &choiceExpr{
-										pos: position{line: 651, col: 7, offset: 16074},
-										alternatives: []interface{}{
-											&litMatcher{
-												pos:        position{line: 651, col: 7, offset: 16074},
-												val:        "\"",
-												ignoreCase: false,
-											},
-											&ruleRefExpr{
-												pos:  position{line: 651, col: 13, offset: 16080},
-												name: "escapedChar",
-											},
+								&choiceExpr{
+									pos: position{line: 556, col: 20, offset: 18098},
+									alternatives: []interface{}{
+										&litMatcher{
+											pos:        position{line: 556, col: 20, offset: 18098},
+											val:        "microseconds",
+											ignoreCase: false,
+										},
+										&litMatcher{
+											pos:        position{line: 556, col: 37, offset: 18115},
+											val:        "microsecond",
+											ignoreCase: false,
+										},
+										&litMatcher{
+											pos:        position{line: 556, col: 53, offset: 18131},
+											val:        "usec",
+											ignoreCase: false,
+										},
+										&litMatcher{
+											pos:        position{line: 556, col: 62, offset: 18140},
+											val:        "us",
+											ignoreCase: false,
 										},
 									},
 								},
-								&anyMatcher{
-									line: 651, col: 26, offset: 16093,
-								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 652, col: 5, offset: 16130},
-						run: (*parser).callondoubleQuotedChar9,
+						pos: position{line: 559, col: 5, offset: 18209},
+						run: (*parser).callonsubseconds24,
 						expr: &seqExpr{
-							pos: position{line: 652, col: 5, offset: 16130},
+							pos: position{line: 559, col: 5, offset: 18209},
 							exprs: []interface{}{
-								&litMatcher{
-									pos:        position{line: 652, col: 5, offset: 16130},
-									val:        "\\",
-									ignoreCase: false,
-								},
 								&labeledExpr{
-									pos:   position{line: 652, col: 10, offset: 16135},
-									label: "s",
+									pos:   position{line: 559, col: 5, offset: 18209},
+									label: "num",
 									expr: &ruleRefExpr{
-										pos:  position{line: 652, col: 12, offset: 16137},
-										name: "escapeSequence",
+										pos:  position{line: 559, col: 9, offset: 18213},
+										name: "number",
+									},
+								},
+								&zeroOrOneExpr{
+									pos: position{line: 559, col: 16, offset: 18220},
+									expr: &ruleRefExpr{
+										pos:  position{line: 559, col: 16, offset: 18220},
+										name: "_",
+									},
+								},
+								&choiceExpr{
+									pos: position{line: 559, col: 20, offset: 18224},
+									alternatives: []interface{}{
+										&litMatcher{
+											pos:        position{line: 559, col: 20, offset: 18224},
+											val:        "nanoseconds",
+											ignoreCase: false,
+										},
+										&litMatcher{
+											pos:        position{line: 559, col: 36, offset: 18240},
+											val:        "nanosecond",
+											ignoreCase: false,
+										},
+										&litMatcher{
+											pos:        position{line: 559, col: 51, offset: 18255},
+											val:        "nsec",
+											ignoreCase: false,
+										},
+										&litMatcher{
+											pos:        position{line: 559, col: 60, offset: 18264},
+											val:        "ns",
+											ignoreCase: false,
+										},
 									},
 								},
 							},
@@ -5169,59 +6276,91 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "singleQuotedChar",
-			pos:  position{line: 654, col: 1, offset: 16171},
+			name: "seconds",
+			pos:  position{line: 562, col: 1, offset: 18318},
 			expr: &choiceExpr{
-				pos: position{line: 655, col: 5, offset: 16192},
+				pos: position{line: 563, col: 5, offset: 18330},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 655, col: 5, offset: 16192},
-						run: (*parser).callonsingleQuotedChar2,
+						pos: position{line: 563, col: 5, offset: 18330},
+						run: (*parser).callonseconds2,
+						expr: &litMatcher{
+							pos:        position{line: 563, col: 5, offset: 18330},
+							val:        "second",
+							ignoreCase: false,
+						},
+					},
+					&actionExpr{
+						pos: position{line: 564, col: 5, offset: 18375},
+						run: (*parser).callonseconds4,
 						expr: &seqExpr{
-							pos: position{line: 655, col: 5, offset: 16192},
+							pos: position{line: 564, col: 5, offset: 18375},
 							exprs: []interface{}{
-								&notExpr{
-									pos: position{line: 655, col: 5, offset: 16192},
-									expr: &choiceExpr{
-										pos: position{line: 655, col: 7, offset: 16194},
-										alternatives: []interface{}{
-											&litMatcher{
-												pos:        position{line: 655, col: 7, offset: 16194},
-												val:        "'",
-												ignoreCase: false,
-											},
-											&ruleRefExpr{
-												pos:  position{line: 655, col: 13, offset: 16200},
-												name: "escapedChar",
-											},
-										},
+								&labeledExpr{
+									pos:   position{line: 564, col: 5, offset: 18375},
+									label: "num",
+									expr: &ruleRefExpr{
+										pos:  position{line: 564, col: 9, offset: 18379},
+										name: "number",
 									},
 								},
-								&anyMatcher{
-									line: 655, col: 26, offset: 16213,
+								&zeroOrOneExpr{
+									pos: position{line: 564, col: 16, offset: 18386},
+									expr: &ruleRefExpr{
+										pos:  position{line: 564, col: 16, offset: 18386},
+										name: "_",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 564, col: 19, offset: 18389},
+									name: "sec_abbrev",
 								},
 							},
 						},
 					},
+				},
+			},
+		},
+		{
+			name: "minutes",
+			pos:  position{line: 565, col: 1, offset: 18434},
+			expr: &choiceExpr{
+				pos: position{line: 566, col: 5, offset: 18446},
+				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 656, col: 5, offset: 16250},
-						run: (*parser).callonsingleQuotedChar9,
+						pos: position{line: 566, col: 5, offset: 18446},
+						run: (*parser).callonminutes2,
+						expr: &litMatcher{
+							pos:        position{line: 566, col: 5, offset: 18446},
+							val:        "minute",
+							ignoreCase: false,
+						},
+					},
+					&actionExpr{
+						pos: position{line: 567, col: 5, offset: 18492},
+						run: (*parser).callonminutes4,
 						expr: &seqExpr{
-							pos: position{line: 656, col: 5, offset: 16250},
+							pos: position{line: 567, col: 5, offset: 18492},
 							exprs: []interface{}{
-								&litMatcher{
-									pos:        position{line: 656, col: 5, offset: 16250},
-									val:        "\\",
-									ignoreCase: false,
-								},
 								&labeledExpr{
-									pos:   position{line: 656, col: 10, offset: 16255},
-									label: "s",
+									pos:   position{line: 567, col: 5, offset: 18492},
+									label: "num",
 									expr: &ruleRefExpr{
-										pos:  position{line: 656, col: 12, offset: 16257},
-										name: "escapeSequence",
+										pos:  position{line: 567, col: 9, offset: 18496},
+										name: "number",
+									},
+								},
+								&zeroOrOneExpr{
+									pos: position{line: 567, col: 16, offset: 18503},
+									expr: &ruleRefExpr{
+										pos:  position{line: 567, col: 16, offset: 18503},
+										name: "_",
 									},
 								},
+								&ruleRefExpr{
+									pos:  position{line: 567, col: 19, offset: 18506},
+									name: "min_abbrev",
+								},
 							},
 						},
 					},
@@ -5229,143 +6368,206 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "escapeSequence",
-			pos:  position{line: 658, col: 1, offset: 16291},
+			name: "hours",
+			pos:  position{line: 568, col: 1, offset: 18560},
 			expr: &choiceExpr{
-				pos: position{line: 659, col: 5, offset: 16310},
+				pos: position{line: 569, col: 5, offset: 18570},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 659, col: 5, offset: 16310},
-						run: (*parser).callonescapeSequence2,
+						pos: position{line: 569, col: 5, offset: 18570},
+						run: (*parser).callonhours2,
+						expr: &litMatcher{
+							pos:        position{line: 569, col: 5, offset: 18570},
+							val:        "hour",
+							ignoreCase: false,
+						},
+					},
+					&actionExpr{
+						pos: position{line: 570, col: 5, offset: 18616},
+						run: (*parser).callonhours4,
 						expr: &seqExpr{
-							pos: position{line: 659, col: 5, offset: 16310},
+							pos: position{line: 570, col: 5, offset: 18616},
 							exprs: []interface{}{
-								&litMatcher{
-									pos:        position{line: 659, col: 5, offset: 16310},
-									val:        "x",
-									ignoreCase: false,
+								&labeledExpr{
+									pos:   position{line: 570, col: 5, offset: 18616},
+									label: "num",
+									expr: &ruleRefExpr{
+										pos:  position{line: 570, col: 9, offset: 18620},
+										name: "number",
+									},
 								},
-								&ruleRefExpr{
-									pos:  position{line: 659, col: 9, offset: 16314},
-									name: "hexdigit",
+								&zeroOrOneExpr{
+									pos: position{line: 570, col: 16, offset: 18627},
+									expr: &ruleRefExpr{
+										pos:  position{line: 570, col: 16, offset: 18627},
+										name: "_",
+									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 659, col: 18, offset: 16323},
-									name: "hexdigit",
+									pos:  position{line: 570, col: 19, offset: 18630},
+									name: "hour_abbrev",
 								},
 							},
 						},
 					},
-					&ruleRefExpr{
-						pos:  position{line: 660, col: 5, offset: 16374},
-						name: "singleCharEscape",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 661, col: 5, offset: 16395},
-						name: "unicodeEscape",
-					},
 				},
 			},
 		},
 		{
-			name: "singleCharEscape",
-			pos:  position{line: 663, col: 1, offset: 16410},
+			name: "days",
+			pos:  position{line: 571, col: 1, offset: 18687},
 			expr: &choiceExpr{
-				pos: position{line: 664, col: 5, offset: 16431},
+				pos: position{line: 572, col: 5, offset: 18696},
 				alternatives: []interface{}{
-					&litMatcher{
-						pos:        position{line: 664, col: 5, offset: 16431},
-						val:        "'",
-						ignoreCase: false,
-					},
-					&litMatcher{
-						pos:        position{line: 665, col: 5, offset: 16439},
-						val:        "\"",
-						ignoreCase: false,
-					},
-					&litMatcher{
-						pos:        position{line: 666, col: 5, offset: 16447},
-						val:        "\\",
-						ignoreCase: false,
-					},
-					&actionExpr{
-						pos: position{line: 667, col: 5, offset: 16456},
-						run: (*parser).callonsingleCharEscape5,
-						expr: &litMatcher{
-							pos:        position{line: 667, col: 5, offset: 16456},
-							val:        "b",
-							ignoreCase: false,
-						},
-					},
 					&actionExpr{
-						pos: position{line: 668, col: 5, offset: 16485},
-						run: (*parser).callonsingleCharEscape7,
+						pos: position{line: 572, col: 5, offset: 18696},
+						run: (*parser).callondays2,
 						expr: &litMatcher{
-							pos:        position{line: 668, col: 5, offset: 16485},
-							val:        "f",
+							pos:        position{line: 572, col: 5, offset: 18696},
+							val:        "day",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 669, col: 5, offset: 16514},
-						run: (*parser).callonsingleCharEscape9,
-						expr: &litMatcher{
-							pos:        position{line: 669, col: 5, offset: 16514},
-							val:        "n",
-							ignoreCase: false,
+						pos: position{line: 573, col: 5, offset: 18744},
+						run: (*parser).callondays4,
+						expr: &seqExpr{
+							pos: position{line: 573, col: 5, offset: 18744},
+							exprs: []interface{}{
+								&labeledExpr{
+									pos:   position{line: 573, col: 5, offset: 18744},
+									label: "num",
+									expr: &ruleRefExpr{
+										pos:  position{line: 573, col: 9, offset: 18748},
+										name: "number",
+									},
+								},
+								&zeroOrOneExpr{
+									pos: position{line: 573, col: 16, offset: 18755},
+									expr: &ruleRefExpr{
+										pos:  position{line: 573, col: 16, offset: 18755},
+										name: "_",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 573, col: 19, offset: 18758},
+									name: "day_abbrev",
+								},
+							},
 						},
 					},
-					&actionExpr{
-						pos: position{line: 670, col: 5, offset: 16543},
-						run: (*parser).callonsingleCharEscape11,
-						expr: &litMatcher{
-							pos:        position{line: 670, col: 5, offset: 16543},
-							val:        "r",
-							ignoreCase: false,
+				},
+			},
+		},
+		{
+			name: "weeks",
+			pos:  position{line: 574, col: 1, offset: 18817},
+			expr: &actionExpr{
+				pos: position{line: 575, col: 5, offset: 18827},
+				run: (*parser).callonweeks1,
+				expr: &seqExpr{
+					pos: position{line: 575, col: 5, offset: 18827},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 575, col: 5, offset: 18827},
+							label: "num",
+							expr: &ruleRefExpr{
+								pos:  position{line: 575, col: 9, offset: 18831},
+								name: "number",
+							},
 						},
-					},
-					&actionExpr{
-						pos: position{line: 671, col: 5, offset: 16572},
-						run: (*parser).callonsingleCharEscape13,
-						expr: &litMatcher{
-							pos:        position{line: 671, col: 5, offset: 16572},
-							val:        "t",
-							ignoreCase: false,
+						&zeroOrOneExpr{
+							pos: position{line: 575, col: 16, offset: 18838},
+							expr: &ruleRefExpr{
+								pos:  position{line: 575, col: 16, offset: 18838},
+								name: "_",
+							},
 						},
-					},
-					&actionExpr{
-						pos: position{line: 672, col: 5, offset: 16601},
-						run: (*parser).callonsingleCharEscape15,
-						expr: &litMatcher{
-							pos:        position{line: 672, col: 5, offset: 16601},
-							val:        "v",
-							ignoreCase: false,
+						&ruleRefExpr{
+							pos:  position{line: 575, col: 19, offset: 18841},
+							name: "week_abbrev",
 						},
 					},
 				},
 			},
 		},
 		{
-			name: "searchEscape",
-			pos:  position{line: 674, col: 1, offset: 16627},
+			name: "calendarInterval",
+			pos:  position{line: 576, col: 1, offset: 18903},
 			expr: &choiceExpr{
-				pos: position{line: 675, col: 5, offset: 16644},
+				pos: position{line: 577, col: 5, offset: 18924},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 675, col: 5, offset: 16644},
-						run: (*parser).callonsearchEscape2,
+						pos: position{line: 577, col: 5, offset: 18924},
+						run: (*parser).calloncalendarInterval2,
+						expr: &seqExpr{
+							pos: position{line: 577, col: 5, offset: 18924},
+							exprs: []interface{}{
+								&labeledExpr{
+									pos:   position{line: 577, col: 5, offset: 18924},
+									label: "num",
+									expr: &ruleRefExpr{
+										pos:  position{line: 577, col: 9, offset: 18928},
+										name: "number",
+									},
+								},
+								&zeroOrOneExpr{
+									pos: position{line: 577, col: 16, offset: 18935},
+									expr: &ruleRefExpr{
+										pos:  position{line: 577, col: 16, offset: 18935},
+										name: "_",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 577, col: 19, offset: 18938},
+									name: "month_abbrev",
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 578, col: 5, offset: 18999},
+						run: (*parser).calloncalendarInterval9,
+						expr: &seqExpr{
+							pos: position{line: 578, col: 5, offset: 18999},
+							exprs: []interface{}{
+								&labeledExpr{
+									pos:   position{line: 578, col: 5, offset: 18999},
+									label: "num",
+									expr: &ruleRefExpr{
+										pos:  position{line: 578, col: 9, offset: 19003},
+										name: "number",
+									},
+								},
+								&zeroOrOneExpr{
+									pos: position{line: 578, col: 16, offset: 19010},
+									expr: &ruleRefExpr{
+										pos:  position{line: 578, col: 16, offset: 19010},
+										name: "_",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 578, col: 19, offset: 19013},
+									name: "year_abbrev",
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 579, col: 5, offset: 19072},
+						run: (*parser).calloncalendarInterval16,
 						expr: &litMatcher{
-							pos:        position{line: 675, col: 5, offset: 16644},
-							val:        "=",
+							pos:        position{line: 579, col: 5, offset: 19072},
+							val:        "month",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 676, col: 5, offset: 16672},
-						run: (*parser).callonsearchEscape4,
+						pos: position{line: 580, col: 5, offset: 19126},
+						run: (*parser).calloncalendarInterval18,
 						expr: &litMatcher{
-							pos:        position{line: 676, col: 5, offset: 16672},
-							val:        "*",
+							pos:        position{line: 580, col: 5, offset: 19126},
+							val:        "year",
 							ignoreCase: false,
 						},
 					},
@@ -5373,1413 +6575,3270 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "unicodeEscape",
-			pos:  position{line: 678, col: 1, offset: 16699},
+			name: "dayInterval",
+			pos:  position{line: 581, col: 1, offset: 19174},
 			expr: &choiceExpr{
-				pos: position{line: 679, col: 5, offset: 16717},
+				pos: position{line: 582, col: 5, offset: 19190},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 679, col: 5, offset: 16717},
-						run: (*parser).callonunicodeEscape2,
+						pos: position{line: 582, col: 5, offset: 19190},
+						run: (*parser).callondayInterval2,
 						expr: &seqExpr{
-							pos: position{line: 679, col: 5, offset: 16717},
+							pos: position{line: 582, col: 5, offset: 19190},
 							exprs: []interface{}{
-								&litMatcher{
-									pos:        position{line: 679, col: 5, offset: 16717},
-									val:        "u",
-									ignoreCase: false,
-								},
 								&labeledExpr{
-									pos:   position{line: 679, col: 9, offset: 16721},
-									label: "chars",
-									expr: &seqExpr{
-										pos: position{line: 679, col: 16, offset: 16728},
-										exprs: []interface{}{
-											&ruleRefExpr{
-												pos:  position{line: 679, col: 16, offset: 16728},
-												name: "hexdigit",
-											},
-											&ruleRefExpr{
-												pos:  position{line: 679, col: 25, offset: 16737},
-												name: "hexdigit",
-											},
-											&ruleRefExpr{
-												pos:  position{line: 679, col: 34, offset: 16746},
-												name: "hexdigit",
-											},
-											&ruleRefExpr{
-												pos:  position{line: 679, col: 43, offset: 16755},
-												name: "hexdigit",
-											},
-										},
+									pos:   position{line: 582, col: 5, offset: 19190},
+									label: "num",
+									expr: &ruleRefExpr{
+										pos:  position{line: 582, col: 9, offset: 19194},
+										name: "number",
+									},
+								},
+								&zeroOrOneExpr{
+									pos: position{line: 582, col: 16, offset: 19201},
+									expr: &ruleRefExpr{
+										pos:  position{line: 582, col: 16, offset: 19201},
+										name: "_",
 									},
 								},
+								&ruleRefExpr{
+									pos:  position{line: 582, col: 19, offset: 19204},
+									name: "day_abbrev",
+								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 682, col: 5, offset: 16818},
-						run: (*parser).callonunicodeEscape11,
+						pos: position{line: 583, col: 5, offset: 19261},
+						run: (*parser).callondayInterval9,
 						expr: &seqExpr{
-							pos: position{line: 682, col: 5, offset: 16818},
+							pos: position{line: 583, col: 5, offset: 19261},
 							exprs: []interface{}{
-								&litMatcher{
-									pos:        position{line: 682, col: 5, offset: 16818},
-									val:        "u",
-									ignoreCase: false,
-								},
-								&litMatcher{
-									pos:        position{line: 682, col: 9, offset: 16822},
-									val:        "{",
-									ignoreCase: false,
-								},
 								&labeledExpr{
-									pos:   position{line: 682, col: 13, offset: 16826},
-									label: "chars",
-									expr: &seqExpr{
-										pos: position{line: 682, col: 20, offset: 16833},
-										exprs: []interface{}{
-											&ruleRefExpr{
-												pos:  position{line: 682, col: 20, offset: 16833},
-												name: "hexdigit",
-											},
-											&zeroOrOneExpr{
-												pos: position{line: 682, col: 29, offset: 16842},
-												expr: &ruleRefExpr{
-													pos:  position{line: 682, col: 29, offset: 16842},
-													name: "hexdigit",
-												},
-											},
-											&zeroOrOneExpr{
-												pos: position{line: 682, col: 39, offset: 16852},
-												expr: &ruleRefExpr{
-													pos:  position{line: 682, col: 39, offset: 16852},
-													name: "hexdigit",
-												},
-											},
-											&zeroOrOneExpr{
-												pos: position{line: 682, col: 49, offset: 16862},
-												expr: &ruleRefExpr{
-													pos:  position{line: 682, col: 49, offset: 16862},
-													name: "hexdigit",
-												},
-											},
-											&zeroOrOneExpr{
-												pos: position{line: 682, col: 59, offset: 16872},
-												expr: &ruleRefExpr{
-													pos:  position{line: 682, col: 59, offset: 16872},
-													name: "hexdigit",
-												},
-											},
-											&zeroOrOneExpr{
-												pos: position{line: 682, col: 69, offset: 16882},
-												expr: &ruleRefExpr{
-													pos:  position{line: 682, col: 69, offset: 16882},
-													name: "hexdigit",
-												},
-											},
-										},
+									pos:   position{line: 583, col: 5, offset: 19261},
+									label: "num",
+									expr: &ruleRefExpr{
+										pos:  position{line: 583, col: 9, offset: 19265},
+										name: "number",
 									},
 								},
-								&litMatcher{
-									pos:        position{line: 682, col: 80, offset: 16893},
-									val:        "}",
-									ignoreCase: false,
+								&zeroOrOneExpr{
+									pos: position{line: 583, col: 16, offset: 19272},
+									expr: &ruleRefExpr{
+										pos:  position{line: 583, col: 16, offset: 19272},
+										name: "_",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 583, col: 19, offset: 19275},
+									name: "week_abbrev",
 								},
 							},
 						},
 					},
+					&actionExpr{
+						pos: position{line: 584, col: 5, offset: 19334},
+						run: (*parser).callondayInterval16,
+						expr: &litMatcher{
+							pos:        position{line: 584, col: 5, offset: 19334},
+							val:        "day",
+							ignoreCase: false,
+						},
+					},
+					&actionExpr{
+						pos: position{line: 585, col: 5, offset: 19384},
+						run: (*parser).callondayInterval18,
+						expr: &litMatcher{
+							pos:        position{line: 585, col: 5, offset: 19384},
+							val:        "week",
+							ignoreCase: false,
+						},
+					},
 				},
 			},
 		},
 		{
-			name: "reString",
-			pos:  position{line: 686, col: 1, offset: 16947},
+			name: "number",
+			pos:  position{line: 586, col: 1, offset: 19432},
+			expr: &ruleRefExpr{
+				pos:  position{line: 586, col: 10, offset: 19441},
+				name: "unsignedInteger",
+			},
+		},
+		{
+			name: "addr",
+			pos:  position{line: 587, col: 1, offset: 19457},
 			expr: &actionExpr{
-				pos: position{line: 687, col: 5, offset: 16960},
-				run: (*parser).callonreString1,
+				pos: position{line: 588, col: 5, offset: 19466},
+				run: (*parser).callonaddr1,
+				expr: &labeledExpr{
+					pos:   position{line: 588, col: 5, offset: 19466},
+					label: "a",
+					expr: &seqExpr{
+						pos: position{line: 588, col: 8, offset: 19469},
+						exprs: []interface{}{
+							&ruleRefExpr{
+								pos:  position{line: 588, col: 8, offset: 19469},
+								name: "unsignedInteger",
+							},
+							&litMatcher{
+								pos:        position{line: 588, col: 24, offset: 19485},
+								val:        ".",
+								ignoreCase: false,
+							},
+							&ruleRefExpr{
+								pos:  position{line: 588, col: 28, offset: 19489},
+								name: "unsignedInteger",
+							},
+							&litMatcher{
+								pos:        position{line: 588, col: 44, offset: 19505},
+								val:        ".",
+								ignoreCase: false,
+							},
+							&ruleRefExpr{
+								pos:  position{line: 588, col: 48, offset: 19509},
+								name: "unsignedInteger",
+							},
+							&litMatcher{
+								pos:        position{line: 588, col: 64, offset: 19525},
+								val:        ".",
+								ignoreCase: false,
+							},
+							&ruleRefExpr{
+								pos:  position{line: 588, col: 68, offset: 19529},
+								name: "unsignedInteger",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "port",
+			pos:  position{line: 589, col: 1, offset: 19577},
+			expr: &actionExpr{
+				pos: position{line: 590, col: 5, offset: 19586},
+				run: (*parser).callonport1,
 				expr: &seqExpr{
-					pos: position{line: 687, col: 5, offset: 16960},
+					pos: position{line: 590, col: 5, offset: 19586},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 687, col: 5, offset: 16960},
-							val:        "/",
+							pos:        position{line: 590, col: 5, offset: 19586},
+							val:        ":",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 687, col: 9, offset: 16964},
+							pos:   position{line: 590, col: 9, offset: 19590},
 							label: "v",
 							expr: &ruleRefExpr{
-								pos:  position{line: 687, col: 11, offset: 16966},
-								name: "reBody",
+								pos:  position{line: 590, col: 11, offset: 19592},
+								name: "suint",
 							},
 						},
-						&litMatcher{
-							pos:        position{line: 687, col: 18, offset: 16973},
-							val:        "/",
-							ignoreCase: false,
-						},
 					},
 				},
 			},
 		},
 		{
-			name: "reBody",
-			pos:  position{line: 689, col: 1, offset: 16996},
-			expr: &actionExpr{
-				pos: position{line: 690, col: 5, offset: 17007},
-				run: (*parser).callonreBody1,
-				expr: &oneOrMoreExpr{
-					pos: position{line: 690, col: 5, offset: 17007},
-					expr: &choiceExpr{
-						pos: position{line: 690, col: 6, offset: 17008},
-						alternatives: []interface{}{
-							&charClassMatcher{
-								pos:        position{line: 690, col: 6, offset: 17008},
-								val:        "[^/\\\\]",
-								chars:      []rune{'/', '\\'},
-								ignoreCase: false,
-								inverted:   true,
+			name: "ip6addr",
+			pos:  position{line: 591, col: 1, offset: 19616},
+			expr: &choiceExpr{
+				pos: position{line: 592, col: 5, offset: 19628},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 592, col: 5, offset: 19628},
+						run: (*parser).callonip6addr2,
+						expr: &seqExpr{
+							pos: position{line: 592, col: 5, offset: 19628},
+							exprs: []interface{}{
+								&labeledExpr{
+									pos:   position{line: 592, col: 5, offset: 19628},
+									label: "a",
+									expr: &oneOrMoreExpr{
+										pos: position{line: 592, col: 7, offset: 19630},
+										expr: &ruleRefExpr{
+											pos:  position{line: 592, col: 8, offset: 19631},
+											name: "h_prepend",
+										},
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 592, col: 20, offset: 19643},
+									label: "b",
+									expr: &ruleRefExpr{
+										pos:  position{line: 592, col: 22, offset: 19645},
+										name: "ip6tail",
+									},
+								},
 							},
-							&litMatcher{
-								pos:        position{line: 690, col: 13, offset: 17015},
-								val:        "\\/",
-								ignoreCase: false,
+						},
+					},
+					&actionExpr{
+						pos: position{line: 595, col: 5, offset: 19709},
+						run: (*parser).callonip6addr9,
+						expr: &seqExpr{
+							pos: position{line: 595, col: 5, offset: 19709},
+							exprs: []interface{}{
+								&labeledExpr{
+									pos:   position{line: 595, col: 5, offset: 19709},
+									label: "a",
+									expr: &ruleRefExpr{
+										pos:  position{line: 595, col: 7, offset: 19711},
+										name: "h16",
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 595, col: 11, offset: 19715},
+									label: "b",
+									expr: &zeroOrMoreExpr{
+										pos: position{line: 595, col: 13, offset: 19717},
+										expr: &ruleRefExpr{
+											pos:  position{line: 595, col: 14, offset: 19718},
+											name: "h_append",
+										},
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 595, col: 25, offset: 19729},
+									val:        "::",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 595, col: 30, offset: 19734},
+									label: "d",
+									expr: &zeroOrMoreExpr{
+										pos: position{line: 595, col: 32, offset: 19736},
+										expr: &ruleRefExpr{
+											pos:  position{line: 595, col: 33, offset: 19737},
+											name: "h_prepend",
+										},
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 595, col: 45, offset: 19749},
+									label: "e",
+									expr: &ruleRefExpr{
+										pos:  position{line: 595, col: 47, offset: 19751},
+										name: "ip6tail",
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 598, col: 5, offset: 19850},
+						run: (*parser).callonip6addr22,
+						expr: &seqExpr{
+							pos: position{line: 598, col: 5, offset: 19850},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 598, col: 5, offset: 19850},
+									val:        "::",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 598, col: 10, offset: 19855},
+									label: "a",
+									expr: &zeroOrMoreExpr{
+										pos: position{line: 598, col: 12, offset: 19857},
+										expr: &ruleRefExpr{
+											pos:  position{line: 598, col: 13, offset: 19858},
+											name: "h_prepend",
+										},
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 598, col: 25, offset: 19870},
+									label: "b",
+									expr: &ruleRefExpr{
+										pos:  position{line: 598, col: 27, offset: 19872},
+										name: "ip6tail",
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 601, col: 5, offset: 19943},
+						run: (*parser).callonip6addr30,
+						expr: &seqExpr{
+							pos: position{line: 601, col: 5, offset: 19943},
+							exprs: []interface{}{
+								&labeledExpr{
+									pos:   position{line: 601, col: 5, offset: 19943},
+									label: "a",
+									expr: &ruleRefExpr{
+										pos:  position{line: 601, col: 7, offset: 19945},
+										name: "h16",
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 601, col: 11, offset: 19949},
+									label: "b",
+									expr: &zeroOrMoreExpr{
+										pos: position{line: 601, col: 13, offset: 19951},
+										expr: &ruleRefExpr{
+											pos:  position{line: 601, col: 14, offset: 19952},
+											name: "h_append",
+										},
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 601, col: 25, offset: 19963},
+									val:        "::",
+									ignoreCase: false,
+								},
 							},
 						},
 					},
+					&actionExpr{
+						pos: position{line: 604, col: 5, offset: 20031},
+						run: (*parser).callonip6addr38,
+						expr: &litMatcher{
+							pos:        position{line: 604, col: 5, offset: 20031},
+							val:        "::",
+							ignoreCase: false,
+						},
+					},
 				},
 			},
 		},
 		{
-			name: "escapedChar",
-			pos:  position{line: 692, col: 1, offset: 17055},
-			expr: &charClassMatcher{
-				pos:        position{line: 693, col: 5, offset: 17071},
-				val:        "[\\x00-\\x1f\\\\]",
-				chars:      []rune{'\\'},
-				ranges:     []rune{'\x00', '\x1f'},
-				ignoreCase: false,
-				inverted:   false,
-			},
-		},
-		{
-			name: "ws",
-			pos:  position{line: 695, col: 1, offset: 17086},
+			name: "ip6tail",
+			pos:  position{line: 607, col: 1, offset: 20067},
 			expr: &choiceExpr{
-				pos: position{line: 696, col: 5, offset: 17093},
+				pos: position{line: 608, col: 5, offset: 20079},
 				alternatives: []interface{}{
-					&litMatcher{
-						pos:        position{line: 696, col: 5, offset: 17093},
-						val:        "\t",
-						ignoreCase: false,
+					&ruleRefExpr{
+						pos:  position{line: 608, col: 5, offset: 20079},
+						name: "addr",
 					},
-					&litMatcher{
-						pos:        position{line: 697, col: 5, offset: 17102},
-						val:        "\v",
-						ignoreCase: false,
+					&ruleRefExpr{
+						pos:  position{line: 609, col: 5, offset: 20088},
+						name: "h16",
 					},
-					&litMatcher{
-						pos:        position{line: 698, col: 5, offset: 17111},
-						val:        "\f",
-						ignoreCase: false,
+				},
+			},
+		},
+		{
+			name: "h_append",
+			pos:  position{line: 610, col: 1, offset: 20092},
+			expr: &actionExpr{
+				pos: position{line: 610, col: 12, offset: 20103},
+				run: (*parser).callonh_append1,
+				expr: &seqExpr{
+					pos: position{line: 610, col: 12, offset: 20103},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 610, col: 12, offset: 20103},
+							val:        ":",
+							ignoreCase: false,
+						},
+						&labeledExpr{
+							pos:   position{line: 610, col: 16, offset: 20107},
+							label: "v",
+							expr: &ruleRefExpr{
+								pos:  position{line: 610, col: 18, offset: 20109},
+								name: "h16",
+							},
+						},
 					},
-					&litMatcher{
-						pos:        position{line: 699, col: 5, offset: 17120},
-						val:        " ",
-						ignoreCase: false,
+				},
+			},
+		},
+		{
+			name: "h_prepend",
+			pos:  position{line: 611, col: 1, offset: 20146},
+			expr: &actionExpr{
+				pos: position{line: 611, col: 13, offset: 20158},
+				run: (*parser).callonh_prepend1,
+				expr: &seqExpr{
+					pos: position{line: 611, col: 13, offset: 20158},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 611, col: 13, offset: 20158},
+							label: "v",
+							expr: &ruleRefExpr{
+								pos:  position{line: 611, col: 15, offset: 20160},
+								name: "h16",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 611, col: 19, offset: 20164},
+							val:        ":",
+							ignoreCase: false,
+						},
 					},
-					&litMatcher{
-						pos:        position{line: 700, col: 5, offset: 17128},
-						val:        "\u00a0",
-						ignoreCase: false,
+				},
+			},
+		},
+		{
+			name: "sub_addr",
+			pos:  position{line: 612, col: 1, offset: 20201},
+			expr: &choiceExpr{
+				pos: position{line: 613, col: 5, offset: 20214},
+				alternatives: []interface{}{
+					&ruleRefExpr{
+						pos:  position{line: 613, col: 5, offset: 20214},
+						name: "addr",
 					},
-					&litMatcher{
-						pos:        position{line: 701, col: 5, offset: 17141},
-						val:        "\ufeff",
+					&actionExpr{
+						pos: position{line: 614, col: 5, offset: 20223},
+						run: (*parser).callonsub_addr3,
+						expr: &labeledExpr{
+							pos:   position{line: 614, col: 5, offset: 20223},
+							label: "a",
+							expr: &seqExpr{
+								pos: position{line: 614, col: 8, offset: 20226},
+								exprs: []interface{}{
+									&ruleRefExpr{
+										pos:  position{line: 614, col: 8, offset: 20226},
+										name: "unsignedInteger",
+									},
+									&litMatcher{
+										pos:        position{line: 614, col: 24, offset: 20242},
+										val:        ".",
+										ignoreCase: false,
+									},
+									&ruleRefExpr{
+										pos:  position{line: 614, col: 28, offset: 20246},
+										name: "unsignedInteger",
+									},
+									&litMatcher{
+										pos:        position{line: 614, col: 44, offset: 20262},
+										val:        ".",
+										ignoreCase: false,
+									},
+									&ruleRefExpr{
+										pos:  position{line: 614, col: 48, offset: 20266},
+										name: "unsignedInteger",
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 615, col: 5, offset: 20326},
+						run: (*parser).callonsub_addr11,
+						expr: &labeledExpr{
+							pos:   position{line: 615, col: 5, offset: 20326},
+							label: "a",
+							expr: &seqExpr{
+								pos: position{line: 615, col: 8, offset: 20329},
+								exprs: []interface{}{
+									&ruleRefExpr{
+										pos:  position{line: 615, col: 8, offset: 20329},
+										name: "unsignedInteger",
+									},
+									&litMatcher{
+										pos:        position{line: 615, col: 24, offset: 20345},
+										val:        ".",
+										ignoreCase: false,
+									},
+									&ruleRefExpr{
+										pos:  position{line: 615, col: 28, offset: 20349},
+										name: "unsignedInteger",
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 616, col: 5, offset: 20411},
+						run: (*parser).callonsub_addr17,
+						expr: &labeledExpr{
+							pos:   position{line: 616, col: 5, offset: 20411},
+							label: "a",
+							expr: &ruleRefExpr{
+								pos:  position{line: 616, col: 7, offset: 20413},
+								name: "unsignedInteger",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "subnet",
+			pos:  position{line: 617, col: 1, offset: 20471},
+			expr: &actionExpr{
+				pos: position{line: 618, col: 5, offset: 20482},
+				run: (*parser).callonsubnet1,
+				expr: &seqExpr{
+					pos: position{line: 618, col: 5, offset: 20482},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 618, col: 5, offset: 20482},
+							label: "a",
+							expr: &ruleRefExpr{
+								pos:  position{line: 618, col: 7, offset: 20484},
+								name: "sub_addr",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 618, col: 16, offset: 20493},
+							val:        "/",
+							ignoreCase: false,
+						},
+						&labeledExpr{
+							pos:   position{line: 618, col: 20, offset: 20497},
+							label: "m",
+							expr: &ruleRefExpr{
+								pos:  position{line: 618, col: 22, offset: 20499},
+								name: "unsignedInteger",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ip6subnet",
+			pos:  position{line: 621, col: 1, offset: 20582},
+			expr: &actionExpr{
+				pos: position{line: 622, col: 5, offset: 20596},
+				run: (*parser).callonip6subnet1,
+				expr: &seqExpr{
+					pos: position{line: 622, col: 5, offset: 20596},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 622, col: 5, offset: 20596},
+							label: "a",
+							expr: &ruleRefExpr{
+								pos:  position{line: 622, col: 7, offset: 20598},
+								name: "ip6addr",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 622, col: 15, offset: 20606},
+							val:        "/",
+							ignoreCase: false,
+						},
+						&labeledExpr{
+							pos:   position{line: 622, col: 19, offset: 20610},
+							label: "m",
+							expr: &ruleRefExpr{
+								pos:  position{line: 622, col: 21, offset: 20612},
+								name: "unsignedInteger",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "unsignedInteger",
+			pos:  position{line: 625, col: 1, offset: 20685},
+			expr: &actionExpr{
+				pos: position{line: 626, col: 5, offset: 20705},
+				run: (*parser).callonunsignedInteger1,
+				expr: &labeledExpr{
+					pos:   position{line: 626, col: 5, offset: 20705},
+					label: "s",
+					expr: &ruleRefExpr{
+						pos:  position{line: 626, col: 7, offset: 20707},
+						name: "suint",
+					},
+				},
+			},
+		},
+		{
+			name: "suint",
+			pos:  position{line: 627, col: 1, offset: 20741},
+			expr: &actionExpr{
+				pos: position{line: 628, col: 5, offset: 20751},
+				run: (*parser).callonsuint1,
+				expr: &oneOrMoreExpr{
+					pos: position{line: 628, col: 5, offset: 20751},
+					expr: &charClassMatcher{
+						pos:        position{line: 628, col: 5, offset: 20751},
+						val:        "[0-9]",
+						ranges:     []rune{'0', '9'},
 						ignoreCase: false,
+						inverted:   false,
 					},
 				},
 			},
 		},
 		{
-			name:        "_",
-			displayName: "\"whitespace\"",
-			pos:         position{line: 703, col: 1, offset: 17151},
-			expr: &oneOrMoreExpr{
-				pos: position{line: 703, col: 18, offset: 17168},
-				expr: &ruleRefExpr{
-					pos:  position{line: 703, col: 18, offset: 17168},
-					name: "ws",
+			name: "integer",
+			pos:  position{line: 629, col: 1, offset: 20789},
+			expr: &actionExpr{
+				pos: position{line: 630, col: 5, offset: 20801},
+				run: (*parser).calloninteger1,
+				expr: &labeledExpr{
+					pos:   position{line: 630, col: 5, offset: 20801},
+					label: "s",
+					expr: &ruleRefExpr{
+						pos:  position{line: 630, col: 7, offset: 20803},
+						name: "sinteger",
+					},
 				},
 			},
 		},
 		{
-			name: "__",
-			pos:  position{line: 704, col: 1, offset: 17172},
-			expr: &zeroOrMoreExpr{
-				pos: position{line: 704, col: 6, offset: 17177},
-				expr: &ruleRefExpr{
-					pos:  position{line: 704, col: 6, offset: 17177},
-					name: "ws",
+			name: "sinteger",
+			pos:  position{line: 631, col: 1, offset: 20840},
+			expr: &actionExpr{
+				pos: position{line: 632, col: 5, offset: 20853},
+				run: (*parser).callonsinteger1,
+				expr: &seqExpr{
+					pos: position{line: 632, col: 5, offset: 20853},
+					exprs: []interface{}{
+						&zeroOrOneExpr{
+							pos: position{line: 632, col: 5, offset: 20853},
+							expr: &charClassMatcher{
+								pos:        position{line: 632, col: 5, offset: 20853},
+								val:        "[+-]",
+								chars:      []rune{'+', '-'},
+								ignoreCase: false,
+								inverted:   false,
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 632, col: 11, offset: 20859},
+							name: "suint",
+						},
+					},
 				},
 			},
 		},
 		{
-			name: "EOF",
-			pos:  position{line: 706, col: 1, offset: 17182},
-			expr: &notExpr{
-				pos: position{line: 706, col: 7, offset: 17188},
-				expr: &anyMatcher{
-					line: 706, col: 8, offset: 17189,
+			name: "double",
+			pos:  position{line: 633, col: 1, offset: 20896},
+			expr: &actionExpr{
+				pos: position{line: 634, col: 5, offset: 20907},
+				run: (*parser).callondouble1,
+				expr: &labeledExpr{
+					pos:   position{line: 634, col: 5, offset: 20907},
+					label: "s",
+					expr: &ruleRefExpr{
+						pos:  position{line: 634, col: 7, offset: 20909},
+						name: "sdouble",
+					},
 				},
 			},
 		},
-	},
+		{
+			name: "sdouble",
+			pos:  position{line: 637, col: 1, offset: 20955},
+			expr: &choiceExpr{
+				pos: position{line: 638, col: 5, offset: 20967},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 638, col: 5, offset: 20967},
+						run: (*parser).callonsdouble2,
+						expr: &seqExpr{
+							pos: position{line: 638, col: 5, offset: 20967},
+							exprs: []interface{}{
+								&zeroOrOneExpr{
+									pos: position{line: 638, col: 5, offset: 20967},
+									expr: &litMatcher{
+										pos:        position{line: 638, col: 5, offset: 20967},
+										val:        "-",
+										ignoreCase: false,
+									},
+								},
+								&oneOrMoreExpr{
+									pos: position{line: 638, col: 10, offset: 20972},
+									expr: &ruleRefExpr{
+										pos:  position{line: 638, col: 10, offset: 20972},
+										name: "doubleInteger",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 638, col: 25, offset: 20987},
+									val:        ".",
+									ignoreCase: false,
+								},
+								&oneOrMoreExpr{
+									pos: position{line: 638, col: 29, offset: 20991},
+									expr: &ruleRefExpr{
+										pos:  position{line: 638, col: 29, offset: 20991},
+										name: "doubleDigit",
+									},
+								},
+								&zeroOrOneExpr{
+									pos: position{line: 638, col: 42, offset: 21004},
+									expr: &ruleRefExpr{
+										pos:  position{line: 638, col: 42, offset: 21004},
+										name: "exponentPart",
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 641, col: 5, offset: 21063},
+						run: (*parser).callonsdouble13,
+						expr: &seqExpr{
+							pos: position{line: 641, col: 5, offset: 21063},
+							exprs: []interface{}{
+								&zeroOrOneExpr{
+									pos: position{line: 641, col: 5, offset: 21063},
+									expr: &litMatcher{
+										pos:        position{line: 641, col: 5, offset: 21063},
+										val:        "-",
+										ignoreCase: false,
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 641, col: 10, offset: 21068},
+									val:        ".",
+									ignoreCase: false,
+								},
+								&oneOrMoreExpr{
+									pos: position{line: 641, col: 14, offset: 21072},
+									expr: &ruleRefExpr{
+										pos:  position{line: 641, col: 14, offset: 21072},
+										name: "doubleDigit",
+									},
+								},
+								&zeroOrOneExpr{
+									pos: position{line: 641, col: 27, offset: 21085},
+									expr: &ruleRefExpr{
+										pos:  position{line: 641, col: 27, offset: 21085},
+										name: "exponentPart",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "doubleInteger",
+			pos:  position{line: 644, col: 1, offset: 21140},
+			expr: &choiceExpr{
+				pos: position{line: 645, col: 5, offset: 21158},
+				alternatives: []interface{}{
+					&litMatcher{
+						pos:        position{line: 645, col: 5, offset: 21158},
+						val:        "0",
+						ignoreCase: false,
+					},
+					&seqExpr{
+						pos: position{line: 646, col: 5, offset: 21166},
+						exprs: []interface{}{
+							&charClassMatcher{
+								pos:        position{line: 646, col: 5, offset: 21166},
+								val:        "[1-9]",
+								ranges:     []rune{'1', '9'},
+								ignoreCase: false,
+								inverted:   false,
+							},
+							&zeroOrMoreExpr{
+								pos: position{line: 646, col: 11, offset: 21172},
+								expr: &charClassMatcher{
+									pos:        position{line: 646, col: 11, offset: 21172},
+									val:        "[0-9]",
+									ranges:     []rune{'0', '9'},
+									ignoreCase: false,
+									inverted:   false,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "doubleDigit",
+			pos:  position{line: 647, col: 1, offset: 21179},
+			expr: &charClassMatcher{
+				pos:        position{line: 647, col: 15, offset: 21193},
+				val:        "[0-9]",
+				ranges:     []rune{'0', '9'},
+				ignoreCase: false,
+				inverted:   false,
+			},
+		},
+		{
+			name: "exponentPart",
+			pos:  position{line: 648, col: 1, offset: 21199},
+			expr: &seqExpr{
+				pos: position{line: 648, col: 16, offset: 21214},
+				exprs: []interface{}{
+					&litMatcher{
+						pos:        position{line: 648, col: 16, offset: 21214},
+						val:        "e",
+						ignoreCase: true,
+					},
+					&ruleRefExpr{
+						pos:  position{line: 648, col: 21, offset: 21219},
+						name: "sinteger",
+					},
+				},
+			},
+		},
+		{
+			name: "h16",
+			pos:  position{line: 649, col: 1, offset: 21228},
+			expr: &actionExpr{
+				pos: position{line: 649, col: 7, offset: 21234},
+				run: (*parser).callonh161,
+				expr: &labeledExpr{
+					pos:   position{line: 649, col: 7, offset: 21234},
+					label: "chars",
+					expr: &oneOrMoreExpr{
+						pos: position{line: 649, col: 13, offset: 21240},
+						expr: &ruleRefExpr{
+							pos:  position{line: 649, col: 13, offset: 21240},
+							name: "hexdigit",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "hexdigit",
+			pos:  position{line: 650, col: 1, offset: 21281},
+			expr: &charClassMatcher{
+				pos:        position{line: 650, col: 12, offset: 21292},
+				val:        "[0-9a-fA-F]",
+				ranges:     []rune{'0', '9', 'a', 'f', 'A', 'F'},
+				ignoreCase: false,
+				inverted:   false,
+			},
+		},
+		{
+			name: "searchWord",
+			pos:  position{line: 651, col: 1, offset: 21304},
+			expr: &actionExpr{
+				pos: position{line: 652, col: 5, offset: 21319},
+				run: (*parser).callonsearchWord1,
+				expr: &labeledExpr{
+					pos:   position{line: 652, col: 5, offset: 21319},
+					label: "chars",
+					expr: &oneOrMoreExpr{
+						pos: position{line: 652, col: 11, offset: 21325},
+						expr: &ruleRefExpr{
+							pos:  position{line: 652, col: 11, offset: 21325},
+							name: "searchWordPart",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "searchWordPart",
+			pos:  position{line: 653, col: 1, offset: 21374},
+			expr: &choiceExpr{
+				pos: position{line: 654, col: 5, offset: 21393},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 654, col: 5, offset: 21393},
+						run: (*parser).callonsearchWordPart2,
+						expr: &seqExpr{
+							pos: position{line: 654, col: 5, offset: 21393},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 654, col: 5, offset: 21393},
+									val:        "\\",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 654, col: 10, offset: 21398},
+									label: "s",
+									expr: &choiceExpr{
+										pos: position{line: 654, col: 13, offset: 21401},
+										alternatives: []interface{}{
+											&ruleRefExpr{
+												pos:  position{line: 654, col: 13, offset: 21401},
+												name: "escapeSequence",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 654, col: 30, offset: 21418},
+												name: "searchEscape",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 655, col: 5, offset: 21454},
+						run: (*parser).callonsearchWordPart9,
+						expr: &seqExpr{
+							pos: position{line: 655, col: 5, offset: 21454},
+							exprs: []interface{}{
+								&notExpr{
+									pos: position{line: 655, col: 5, offset: 21454},
+									expr: &choiceExpr{
+										pos: position{line: 655, col: 7, offset: 21456},
+										alternatives: []interface{}{
+											&charClassMatcher{
+												pos:        position{line: 655, col: 7, offset: 21456},
+												val:        "[\\x00-\\x1F\\x5C(),!><=\\x22|\\x27;]",
+												chars:      []rune{'\\', '(', ')', ',', '!', '>', '<', '=', '"', '|', '\'', ';'},
+												ranges:     []rune{'\x00', '\x1f'},
+												ignoreCase: false,
+												inverted:   false,
+											},
+											&ruleRefExpr{
+												pos:  position{line: 655, col: 42, offset: 21491},
+												name: "ws",
+											},
+										},
+									},
+								},
+								&anyMatcher{
+									line: 655, col: 46, offset: 21495,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "quotedString",
+			pos:  position{line: 656, col: 1, offset: 21528},
+			expr: &choiceExpr{
+				pos: position{line: 657, col: 5, offset: 21545},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 657, col: 5, offset: 21545},
+						run: (*parser).callonquotedString2,
+						expr: &seqExpr{
+							pos: position{line: 657, col: 5, offset: 21545},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 657, col: 5, offset: 21545},
+									val:        "\"",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 657, col: 9, offset: 21549},
+									label: "v",
+									expr: &zeroOrMoreExpr{
+										pos: position{line: 657, col: 11, offset: 21551},
+										expr: &ruleRefExpr{
+											pos:  position{line: 657, col: 11, offset: 21551},
+											name: "doubleQuotedChar",
+										},
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 657, col: 29, offset: 21569},
+									val:        "\"",
+									ignoreCase: false,
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 658, col: 5, offset: 21606},
+						run: (*parser).callonquotedString9,
+						expr: &seqExpr{
+							pos: position{line: 658, col: 5, offset: 21606},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 658, col: 5, offset: 21606},
+									val:        "'",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 658, col: 9, offset: 21610},
+									label: "v",
+									expr: &zeroOrMoreExpr{
+										pos: position{line: 658, col: 11, offset: 21612},
+										expr: &ruleRefExpr{
+											pos:  position{line: 658, col: 11, offset: 21612},
+											name: "singleQuotedChar",
+										},
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 658, col: 29, offset: 21630},
+									val:        "'",
+									ignoreCase: false,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "doubleQuotedChar",
+			pos:  position{line: 659, col: 1, offset: 21663},
+			expr: &choiceExpr{
+				pos: position{line: 660, col: 5, offset: 21684},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 660, col: 5, offset: 21684},
+						run: (*parser).callondoubleQuotedChar2,
+						expr: &seqExpr{
+							pos: position{line: 660, col: 5, offset: 21684},
+							exprs: []interface{}{
+								&notExpr{
+									pos: position{line: 660, col: 5, offset: 21684},
+									expr: &choiceExpr{
+										pos: position{line: 660, col: 7, offset: 21686},
+										alternatives: []interface{}{
+											&litMatcher{
+												pos:        position{line: 660, col: 7, offset: 21686},
+												val:        "\"",
+												ignoreCase: false,
+											},
+											&ruleRefExpr{
+												pos:  position{line: 660, col: 13, offset: 21692},
+												name: "escapedChar",
+											},
+										},
+									},
+								},
+								&anyMatcher{
+									line: 660, col: 26, offset: 21705,
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 661, col: 5, offset: 21742},
+						run: (*parser).callondoubleQuotedChar9,
+						expr: &seqExpr{
+							pos: position{line: 661, col: 5, offset: 21742},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 661, col: 5, offset: 21742},
+									val:        "\\",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 661, col: 10, offset: 21747},
+									label: "s",
+									expr: &ruleRefExpr{
+										pos:  position{line: 661, col: 12, offset: 21749},
+										name: "escapeSequence",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "singleQuotedChar",
+			pos:  position{line: 662, col: 1, offset: 21782},
+			expr: &choiceExpr{
+				pos: position{line: 663, col: 5, offset: 21803},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 663, col: 5, offset: 21803},
+						run: (*parser).callonsingleQuotedChar2,
+						expr: &seqExpr{
+							pos: position{line: 663, col: 5, offset: 21803},
+							exprs: []interface{}{
+								&notExpr{
+									pos: position{line: 663, col: 5, offset: 21803},
+									expr: &choiceExpr{
+										pos: position{line: 663, col: 7, offset: 21805},
+										alternatives: []interface{}{
+											&litMatcher{
+												pos:        position{line: 663, col: 7, offset: 21805},
+												val:        "'",
+												ignoreCase: false,
+											},
+											&ruleRefExpr{
+												pos:  position{line: 663, col: 13, offset: 21811},
+												name: "escapedChar",
+											},
+										},
+									},
+								},
+								&anyMatcher{
+									line: 663, col: 26, offset: 21824,
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 664, col: 5, offset: 21861},
+						run: (*parser).callonsingleQuotedChar9,
+						expr: &seqExpr{
+							pos: position{line: 664, col: 5, offset: 21861},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 664, col: 5, offset: 21861},
+									val:        "\\",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 664, col: 10, offset: 21866},
+									label: "s",
+									expr: &ruleRefExpr{
+										pos:  position{line: 664, col: 12, offset: 21868},
+										name: "escapeSequence",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "escapeSequence",
+			pos:  position{line: 665, col: 1, offset: 21901},
+			expr: &choiceExpr{
+				pos: position{line: 666, col: 5, offset: 21920},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 666, col: 5, offset: 21920},
+						run: (*parser).callonescapeSequence2,
+						expr: &seqExpr{
+							pos: position{line: 666, col: 5, offset: 21920},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 666, col: 5, offset: 21920},
+									val:        "x",
+									ignoreCase: false,
+								},
+								&ruleRefExpr{
+									pos:  position{line: 666, col: 9, offset: 21924},
+									name: "hexdigit",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 666, col: 18, offset: 21933},
+									name: "hexdigit",
+								},
+							},
+						},
+					},
+					&ruleRefExpr{
+						pos:  position{line: 667, col: 5, offset: 21984},
+						name: "singleCharEscape",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 668, col: 5, offset: 22005},
+						name: "unicodeEscape",
+					},
+				},
+			},
+		},
+		{
+			name: "singleCharEscape",
+			pos:  position{line: 669, col: 1, offset: 22019},
+			expr: &choiceExpr{
+				pos: position{line: 670, col: 5, offset: 22040},
+				alternatives: []interface{}{
+					&litMatcher{
+						pos:        position{line: 670, col: 5, offset: 22040},
+						val:        "'",
+						ignoreCase: false,
+					},
+					&litMatcher{
+						pos:        position{line: 671, col: 5, offset: 22048},
+						val:        "\"",
+						ignoreCase: false,
+					},
+					&litMatcher{
+						pos:        position{line: 672, col: 5, offset: 22056},
+						val:        "\\",
+						ignoreCase: false,
+					},
+					&actionExpr{
+						pos: position{line: 673, col: 5, offset: 22065},
+						run: (*parser).callonsingleCharEscape5,
+						expr: &litMatcher{
+							pos:        position{line: 673, col: 5, offset: 22065},
+							val:        "b",
+							ignoreCase: false,
+						},
+					},
+					&actionExpr{
+						pos: position{line: 674, col: 5, offset: 22094},
+						run: (*parser).callonsingleCharEscape7,
+						expr: &litMatcher{
+							pos:        position{line: 674, col: 5, offset: 22094},
+							val:        "f",
+							ignoreCase: false,
+						},
+					},
+					&actionExpr{
+						pos: position{line: 675, col: 5, offset: 22123},
+						run: (*parser).callonsingleCharEscape9,
+						expr: &litMatcher{
+							pos:        position{line: 675, col: 5, offset: 22123},
+							val:        "n",
+							ignoreCase: false,
+						},
+					},
+					&actionExpr{
+						pos: position{line: 676, col: 5, offset: 22152},
+						run: (*parser).callonsingleCharEscape11,
+						expr: &litMatcher{
+							pos:        position{line: 676, col: 5, offset: 22152},
+							val:        "r",
+							ignoreCase: false,
+						},
+					},
+					&actionExpr{
+						pos: position{line: 677, col: 5, offset: 22181},
+						run: (*parser).callonsingleCharEscape13,
+						expr: &litMatcher{
+							pos:        position{line: 677, col: 5, offset: 22181},
+							val:        "t",
+							ignoreCase: false,
+						},
+					},
+					&actionExpr{
+						pos: position{line: 678, col: 5, offset: 22210},
+						run: (*parser).callonsingleCharEscape15,
+						expr: &litMatcher{
+							pos:        position{line: 678, col: 5, offset: 22210},
+							val:        "v",
+							ignoreCase: false,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "searchEscape",
+			pos:  position{line: 679, col: 1, offset: 22235},
+			expr: &choiceExpr{
+				pos: position{line: 680, col: 5, offset: 22252},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 680, col: 5, offset: 22252},
+						run: (*parser).callonsearchEscape2,
+						expr: &litMatcher{
+							pos:        position{line: 680, col: 5, offset: 22252},
+							val:        "=",
+							ignoreCase: false,
+						},
+					},
+					&actionExpr{
+						pos: position{line: 681, col: 5, offset: 22280},
+						run: (*parser).callonsearchEscape4,
+						expr: &litMatcher{
+							pos:        position{line: 681, col: 5, offset: 22280},
+							val:        "*",
+							ignoreCase: false,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "unicodeEscape",
+			pos:  position{line: 682, col: 1, offset: 22306},
+			expr: &choiceExpr{
+				pos: position{line: 683, col: 5, offset: 22324},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 683, col: 5, offset: 22324},
+						run: (*parser).callonunicodeEscape2,
+						expr: &seqExpr{
+							pos: position{line: 683, col: 5, offset: 22324},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 683, col: 5, offset: 22324},
+									val:        "u",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 683, col: 9, offset: 22328},
+									label: "chars",
+									expr: &seqExpr{
+										pos: position{line: 683, col: 16, offset: 22335},
+										exprs: []interface{}{
+											&ruleRefExpr{
+												pos:  position{line: 683, col: 16, offset: 22335},
+												name: "hexdigit",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 683, col: 25, offset: 22344},
+												name: "hexdigit",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 683, col: 34, offset: 22353},
+												name: "hexdigit",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 683, col: 43, offset: 22362},
+												name: "hexdigit",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 686, col: 5, offset: 22425},
+						run: (*parser).callonunicodeEscape11,
+						expr: &seqExpr{
+							pos: position{line: 686, col: 5, offset: 22425},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 686, col: 5, offset: 22425},
+									val:        "u",
+									ignoreCase: false,
+								},
+								&litMatcher{
+									pos:        position{line: 686, col: 9, offset: 22429},
+									val:        "{",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 686, col: 13, offset: 22433},
+									label: "chars",
+									expr: &seqExpr{
+										pos: position{line: 686, col: 20, offset: 22440},
+										exprs: []interface{}{
+											&ruleRefExpr{
+												pos:  position{line: 686, col: 20, offset: 22440},
+												name: "hexdigit",
+											},
+											&zeroOrOneExpr{
+												pos: position{line: 686, col: 29, offset: 22449},
+												expr: &ruleRefExpr{
+													pos:  position{line: 686, col: 29, offset: 22449},
+													name: "hexdigit",
+												},
+											},
+											&zeroOrOneExpr{
+												pos: position{line: 686, col: 39, offset: 22459},
+												expr: &ruleRefExpr{
+													pos:  position{line: 686, col: 39, offset: 22459},
+													name: "hexdigit",
+												},
+											},
+											&zeroOrOneExpr{
+												pos: position{line: 686, col: 49, offset: 22469},
+												expr: &ruleRefExpr{
+													pos:  position{line: 686, col: 49, offset: 22469},
+													name: "hexdigit",
+												},
+											},
+											&zeroOrOneExpr{
+												pos: position{line: 686, col: 59, offset: 22479},
+												expr: &ruleRefExpr{
+													pos:  position{line: 686, col: 59, offset: 22479},
+													name: "hexdigit",
+												},
+											},
+											&zeroOrOneExpr{
+												pos: position{line: 686, col: 69, offset: 22489},
+												expr: &ruleRefExpr{
+													pos:  position{line: 686, col: 69, offset: 22489},
+													name: "hexdigit",
+												},
+											},
+										},
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 686, col: 80, offset: 22500},
+									val:        "}",
+									ignoreCase: false,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reString",
+			pos:  position{line: 689, col: 1, offset: 22553},
+			expr: &actionExpr{
+				pos: position{line: 690, col: 5, offset: 22566},
+				run: (*parser).callonreString1,
+				expr: &seqExpr{
+					pos: position{line: 690, col: 5, offset: 22566},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 690, col: 5, offset: 22566},
+							val:        "/",
+							ignoreCase: false,
+						},
+						&labeledExpr{
+							pos:   position{line: 690, col: 9, offset: 22570},
+							label: "v",
+							expr: &ruleRefExpr{
+								pos:  position{line: 690, col: 11, offset: 22572},
+								name: "reBody",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 690, col: 18, offset: 22579},
+							val:        "/",
+							ignoreCase: false,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reBody",
+			pos:  position{line: 691, col: 1, offset: 22601},
+			expr: &actionExpr{
+				pos: position{line: 692, col: 5, offset: 22612},
+				run: (*parser).callonreBody1,
+				expr: &oneOrMoreExpr{
+					pos: position{line: 692, col: 5, offset: 22612},
+					expr: &choiceExpr{
+						pos: position{line: 692, col: 6, offset: 22613},
+						alternatives: []interface{}{
+							&charClassMatcher{
+								pos:        position{line: 692, col: 6, offset: 22613},
+								val:        "[^/\\\\]",
+								chars:      []rune{'/', '\\'},
+								ignoreCase: false,
+								inverted:   true,
+							},
+							&seqExpr{
+								pos: position{line: 692, col: 13, offset: 22620},
+								exprs: []interface{}{
+									&litMatcher{
+										pos:        position{line: 692, col: 13, offset: 22620},
+										val:        "\\",
+										ignoreCase: false,
+									},
+									&anyMatcher{
+										line: 692, col: 18, offset: 22625,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "escapedChar",
+			pos:  position{line: 693, col: 1, offset: 22660},
+			expr: &charClassMatcher{
+				pos:        position{line: 694, col: 5, offset: 22676},
+				val:        "[\\x00-\\x1f\\\\]",
+				chars:      []rune{'\\'},
+				ranges:     []rune{'\x00', '\x1f'},
+				ignoreCase: false,
+				inverted:   false,
+			},
+		},
+		{
+			name: "ws",
+			pos:  position{line: 695, col: 1, offset: 22690},
+			expr: &choiceExpr{
+				pos: position{line: 696, col: 5, offset: 22697},
+				alternatives: []interface{}{
+					&litMatcher{
+						pos:        position{line: 696, col: 5, offset: 22697},
+						val:        "\t",
+						ignoreCase: false,
+					},
+					&litMatcher{
+						pos:        position{line: 697, col: 5, offset: 22706},
+						val:        "\v",
+						ignoreCase: false,
+					},
+					&litMatcher{
+						pos:        position{line: 698, col: 5, offset: 22715},
+						val:        "\f",
+						ignoreCase: false,
+					},
+					&litMatcher{
+						pos:        position{line: 699, col: 5, offset: 22724},
+						val:        " ",
+						ignoreCase: false,
+					},
+					&litMatcher{
+						pos:        position{line: 700, col: 5, offset: 22732},
+						val:        "\u00a0",
+						ignoreCase: false,
+					},
+					&litMatcher{
+						pos:        position{line: 701, col: 5, offset: 22745},
+						val:        "\ufeff",
+						ignoreCase: false,
+					},
+				},
+			},
+		},
+		{
+			name:        "_",
+			displayName: "\"whitespace\"",
+			pos:         position{line: 702, col: 1, offset: 22754},
+			expr: &oneOrMoreExpr{
+				pos: position{line: 702, col: 18, offset: 22771},
+				expr: &ruleRefExpr{
+					pos:  position{line: 702, col: 18, offset: 22771},
+					name: "ws",
+				},
+			},
+		},
+		{
+			name: "__",
+			pos:  position{line: 703, col: 1, offset: 22775},
+			expr: &zeroOrMoreExpr{
+				pos: position{line: 703, col: 6, offset: 22780},
+				expr: &ruleRefExpr{
+					pos:  position{line: 703, col: 6, offset: 22780},
+					name: "ws",
+				},
+			},
+		},
+		{
+			name: "defSpace",
+			pos:  position{line: 704, col: 1, offset: 22784},
+			expr: &oneOrMoreExpr{
+				pos: position{line: 704, col: 12, offset: 22795},
+				expr: &choiceExpr{
+					pos: position{line: 704, col: 13, offset: 22796},
+					alternatives: []interface{}{
+						&ruleRefExpr{
+							pos:  position{line: 704, col: 13, offset: 22796},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 704, col: 18, offset: 22801},
+							val:        "\r",
+							ignoreCase: false,
+						},
+						&litMatcher{
+							pos:        position{line: 704, col: 25, offset: 22808},
+							val:        "\n",
+							ignoreCase: false,
+						},
+						&seqExpr{
+							pos: position{line: 704, col: 32, offset: 22815},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 704, col: 32, offset: 22815},
+									val:        "#",
+									ignoreCase: false,
+								},
+								&zeroOrMoreExpr{
+									pos: position{line: 704, col: 36, offset: 22819},
+									expr: &charClassMatcher{
+										pos:        position{line: 704, col: 36, offset: 22819},
+										val:        "[^\\n]",
+										chars:      []rune{'\n'},
+										ignoreCase: false,
+										inverted:   true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "EOF",
+			pos:  position{line: 705, col: 1, offset: 22828},
+			expr: &notExpr{
+				pos: position{line: 705, col: 7, offset: 22834},
+				expr: &anyMatcher{
+					line: 705, col: 8, offset: 22835,
+				},
+			},
+		},
+	},
+}
+
+func (c *current) onstart1(ast interface{}) (interface{}, error) {
+	return ast, nil
+}
+
+func (p *parser) callonstart1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onstart1(stack["ast"])
+}
+
+func (c *current) onlibrary1() (interface{}, error) {
+	return nil, nil
+}
+
+func (p *parser) callonlibrary1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onlibrary1()
+}
+
+func (c *current) onmacroDef1(name, body interface{}) (interface{}, error) {
+	return defineMacro(c.globalStore, name, body), nil
+
+}
+
+func (p *parser) callonmacroDef1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onmacroDef1(stack["name"], stack["body"])
+}
+
+func (c *current) onmacroBody2(procs interface{}) (interface{}, error) {
+	return makeSequentialProc(procs), nil
+}
+
+func (p *parser) callonmacroBody2() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onmacroBody2(stack["procs"])
+}
+
+func (c *current) onidentifier1() (interface{}, error) {
+	return string(c.text), nil
+}
+
+func (p *parser) callonidentifier1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onidentifier1()
+}
+
+func (c *current) onmacroRef5(name interface{}) (bool, error) {
+	return isMacro(c.globalStore, name), nil
+}
+
+func (p *parser) callonmacroRef5() (bool, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onmacroRef5(stack["name"])
+}
+
+func (c *current) onmacroRef1(name interface{}) (interface{}, error) {
+	return expandMacro(c.globalStore, name)
+
+}
+
+func (p *parser) callonmacroRef1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onmacroRef1(stack["name"])
+}
+
+func (c *current) onquery2(procs interface{}) (interface{}, error) {
+	filt := makeFilterProc(makeMatchAll())
+	return makeSequentialProc(append([]interface{}{filt}, (procs.([]interface{}))...)), nil
+
+}
+
+func (p *parser) callonquery2() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onquery2(stack["procs"])
+}
+
+func (c *current) onquery8(s, rest interface{}) (interface{}, error) {
+	if len(rest.([]interface{})) == 0 {
+		return s, nil
+	} else {
+		return makeSequentialProc(append([]interface{}{s}, (rest.([]interface{}))...)), nil
+	}
+
+}
+
+func (p *parser) callonquery8() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onquery8(stack["s"], stack["rest"])
+}
+
+func (c *current) onquery17(s interface{}) (interface{}, error) {
+	return makeSequentialProc([]interface{}{s}), nil
+
+}
+
+func (p *parser) callonquery17() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onquery17(stack["s"])
+}
+
+func (c *current) onprocChain1(first, rest interface{}) (interface{}, error) {
+	if rest != nil {
+		return append([]interface{}{first}, (rest.([]interface{}))...), nil
+	} else {
+		return []interface{}{first}, nil
+	}
+
+}
+
+func (p *parser) callonprocChain1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onprocChain1(stack["first"], stack["rest"])
+}
+
+func (c *current) onchainedProc1(p interface{}) (interface{}, error) {
+	return p, nil
+}
+
+func (p *parser) callonchainedProc1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onchainedProc1(stack["p"])
+}
+
+func (c *current) onsearch1(expr interface{}) (interface{}, error) {
+	return makeFilterProc(expr), nil
+
+}
+
+func (p *parser) callonsearch1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onsearch1(stack["expr"])
+}
+
+func (c *current) onsearchExpr1(first, rest interface{}) (interface{}, error) {
+	return makeOrChain(first, rest), nil
+
+}
+
+func (p *parser) callonsearchExpr1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onsearchExpr1(stack["first"], stack["rest"])
+}
+
+func (c *current) onoredSearchTerm1(t interface{}) (interface{}, error) {
+	return t, nil
+}
+
+func (p *parser) callonoredSearchTerm1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onoredSearchTerm1(stack["t"])
+}
+
+func (c *current) onsearchTerm1(first, rest interface{}) (interface{}, error) {
+	return makeAndChain(first, rest), nil
+
+}
+
+func (p *parser) callonsearchTerm1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onsearchTerm1(stack["first"], stack["rest"])
+}
+
+func (c *current) onandedSearchTerm1(f interface{}) (interface{}, error) {
+	return f, nil
+}
+
+func (p *parser) callonandedSearchTerm1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onandedSearchTerm1(stack["f"])
+}
+
+func (c *current) onsearchFactor2(e interface{}) (interface{}, error) {
+	return makeLogicalNot(e), nil
+
+}
+
+func (p *parser) callonsearchFactor2() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onsearchFactor2(stack["e"])
+}
+
+func (c *current) onsearchFactor14(s interface{}) (interface{}, error) {
+	return s, nil
+}
+
+func (p *parser) callonsearchFactor14() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onsearchFactor14(stack["s"])
+}
+
+func (c *current) onsearchFactor20(expr interface{}) (interface{}, error) {
+	return expr, nil
+}
+
+func (p *parser) callonsearchFactor20() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onsearchFactor20(stack["expr"])
+}
+
+func (c *current) onsearchPred2(fieldComparator, v interface{}) (interface{}, error) {
+	return makeCompareAny(fieldComparator, false, v), nil
+
+}
+
+func (p *parser) callonsearchPred2() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onsearchPred2(stack["fieldComparator"], stack["v"])
+}
+
+func (c *current) onsearchPred13(fieldComparator, v interface{}) (interface{}, error) {
+	return makeCompareAny(fieldComparator, true, v), nil
+
+}
+
+func (p *parser) callonsearchPred13() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onsearchPred13(stack["fieldComparator"], stack["v"])
+}
+
+func (c *current) onsearchPred24(f, fieldComparator, v interface{}) (interface{}, error) {
+	return makeCompareField(fieldComparator, f, v), nil
+
+}
+
+func (p *parser) callonsearchPred24() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onsearchPred24(stack["f"], stack["fieldComparator"], stack["v"])
+}
+
+func (c *current) onsearchPred36(v interface{}) (interface{}, error) {
+	return makeCompareAny("in", false, v), nil
+
+}
+
+func (p *parser) callonsearchPred36() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onsearchPred36(stack["v"])
+}
+
+func (c *current) onsearchPred46(v, f interface{}) (interface{}, error) {
+	return makeCompareField("in", f, v), nil
+
+}
+
+func (p *parser) callonsearchPred46() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onsearchPred46(stack["v"], stack["f"])
+}
+
+func (c *current) onsearchPred57(v interface{}) (interface{}, error) {
+	if getValueType(v) == "string" {
+		return makeOrChain(makeCompareAny("search", true, v), []interface{}{makeCompareAny("searchin", true, v)}), nil
+	}
+	return makeOrChain(makeCompareAny("search", true, makeLiteral("string", getValueText(v))), []interface{}{makeCompareAny("searchin", true, makeLiteral("string", getValueText(v))), makeCompareAny("eql", true, v), makeCompareAny("in", true, v)}), nil
+
+}
+
+func (p *parser) callonsearchPred57() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onsearchPred57(stack["v"])
+}
+
+func (c *current) onsearchPred60(v interface{}) (interface{}, error) {
+	if getValueType(v) == "string" {
+		return makeOrChain(makeCompareAny("search", true, v), []interface{}{makeCompareAny("searchin", true, v)}), nil
+	}
+	if getValueType(v) == "regexp" {
+		if string(c.text) == "*" {
+			return makeMatchAll(), nil
+		}
+		return makeOrChain(makeCompareAny("eql", true, v), []interface{}{makeCompareAny("in", true, v)}), nil
+	}
+	return makeOrChain(makeCompareAny("search", true, makeLiteral("string", string(c.text))), []interface{}{makeCompareAny("searchin", true, makeLiteral("string", string(c.text))), makeCompareAny("eql", true, v), makeCompareAny("in", true, v)}), nil
+
 }
 
-func (c *current) onstart1(ast interface{}) (interface{}, error) {
-	return ast, nil
+func (p *parser) callonsearchPred60() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onsearchPred60(stack["v"])
 }
 
-func (p *parser) callonstart1() (interface{}, error) {
+func (c *current) onsearchValue9(i interface{}) (interface{}, error) {
+	return i, nil
+}
+
+func (p *parser) callonsearchValue9() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onstart1(stack["ast"])
+	return p.cur.onsearchValue9(stack["i"])
 }
 
-func (c *current) onquery2(procs interface{}) (interface{}, error) {
-	filt := makeFilterProc(makeMatchAll())
-	return makeSequentialProc(append([]interface{}{filt}, (procs.([]interface{}))...)), nil
+func (c *current) onsearchValue15(v interface{}) (interface{}, error) {
+	return v, nil
+}
+
+func (p *parser) callonsearchValue15() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onsearchValue15(stack["v"])
+}
 
+func (c *current) onsearchValue23(v interface{}) (interface{}, error) {
+	return v, nil
 }
 
-func (p *parser) callonquery2() (interface{}, error) {
+func (p *parser) callonsearchValue23() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onquery2(stack["procs"])
+	return p.cur.onsearchValue23(stack["v"])
 }
 
-func (c *current) onquery5(s, rest interface{}) (interface{}, error) {
-	if len(rest.([]interface{})) == 0 {
-		return s, nil
-	} else {
-		return makeSequentialProc(append([]interface{}{s}, (rest.([]interface{}))...)), nil
+func (c *current) onsearchValue31(v interface{}) (interface{}, error) {
+	if reglob.IsGlobby(v.(string)) {
+		re := reglob.Reglob(v.(string))
+		return makeLiteral("regexp", re), nil
 	}
+	return makeLiteral("string", v), nil
 
 }
 
-func (p *parser) callonquery5() (interface{}, error) {
+func (p *parser) callonsearchValue31() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onquery5(stack["s"], stack["rest"])
+	return p.cur.onsearchValue31(stack["v"])
 }
 
-func (c *current) onquery14(s interface{}) (interface{}, error) {
-	return makeSequentialProc([]interface{}{s}), nil
+func (c *current) onParamLiteral7(name interface{}) (bool, error) {
+	return isParam(c.globalStore, name), nil
+}
+
+func (p *parser) callonParamLiteral7() (bool, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onParamLiteral7(stack["name"])
+}
+
+func (c *current) onParamLiteral2(name interface{}) (interface{}, error) {
+	return bindParam(c.globalStore, name), nil
 
 }
 
-func (p *parser) callonquery14() (interface{}, error) {
+func (p *parser) callonParamLiteral2() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onquery14(stack["s"])
+	return p.cur.onParamLiteral2(stack["name"])
 }
 
-func (c *current) onprocChain1(first, rest interface{}) (interface{}, error) {
+func (c *current) onParamLiteral13(name interface{}) (bool, error) {
+	return hasParams(c.globalStore), nil
+}
+
+func (p *parser) callonParamLiteral13() (bool, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onParamLiteral13(stack["name"])
+}
+
+func (c *current) onParamLiteral8(name interface{}) (interface{}, error) {
+	return unboundParam(c.globalStore, name)
+
+}
+
+func (p *parser) callonParamLiteral8() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onParamLiteral8(stack["name"])
+}
+
+func (c *current) onStringLiteral1(v interface{}) (interface{}, error) {
+	return makeLiteral("string", v), nil
+
+}
+
+func (p *parser) callonStringLiteral1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onStringLiteral1(stack["v"])
+}
+
+func (c *current) onRegexpLiteral1(v interface{}) (interface{}, error) {
+	return makeLiteral("regexp", v), nil
+
+}
+
+func (p *parser) callonRegexpLiteral1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRegexpLiteral1(stack["v"])
+}
+
+func (c *current) onPortLiteral1(v interface{}) (interface{}, error) {
+	return makeLiteral("port", v), nil
+
+}
+
+func (p *parser) callonPortLiteral1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPortLiteral1(stack["v"])
+}
+
+func (c *current) onSubnetLiteral2(v interface{}) (interface{}, error) {
+	return makeLiteral("net", v), nil
+
+}
+
+func (p *parser) callonSubnetLiteral2() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSubnetLiteral2(stack["v"])
+}
+
+func (c *current) onSubnetLiteral5(v interface{}) (interface{}, error) {
+	return makeLiteral("net", v), nil
+
+}
+
+func (p *parser) callonSubnetLiteral5() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSubnetLiteral5(stack["v"])
+}
+
+func (c *current) onAddressLiteral2(v interface{}) (interface{}, error) {
+	return makeLiteral("ip", v), nil
+
+}
+
+func (p *parser) callonAddressLiteral2() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onAddressLiteral2(stack["v"])
+}
+
+func (c *current) onAddressLiteral5(v interface{}) (interface{}, error) {
+	return makeLiteral("ip", v), nil
+
+}
+
+func (p *parser) callonAddressLiteral5() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onAddressLiteral5(stack["v"])
+}
+
+func (c *current) onFloatLiteral1(v interface{}) (interface{}, error) {
+	return makeLiteral("float64", v), nil
+
+}
+
+func (p *parser) callonFloatLiteral1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onFloatLiteral1(stack["v"])
+}
+
+func (c *current) onIntegerLiteral1(v interface{}) (interface{}, error) {
+	return makeLiteral("int64", v), nil
+
+}
+
+func (p *parser) callonIntegerLiteral1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onIntegerLiteral1(stack["v"])
+}
+
+func (c *current) onBooleanLiteral2() (interface{}, error) {
+	return makeLiteral("bool", "true"), nil
+}
+
+func (p *parser) callonBooleanLiteral2() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onBooleanLiteral2()
+}
+
+func (c *current) onBooleanLiteral4() (interface{}, error) {
+	return makeLiteral("bool", "false"), nil
+}
+
+func (p *parser) callonBooleanLiteral4() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onBooleanLiteral4()
+}
+
+func (c *current) onNullLiteral1() (interface{}, error) {
+	return makeLiteral("null", ""), nil
+}
+
+func (p *parser) callonNullLiteral1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNullLiteral1()
+}
+
+func (c *current) onprocList1(first, rest interface{}) (interface{}, error) {
+	fp := makeSequentialProc(first)
 	if rest != nil {
-		return append([]interface{}{first}, (rest.([]interface{}))...), nil
+		return makeParallelProc(append([]interface{}{fp}, (rest.([]interface{}))...)), nil
 	} else {
-		return []interface{}{first}, nil
+		return fp, nil
 	}
 
 }
 
-func (p *parser) callonprocChain1() (interface{}, error) {
+func (p *parser) callonprocList1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onprocChain1(stack["first"], stack["rest"])
+	return p.cur.onprocList1(stack["first"], stack["rest"])
 }
 
-func (c *current) onchainedProc1(p interface{}) (interface{}, error) {
-	return p, nil
+func (c *current) onparallelChain1(ch interface{}) (interface{}, error) {
+	return makeSequentialProc(ch), nil
 }
 
-func (p *parser) callonchainedProc1() (interface{}, error) {
+func (p *parser) callonparallelChain1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onchainedProc1(stack["p"])
+	return p.cur.onparallelChain1(stack["ch"])
 }
 
-func (c *current) onsearch1(expr interface{}) (interface{}, error) {
-	return makeFilterProc(expr), nil
+func (c *current) onproc6(proc interface{}) (interface{}, error) {
+	return proc, nil
 
 }
 
-func (p *parser) callonsearch1() (interface{}, error) {
+func (p *parser) callonproc6() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onsearch1(stack["expr"])
+	return p.cur.onproc6(stack["proc"])
 }
 
-func (c *current) onsearchExpr1(first, rest interface{}) (interface{}, error) {
-	return makeOrChain(first, rest), nil
+func (c *current) onswitchProc13(ch interface{}) (interface{}, error) {
+	return ch, nil
+}
 
+func (p *parser) callonswitchProc13() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onswitchProc13(stack["ch"])
 }
 
-func (p *parser) callonsearchExpr1() (interface{}, error) {
+func (c *current) onswitchProc1(first, rest interface{}) (interface{}, error) {
+	return makeSwitchProc(append([]interface{}{first}, (rest.([]interface{}))...)), nil
+
+}
+
+func (p *parser) callonswitchProc1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onsearchExpr1(stack["first"], stack["rest"])
+	return p.cur.onswitchProc1(stack["first"], stack["rest"])
 }
 
-func (c *current) onoredSearchTerm1(t interface{}) (interface{}, error) {
-	return t, nil
+func (c *current) onswitchCase2(procs interface{}) (interface{}, error) {
+	return makeSwitchCase(makeMatchAll(), makeSequentialProc(procs)), nil
+
+}
+
+func (p *parser) callonswitchCase2() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onswitchCase2(stack["procs"])
+}
+
+func (c *current) onswitchCase17(filter, procs interface{}) (interface{}, error) {
+	return makeSwitchCase(filter, makeSequentialProc(procs)), nil
+
+}
+
+func (p *parser) callonswitchCase17() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onswitchCase17(stack["filter"], stack["procs"])
+}
+
+func (c *current) ongroupBy1(list interface{}) (interface{}, error) {
+	return list, nil
+}
+
+func (p *parser) callongroupBy1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.ongroupBy1(stack["list"])
+}
+
+func (c *current) oneveryDur2(dur, slide interface{}) (interface{}, error) {
+	return makeEvery(dur, makeSlidingWindow(slide)), nil
+
+}
+
+func (p *parser) calloneveryDur2() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.oneveryDur2(stack["dur"], stack["slide"])
+}
+
+func (c *current) oneveryDur21(z interface{}) (interface{}, error) {
+	return z, nil
+}
+
+func (p *parser) calloneveryDur21() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.oneveryDur21(stack["z"])
+}
+
+func (c *current) oneveryDur13(interval, tz interface{}) (interface{}, error) {
+	return makeEvery(nil, makeCalendarWindow(interval, tz)), nil
+
 }
 
-func (p *parser) callonoredSearchTerm1() (interface{}, error) {
+func (p *parser) calloneveryDur13() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onoredSearchTerm1(stack["t"])
+	return p.cur.oneveryDur13(stack["interval"], stack["tz"])
 }
 
-func (c *current) onsearchTerm1(first, rest interface{}) (interface{}, error) {
-	return makeAndChain(first, rest), nil
+func (c *current) oneveryDur26(interval, tz interface{}) (interface{}, error) {
+	return makeEvery(nil, makeCalendarWindow(interval, tz)), nil
 
 }
 
-func (p *parser) callonsearchTerm1() (interface{}, error) {
+func (p *parser) calloneveryDur26() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onsearchTerm1(stack["first"], stack["rest"])
+	return p.cur.oneveryDur26(stack["interval"], stack["tz"])
 }
 
-func (c *current) onandedSearchTerm1(f interface{}) (interface{}, error) {
-	return f, nil
+func (c *current) oneveryDur35(dur interface{}) (interface{}, error) {
+	return makeEvery(dur, nil), nil
 }
 
-func (p *parser) callonandedSearchTerm1() (interface{}, error) {
+func (p *parser) calloneveryDur35() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onandedSearchTerm1(stack["f"])
+	return p.cur.oneveryDur35(stack["dur"])
 }
 
-func (c *current) onsearchFactor2(e interface{}) (interface{}, error) {
-	return makeLogicalNot(e), nil
-
+func (c *current) ontimeZone1(zone interface{}) (interface{}, error) {
+	return zone, nil
 }
 
-func (p *parser) callonsearchFactor2() (interface{}, error) {
+func (p *parser) callontimeZone1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onsearchFactor2(stack["e"])
+	return p.cur.ontimeZone1(stack["zone"])
 }
 
-func (c *current) onsearchFactor14(s interface{}) (interface{}, error) {
-	return s, nil
+func (c *current) onequalityToken2() (interface{}, error) {
+	return "eql", nil
 }
 
-func (p *parser) callonsearchFactor14() (interface{}, error) {
+func (p *parser) callonequalityToken2() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onsearchFactor14(stack["s"])
+	return p.cur.onequalityToken2()
 }
 
-func (c *current) onsearchFactor20(expr interface{}) (interface{}, error) {
-	return expr, nil
+func (c *current) onequalityToken4() (interface{}, error) {
+	return "neql", nil
 }
 
-func (p *parser) callonsearchFactor20() (interface{}, error) {
+func (p *parser) callonequalityToken4() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onsearchFactor20(stack["expr"])
+	return p.cur.onequalityToken4()
 }
 
-func (c *current) onsearchPred2(fieldComparator, v interface{}) (interface{}, error) {
-	return makeCompareAny(fieldComparator, false, v), nil
-
+func (c *current) onequalityToken6() (interface{}, error) {
+	return "lte", nil
 }
 
-func (p *parser) callonsearchPred2() (interface{}, error) {
+func (p *parser) callonequalityToken6() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onsearchPred2(stack["fieldComparator"], stack["v"])
+	return p.cur.onequalityToken6()
 }
 
-func (c *current) onsearchPred13(fieldComparator, v interface{}) (interface{}, error) {
-	return makeCompareAny(fieldComparator, true, v), nil
-
+func (c *current) onequalityToken8() (interface{}, error) {
+	return "gte", nil
 }
 
-func (p *parser) callonsearchPred13() (interface{}, error) {
+func (p *parser) callonequalityToken8() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onsearchPred13(stack["fieldComparator"], stack["v"])
+	return p.cur.onequalityToken8()
 }
 
-func (c *current) onsearchPred24(f, fieldComparator, v interface{}) (interface{}, error) {
-	return makeCompareField(fieldComparator, f, v), nil
-
+func (c *current) onequalityToken10() (interface{}, error) {
+	return "lt", nil
 }
 
-func (p *parser) callonsearchPred24() (interface{}, error) {
+func (p *parser) callonequalityToken10() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onsearchPred24(stack["f"], stack["fieldComparator"], stack["v"])
+	return p.cur.onequalityToken10()
 }
 
-func (c *current) onsearchPred36(v interface{}) (interface{}, error) {
-	return makeCompareAny("in", false, v), nil
-
+func (c *current) onequalityToken12() (interface{}, error) {
+	return "gt", nil
 }
 
-func (p *parser) callonsearchPred36() (interface{}, error) {
+func (p *parser) callonequalityToken12() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onsearchPred36(stack["v"])
+	return p.cur.onequalityToken12()
 }
 
-func (c *current) onsearchPred46(v, f interface{}) (interface{}, error) {
-	return makeCompareField("in", f, v), nil
-
+func (c *current) onandToken1() (interface{}, error) {
+	return string(c.text), nil
 }
 
-func (p *parser) callonsearchPred46() (interface{}, error) {
+func (p *parser) callonandToken1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onsearchPred46(stack["v"], stack["f"])
+	return p.cur.onandToken1()
 }
 
-func (c *current) onsearchPred57(v interface{}) (interface{}, error) {
-	if getValueType(v) == "string" {
-		return makeOrChain(makeCompareAny("search", true, v), []interface{}{makeCompareAny("searchin", true, v)}), nil
-	}
-	if getValueType(v) == "regexp" {
-		if string(c.text) == "*" {
-			return makeMatchAll(), nil
-		}
-		return makeOrChain(makeCompareAny("eql", true, v), []interface{}{makeCompareAny("in", true, v)}), nil
-	}
+func (c *current) onorToken1() (interface{}, error) {
+	return string(c.text), nil
+}
 
-	return makeOrChain(makeCompareAny("search", true, makeLiteral("string", string(c.text))), []interface{}{makeCompareAny("searchin", true, makeLiteral("string", string(c.text))), makeCompareAny("eql", true, v), makeCompareAny("in", true, v)}), nil
+func (p *parser) callonorToken1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onorToken1()
+}
 
+func (c *current) oninToken1() (interface{}, error) {
+	return string(c.text), nil
 }
 
-func (p *parser) callonsearchPred57() (interface{}, error) {
+func (p *parser) calloninToken1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onsearchPred57(stack["v"])
+	return p.cur.oninToken1()
 }
 
-func (c *current) onsearchValue8(i interface{}) (interface{}, error) {
-	return i, nil
+func (c *current) onnotToken1() (interface{}, error) {
+	return string(c.text), nil
 }
 
-func (p *parser) callonsearchValue8() (interface{}, error) {
+func (p *parser) callonnotToken1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onsearchValue8(stack["i"])
+	return p.cur.onnotToken1()
 }
 
-func (c *current) onsearchValue14(v interface{}) (interface{}, error) {
-	return v, nil
+func (c *current) onfieldName1() (interface{}, error) {
+	return string(c.text), nil
 }
 
-func (p *parser) callonsearchValue14() (interface{}, error) {
+func (p *parser) callonfieldName1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onsearchValue14(stack["v"])
+	return p.cur.onfieldName1()
 }
 
-func (c *current) onsearchValue22(v interface{}) (interface{}, error) {
-	return v, nil
+func (c *current) onfieldReference8(field interface{}) (interface{}, error) {
+	return makeFieldCall("RecordFieldRead", nil, field), nil
 }
 
-func (p *parser) callonsearchValue22() (interface{}, error) {
+func (p *parser) callonfieldReference8() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onsearchValue22(stack["v"])
+	return p.cur.onfieldReference8(stack["field"])
 }
 
-func (c *current) onsearchValue30(v interface{}) (interface{}, error) {
-	if reglob.IsGlobby(v.(string)) {
-		re := reglob.Reglob(v.(string))
-		return makeLiteral("regexp", re), nil
-	}
-	return makeLiteral("string", v), nil
-
+func (c *current) onfieldReference13(index interface{}) (interface{}, error) {
+	return makeFieldCall("Index", nil, index), nil
 }
 
-func (p *parser) callonsearchValue30() (interface{}, error) {
+func (p *parser) callonfieldReference13() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onsearchValue30(stack["v"])
+	return p.cur.onfieldReference13(stack["index"])
 }
 
-func (c *current) onStringLiteral1(v interface{}) (interface{}, error) {
-	return makeLiteral("string", v), nil
+func (c *current) onfieldReference1(base, derefs interface{}) (interface{}, error) {
+	return chainFieldCalls(base, derefs), nil
 
 }
 
-func (p *parser) callonStringLiteral1() (interface{}, error) {
+func (p *parser) callonfieldReference1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onStringLiteral1(stack["v"])
+	return p.cur.onfieldReference1(stack["base"], stack["derefs"])
 }
 
-func (c *current) onRegexpLiteral1(v interface{}) (interface{}, error) {
-	return makeLiteral("regexp", v), nil
+func (c *current) onfieldExpr2(op, field interface{}) (interface{}, error) {
+	return makeFieldCall(op, field, nil), nil
 
 }
 
-func (p *parser) callonRegexpLiteral1() (interface{}, error) {
+func (p *parser) callonfieldExpr2() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onRegexpLiteral1(stack["v"])
+	return p.cur.onfieldExpr2(stack["op"], stack["field"])
 }
 
-func (c *current) onPortLiteral1(v interface{}) (interface{}, error) {
-	return makeLiteral("port", v), nil
-
+func (c *current) onfieldOp1() (interface{}, error) {
+	return "Len", nil
 }
 
-func (p *parser) callonPortLiteral1() (interface{}, error) {
+func (p *parser) callonfieldOp1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPortLiteral1(stack["v"])
+	return p.cur.onfieldOp1()
 }
 
-func (c *current) onSubnetLiteral2(v interface{}) (interface{}, error) {
-	return makeLiteral("net", v), nil
+func (c *current) onfieldExprList1(first, rest interface{}) (interface{}, error) {
+	result := []interface{}{first}
+	for _, r := range rest.([]interface{}) {
+		result = append(result, r.([]interface{})[3])
+	}
+	return result, nil
 
 }
 
-func (p *parser) callonSubnetLiteral2() (interface{}, error) {
+func (p *parser) callonfieldExprList1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onSubnetLiteral2(stack["v"])
+	return p.cur.onfieldExprList1(stack["first"], stack["rest"])
 }
 
-func (c *current) onSubnetLiteral5(v interface{}) (interface{}, error) {
-	return makeLiteral("net", v), nil
-
+func (c *current) onfieldRefDotOnly7(field interface{}) (interface{}, error) {
+	return makeFieldCall("RecordFieldRead", nil, field), nil
 }
 
-func (p *parser) callonSubnetLiteral5() (interface{}, error) {
+func (p *parser) callonfieldRefDotOnly7() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onSubnetLiteral5(stack["v"])
+	return p.cur.onfieldRefDotOnly7(stack["field"])
 }
 
-func (c *current) onAddressLiteral2(v interface{}) (interface{}, error) {
-	return makeLiteral("ip", v), nil
+func (c *current) onfieldRefDotOnly1(base, refs interface{}) (interface{}, error) {
+	return chainFieldCalls(base, refs), nil
 
 }
 
-func (p *parser) callonAddressLiteral2() (interface{}, error) {
+func (p *parser) callonfieldRefDotOnly1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onAddressLiteral2(stack["v"])
+	return p.cur.onfieldRefDotOnly1(stack["base"], stack["refs"])
 }
 
-func (c *current) onAddressLiteral5(v interface{}) (interface{}, error) {
-	return makeLiteral("ip", v), nil
-
+func (c *current) onfieldRefDotOnlyList7(ref interface{}) (interface{}, error) {
+	return ref, nil
 }
 
-func (p *parser) callonAddressLiteral5() (interface{}, error) {
+func (p *parser) callonfieldRefDotOnlyList7() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onAddressLiteral5(stack["v"])
+	return p.cur.onfieldRefDotOnlyList7(stack["ref"])
 }
 
-func (c *current) onFloatLiteral1(v interface{}) (interface{}, error) {
-	return makeLiteral("float64", v), nil
+func (c *current) onfieldRefDotOnlyList1(first, rest interface{}) (interface{}, error) {
+	result := []interface{}{first}
+	for _, r := range rest.([]interface{}) {
+		result = append(result, r)
+	}
+	return result, nil
 
 }
 
-func (p *parser) callonFloatLiteral1() (interface{}, error) {
+func (p *parser) callonfieldRefDotOnlyList1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onFloatLiteral1(stack["v"])
+	return p.cur.onfieldRefDotOnlyList1(stack["first"], stack["rest"])
 }
 
-func (c *current) onIntegerLiteral1(v interface{}) (interface{}, error) {
-	return makeLiteral("int64", v), nil
+func (c *current) onfieldNameList1(first, rest interface{}) (interface{}, error) {
+	result := []interface{}{first}
+	for _, r := range rest.([]interface{}) {
+		result = append(result, r.([]interface{})[3])
+	}
+	return result, nil
 
 }
 
-func (p *parser) callonIntegerLiteral1() (interface{}, error) {
+func (p *parser) callonfieldNameList1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onIntegerLiteral1(stack["v"])
+	return p.cur.onfieldNameList1(stack["first"], stack["rest"])
 }
 
-func (c *current) onBooleanLiteral2() (interface{}, error) {
-	return makeLiteral("bool", "true"), nil
+func (c *current) oncountOp1() (interface{}, error) {
+	return "Count", nil
 }
 
-func (p *parser) callonBooleanLiteral2() (interface{}, error) {
+func (p *parser) calloncountOp1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onBooleanLiteral2()
+	return p.cur.oncountOp1()
 }
 
-func (c *current) onBooleanLiteral4() (interface{}, error) {
-	return makeLiteral("bool", "false"), nil
+func (c *current) onfieldReducerOp2() (interface{}, error) {
+	return "Sum", nil
 }
 
-func (p *parser) callonBooleanLiteral4() (interface{}, error) {
+func (p *parser) callonfieldReducerOp2() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onBooleanLiteral4()
+	return p.cur.onfieldReducerOp2()
 }
 
-func (c *current) onNullLiteral1() (interface{}, error) {
-	return makeLiteral("null", ""), nil
+func (c *current) onfieldReducerOp4() (interface{}, error) {
+	return "Avg", nil
 }
 
-func (p *parser) callonNullLiteral1() (interface{}, error) {
+func (p *parser) callonfieldReducerOp4() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNullLiteral1()
+	return p.cur.onfieldReducerOp4()
 }
 
-func (c *current) onprocList1(first, rest interface{}) (interface{}, error) {
-	fp := makeSequentialProc(first)
-	if rest != nil {
-		return makeParallelProc(append([]interface{}{fp}, (rest.([]interface{}))...)), nil
-	} else {
-		return fp, nil
-	}
-
+func (c *current) onfieldReducerOp6() (interface{}, error) {
+	return "Stdev", nil
 }
 
-func (p *parser) callonprocList1() (interface{}, error) {
+func (p *parser) callonfieldReducerOp6() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onprocList1(stack["first"], stack["rest"])
+	return p.cur.onfieldReducerOp6()
 }
 
-func (c *current) onparallelChain1(ch interface{}) (interface{}, error) {
-	return makeSequentialProc(ch), nil
+func (c *current) onfieldReducerOp8() (interface{}, error) {
+	return "Stdev", nil
 }
 
-func (p *parser) callonparallelChain1() (interface{}, error) {
+func (p *parser) callonfieldReducerOp8() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onparallelChain1(stack["ch"])
+	return p.cur.onfieldReducerOp8()
 }
 
-func (c *current) onproc4(proc interface{}) (interface{}, error) {
-	return proc, nil
-
+func (c *current) onfieldReducerOp10() (interface{}, error) {
+	return "Var", nil
 }
 
-func (p *parser) callonproc4() (interface{}, error) {
+func (p *parser) callonfieldReducerOp10() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onproc4(stack["proc"])
+	return p.cur.onfieldReducerOp10()
 }
 
-func (c *current) ongroupBy1(list interface{}) (interface{}, error) {
-	return list, nil
+func (c *current) onfieldReducerOp12() (interface{}, error) {
+	return "Entropy", nil
 }
 
-func (p *parser) callongroupBy1() (interface{}, error) {
+func (p *parser) callonfieldReducerOp12() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.ongroupBy1(stack["list"])
+	return p.cur.onfieldReducerOp12()
 }
 
-func (c *current) oneveryDur1(dur interface{}) (interface{}, error) {
-	return dur, nil
+func (c *current) onfieldReducerOp14() (interface{}, error) {
+	return "Min", nil
 }
 
-func (p *parser) calloneveryDur1() (interface{}, error) {
+func (p *parser) callonfieldReducerOp14() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.oneveryDur1(stack["dur"])
+	return p.cur.onfieldReducerOp14()
 }
 
-func (c *current) onequalityToken2() (interface{}, error) {
-	return "eql", nil
+func (c *current) onfieldReducerOp16() (interface{}, error) {
+	return "Max", nil
 }
 
-func (p *parser) callonequalityToken2() (interface{}, error) {
+func (p *parser) callonfieldReducerOp16() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onequalityToken2()
+	return p.cur.onfieldReducerOp16()
 }
 
-func (c *current) onequalityToken4() (interface{}, error) {
-	return "neql", nil
+func (c *current) onfieldReducerOp18() (interface{}, error) {
+	return "First", nil
 }
 
-func (p *parser) callonequalityToken4() (interface{}, error) {
+func (p *parser) callonfieldReducerOp18() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onequalityToken4()
+	return p.cur.onfieldReducerOp18()
 }
 
-func (c *current) onequalityToken6() (interface{}, error) {
-	return "lte", nil
+func (c *current) onfieldReducerOp20() (interface{}, error) {
+	return "Last", nil
 }
 
-func (p *parser) callonequalityToken6() (interface{}, error) {
+func (p *parser) callonfieldReducerOp20() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onequalityToken6()
+	return p.cur.onfieldReducerOp20()
 }
 
-func (c *current) onequalityToken8() (interface{}, error) {
-	return "gte", nil
+func (c *current) onfieldReducerOp22() (interface{}, error) {
+	return "CountDistinct", nil
 }
 
-func (p *parser) callonequalityToken8() (interface{}, error) {
+func (p *parser) callonfieldReducerOp22() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onequalityToken8()
+	return p.cur.onfieldReducerOp22()
 }
 
-func (c *current) onequalityToken10() (interface{}, error) {
-	return "lt", nil
+func (c *current) onpaddedFieldExpr1(field interface{}) (interface{}, error) {
+	return field, nil
 }
 
-func (p *parser) callonequalityToken10() (interface{}, error) {
+func (p *parser) callonpaddedFieldExpr1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onequalityToken10()
+	return p.cur.onpaddedFieldExpr1(stack["field"])
 }
 
-func (c *current) onequalityToken12() (interface{}, error) {
-	return "gt", nil
+func (c *current) oncountReducer1(op, field interface{}) (interface{}, error) {
+	return makeReducer(op, "count", field), nil
+
 }
 
-func (p *parser) callonequalityToken12() (interface{}, error) {
+func (p *parser) calloncountReducer1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onequalityToken12()
+	return p.cur.oncountReducer1(stack["op"], stack["field"])
 }
 
-func (c *current) onandToken1() (interface{}, error) {
-	return string(c.text), nil
+func (c *current) onfieldReducer1(op, field interface{}) (interface{}, error) {
+	return makeReducer(op, toLowerCase(op), field), nil
+
 }
 
-func (p *parser) callonandToken1() (interface{}, error) {
+func (p *parser) callonfieldReducer1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onandToken1()
+	return p.cur.onfieldReducer1(stack["op"], stack["field"])
 }
 
-func (c *current) onorToken1() (interface{}, error) {
-	return string(c.text), nil
+func (c *current) onreducerProc1(every, reducers, keys, limit interface{}) (interface{}, error) {
+	if OR(keys, every) != nil {
+		if keys != nil {
+			keys = keys.([]interface{})[1]
+		} else {
+			keys = []interface{}{}
+		}
+		if every != nil {
+			every = every.([]interface{})[0]
+		}
+		return makeGroupByProc(every, limit, keys, reducers), nil
+	}
+	return makeReducerProc(reducers), nil
+
 }
 
-func (p *parser) callonorToken1() (interface{}, error) {
+func (p *parser) callonreducerProc1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onorToken1()
+	return p.cur.onreducerProc1(stack["every"], stack["reducers"], stack["keys"], stack["limit"])
 }
 
-func (c *current) oninToken1() (interface{}, error) {
-	return string(c.text), nil
+func (c *current) onasClause1(v interface{}) (interface{}, error) {
+	return v, nil
 }
 
-func (p *parser) calloninToken1() (interface{}, error) {
+func (p *parser) callonasClause1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.oninToken1()
+	return p.cur.onasClause1(stack["v"])
 }
 
-func (c *current) onnotToken1() (interface{}, error) {
-	return string(c.text), nil
+func (c *current) onreducerExpr2(field, f interface{}) (interface{}, error) {
+	return overrideReducerVar(f, field), nil
+
 }
 
-func (p *parser) callonnotToken1() (interface{}, error) {
+func (p *parser) callonreducerExpr2() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onnotToken1()
+	return p.cur.onreducerExpr2(stack["field"], stack["f"])
 }
 
-func (c *current) onfieldName1() (interface{}, error) {
-	return string(c.text), nil
+func (c *current) onreducerExpr13(f, field interface{}) (interface{}, error) {
+	return overrideReducerVar(f, field), nil
+
 }
 
-func (p *parser) callonfieldName1() (interface{}, error) {
+func (p *parser) callonreducerExpr13() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onfieldName1()
+	return p.cur.onreducerExpr13(stack["f"], stack["field"])
 }
 
-func (c *current) onfieldReference8(field interface{}) (interface{}, error) {
-	return makeFieldCall("RecordFieldRead", nil, field), nil
+func (c *current) onreducerList1(first, rest interface{}) (interface{}, error) {
+	result := []interface{}{first}
+	for _, r := range rest.([]interface{}) {
+		result = append(result, r.([]interface{})[3])
+	}
+	return result, nil
+
 }
 
-func (p *parser) callonfieldReference8() (interface{}, error) {
+func (p *parser) callonreducerList1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onfieldReference8(stack["field"])
+	return p.cur.onreducerList1(stack["first"], stack["rest"])
 }
 
-func (c *current) onfieldReference13(index interface{}) (interface{}, error) {
-	return makeFieldCall("Index", nil, index), nil
+func (c *current) onsort8(l interface{}) (interface{}, error) {
+	return l, nil
 }
 
-func (p *parser) callonfieldReference13() (interface{}, error) {
+func (p *parser) callonsort8() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onfieldReference13(stack["index"])
+	return p.cur.onsort8(stack["l"])
 }
 
-func (c *current) onfieldReference1(base, derefs interface{}) (interface{}, error) {
-	return chainFieldCalls(base, derefs), nil
+func (c *current) onsort1(args, list interface{}) (interface{}, error) {
+	return makeSortProc(args, list)
 
 }
 
-func (p *parser) callonfieldReference1() (interface{}, error) {
+func (p *parser) callonsort1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onfieldReference1(stack["base"], stack["derefs"])
+	return p.cur.onsort1(stack["args"], stack["list"])
 }
 
-func (c *current) onfieldExpr2(op, field interface{}) (interface{}, error) {
-	return makeFieldCall(op, field, nil), nil
-
+func (c *current) onsortArgs2(a interface{}) (interface{}, error) {
+	return a, nil
 }
 
-func (p *parser) callonfieldExpr2() (interface{}, error) {
+func (p *parser) callonsortArgs2() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onfieldExpr2(stack["op"], stack["field"])
+	return p.cur.onsortArgs2(stack["a"])
 }
 
-func (c *current) onfieldOp1() (interface{}, error) {
-	return "Len", nil
+func (c *current) onsortArg2(limit interface{}) (interface{}, error) {
+	return makeArg("limit", limit), nil
 }
 
-func (p *parser) callonfieldOp1() (interface{}, error) {
+func (p *parser) callonsortArg2() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onfieldOp1()
+	return p.cur.onsortArg2(stack["limit"])
 }
 
-func (c *current) onfieldExprList1(first, rest interface{}) (interface{}, error) {
-	result := []interface{}{first}
-
-	for _, r := range rest.([]interface{}) {
-		result = append(result, r.([]interface{})[3])
-	}
+func (c *current) onsortArg8() (interface{}, error) {
+	return makeArg("r", nil), nil
+}
 
-	return result, nil
+func (p *parser) callonsortArg8() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onsortArg8()
+}
 
+func (c *current) onsortArg15() (interface{}, error) {
+	return string(c.text), nil
 }
 
-func (p *parser) callonfieldExprList1() (interface{}, error) {
+func (p *parser) callonsortArg15() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onfieldExprList1(stack["first"], stack["rest"])
+	return p.cur.onsortArg15()
 }
 
-func (c *current) onfieldRefDotOnly7(field interface{}) (interface{}, error) {
-	return makeFieldCall("RecordFieldRead", nil, field), nil
+func (c *current) onsortArg10(where interface{}) (interface{}, error) {
+	return makeArg("nulls", where), nil
 }
 
-func (p *parser) callonfieldRefDotOnly7() (interface{}, error) {
+func (p *parser) callonsortArg10() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onfieldRefDotOnly7(stack["field"])
+	return p.cur.onsortArg10(stack["where"])
 }
 
-func (c *current) onfieldRefDotOnly1(base, refs interface{}) (interface{}, error) {
-	return chainFieldCalls(base, refs), nil
-
+func (c *current) ontop6(n interface{}) (interface{}, error) {
+	return n, nil
 }
 
-func (p *parser) callonfieldRefDotOnly1() (interface{}, error) {
+func (p *parser) callontop6() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onfieldRefDotOnly1(stack["base"], stack["refs"])
+	return p.cur.ontop6(stack["n"])
 }
 
-func (c *current) onfieldRefDotOnlyList7(ref interface{}) (interface{}, error) {
-	return ref, nil
+func (c *current) ontop18(f interface{}) (interface{}, error) {
+	return f, nil
 }
 
-func (p *parser) callonfieldRefDotOnlyList7() (interface{}, error) {
+func (p *parser) callontop18() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onfieldRefDotOnlyList7(stack["ref"])
+	return p.cur.ontop18(stack["f"])
 }
 
-func (c *current) onfieldRefDotOnlyList1(first, rest interface{}) (interface{}, error) {
-	result := []interface{}{first}
-	for _, r := range rest.([]interface{}) {
-		result = append(result, r)
-	}
-	return result, nil
+func (c *current) ontop1(limit, flush, list interface{}) (interface{}, error) {
+	return makeTopProc(list, limit, flush), nil
 
 }
 
-func (p *parser) callonfieldRefDotOnlyList1() (interface{}, error) {
+func (p *parser) callontop1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onfieldRefDotOnlyList1(stack["first"], stack["rest"])
+	return p.cur.ontop1(stack["limit"], stack["flush"], stack["list"])
 }
 
-func (c *current) onfieldNameList1(first, rest interface{}) (interface{}, error) {
-	result := []interface{}{first}
-	for _, r := range rest.([]interface{}) {
-		result = append(result, r.([]interface{})[3])
-	}
-	return result, nil
-
+func (c *current) onprocLimitArg1(limit interface{}) (interface{}, error) {
+	return limit, nil
 }
 
-func (p *parser) callonfieldNameList1() (interface{}, error) {
+func (p *parser) callonprocLimitArg1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onfieldNameList1(stack["first"], stack["rest"])
+	return p.cur.onprocLimitArg1(stack["limit"])
 }
 
-func (c *current) oncountOp1() (interface{}, error) {
-	return "Count", nil
+func (c *current) oncut1(partial, list interface{}) (interface{}, error) {
+	return makeCutProc(list, partial), nil
 }
 
-func (p *parser) calloncountOp1() (interface{}, error) {
+func (p *parser) calloncut1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.oncountOp1()
+	return p.cur.oncut1(stack["partial"], stack["list"])
 }
 
-func (c *current) onfieldReducerOp2() (interface{}, error) {
-	return "Sum", nil
+func (c *current) onhead10(k interface{}) (interface{}, error) {
+	return k, nil
 }
 
-func (p *parser) callonfieldReducerOp2() (interface{}, error) {
+func (p *parser) callonhead10() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onfieldReducerOp2()
+	return p.cur.onhead10(stack["k"])
 }
 
-func (c *current) onfieldReducerOp4() (interface{}, error) {
-	return "Avg", nil
+func (c *current) onhead2(count, keys interface{}) (interface{}, error) {
+	return makeHeadProc(count, keys), nil
 }
 
-func (p *parser) callonfieldReducerOp4() (interface{}, error) {
+func (p *parser) callonhead2() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onfieldReducerOp4()
+	return p.cur.onhead2(stack["count"], stack["keys"])
 }
 
-func (c *current) onfieldReducerOp6() (interface{}, error) {
-	return "Stdev", nil
+func (c *current) onhead15() (interface{}, error) {
+	return makeHeadProc(1, nil), nil
 }
 
-func (p *parser) callonfieldReducerOp6() (interface{}, error) {
+func (p *parser) callonhead15() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onfieldReducerOp6()
+	return p.cur.onhead15()
 }
 
-func (c *current) onfieldReducerOp8() (interface{}, error) {
-	return "Stdev", nil
+func (c *current) ontail10(k interface{}) (interface{}, error) {
+	return k, nil
 }
 
-func (p *parser) callonfieldReducerOp8() (interface{}, error) {
+func (p *parser) callontail10() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onfieldReducerOp8()
+	return p.cur.ontail10(stack["k"])
 }
 
-func (c *current) onfieldReducerOp10() (interface{}, error) {
-	return "Var", nil
+func (c *current) ontail2(count, keys interface{}) (interface{}, error) {
+	return makeTailProc(count, keys), nil
 }
 
-func (p *parser) callonfieldReducerOp10() (interface{}, error) {
+func (p *parser) callontail2() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onfieldReducerOp10()
+	return p.cur.ontail2(stack["count"], stack["keys"])
 }
 
-func (c *current) onfieldReducerOp12() (interface{}, error) {
-	return "Entropy", nil
+func (c *current) ontail15() (interface{}, error) {
+	return makeTailProc(1, nil), nil
 }
 
-func (p *parser) callonfieldReducerOp12() (interface{}, error) {
+func (p *parser) callontail15() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onfieldReducerOp12()
+	return p.cur.ontail15()
 }
 
-func (c *current) onfieldReducerOp14() (interface{}, error) {
-	return "Min", nil
+func (c *current) onfilter1(expr interface{}) (interface{}, error) {
+	return makeFilterProc(expr), nil
+
 }
 
-func (p *parser) callonfieldReducerOp14() (interface{}, error) {
+func (p *parser) callonfilter1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onfieldReducerOp14()
+	return p.cur.onfilter1(stack["expr"])
 }
 
-func (c *current) onfieldReducerOp16() (interface{}, error) {
-	return "Max", nil
+func (c *current) onuniq2(keys, limit interface{}) (interface{}, error) {
+	return makeUniqByProc(keys, limit), nil
+
 }
 
-func (p *parser) callonfieldReducerOp16() (interface{}, error) {
+func (p *parser) callonuniq2() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onfieldReducerOp16()
+	return p.cur.onuniq2(stack["keys"], stack["limit"])
 }
 
-func (c *current) onfieldReducerOp18() (interface{}, error) {
-	return "First", nil
+func (c *current) onuniq13() (interface{}, error) {
+	return makeUniqProc(true), nil
+
 }
 
-func (p *parser) callonfieldReducerOp18() (interface{}, error) {
+func (p *parser) callonuniq13() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onfieldReducerOp18()
+	return p.cur.onuniq13()
 }
 
-func (c *current) onfieldReducerOp20() (interface{}, error) {
-	return "Last", nil
+func (c *current) onuniq18() (interface{}, error) {
+	return makeUniqProc(false), nil
+
 }
 
-func (p *parser) callonfieldReducerOp20() (interface{}, error) {
+func (p *parser) callonuniq18() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onfieldReducerOp20()
+	return p.cur.onuniq18()
 }
 
-func (c *current) onfieldReducerOp22() (interface{}, error) {
-	return "CountDistinct", nil
+func (c *current) onput1(f, e interface{}) (interface{}, error) {
+	return makePutProc(f, e), nil
+
 }
 
-func (p *parser) callonfieldReducerOp22() (interface{}, error) {
+func (p *parser) callonput1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onfieldReducerOp22()
+	return p.cur.onput1(stack["f"], stack["e"])
 }
 
-func (c *current) onpaddedFieldExpr1(field interface{}) (interface{}, error) {
-	return field, nil
+func (c *current) onwindow9(k interface{}) (interface{}, error) {
+	return k, nil
 }
 
-func (p *parser) callonpaddedFieldExpr1() (interface{}, error) {
+func (p *parser) callonwindow9() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onpaddedFieldExpr1(stack["field"])
+	return p.cur.onwindow9(stack["k"])
 }
 
-func (c *current) oncountReducer1(op, field interface{}) (interface{}, error) {
-	return makeReducer(op, "count", field), nil
+func (c *current) onwindow1(assignments, keys interface{}) (interface{}, error) {
+	return makeWindowProc(assignments, keys), nil
 
 }
 
-func (p *parser) calloncountReducer1() (interface{}, error) {
+func (p *parser) callonwindow1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.oncountReducer1(stack["op"], stack["field"])
+	return p.cur.onwindow1(stack["assignments"], stack["keys"])
 }
 
-func (c *current) onfieldReducer1(op, field interface{}) (interface{}, error) {
-	return makeReducer(op, toLowerCase(op), field), nil
-
+func (c *current) onsession11(r interface{}) (interface{}, error) {
+	return r, nil
 }
 
-func (p *parser) callonfieldReducer1() (interface{}, error) {
+func (p *parser) callonsession11() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onfieldReducer1(stack["op"], stack["field"])
+	return p.cur.onsession11(stack["r"])
 }
 
-func (c *current) onreducerProc1(every, reducers, keys, limit interface{}) (interface{}, error) {
-	if OR(keys, every) != nil {
-		if keys != nil {
-			keys = keys.([]interface{})[1]
-		} else {
-			keys = []interface{}{}
-		}
-
-		if every != nil {
-			every = every.([]interface{})[0]
-		}
+func (c *current) onsession18(k interface{}) (interface{}, error) {
+	return k, nil
+}
 
-		return makeGroupByProc(every, limit, keys, reducers), nil
-	}
+func (p *parser) callonsession18() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onsession18(stack["k"])
+}
 
-	return makeReducerProc(reducers), nil
+func (c *current) onsession1(gap, reducers, keys interface{}) (interface{}, error) {
+	return makeSessionProc(gap, reducers, keys), nil
 
 }
 
-func (p *parser) callonreducerProc1() (interface{}, error) {
+func (p *parser) callonsession1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onreducerProc1(stack["every"], stack["reducers"], stack["keys"], stack["limit"])
+	return p.cur.onsession1(stack["gap"], stack["reducers"], stack["keys"])
 }
 
-func (c *current) onasClause1(v interface{}) (interface{}, error) {
-	return v, nil
+func (c *current) onsample13(k interface{}) (interface{}, error) {
+	return k, nil
 }
 
-func (p *parser) callonasClause1() (interface{}, error) {
+func (p *parser) callonsample13() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onasClause1(stack["v"])
+	return p.cur.onsample13(stack["k"])
 }
 
-func (c *current) onreducerExpr2(field, f interface{}) (interface{}, error) {
-	return overrideReducerVar(f, field), nil
+func (c *current) onsample2(rate, keys, seed interface{}) (interface{}, error) {
+	return makeSampleProc(nil, rate, keys, seed), nil
 
 }
 
-func (p *parser) callonreducerExpr2() (interface{}, error) {
+func (p *parser) callonsample2() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onreducerExpr2(stack["field"], stack["f"])
+	return p.cur.onsample2(stack["rate"], stack["keys"], stack["seed"])
 }
 
-func (c *current) onreducerExpr13(f, field interface{}) (interface{}, error) {
-	return overrideReducerVar(f, field), nil
-
+func (c *current) onsample29(k interface{}) (interface{}, error) {
+	return k, nil
 }
 
-func (p *parser) callonreducerExpr13() (interface{}, error) {
+func (p *parser) callonsample29() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onreducerExpr13(stack["f"], stack["field"])
+	return p.cur.onsample29(stack["k"])
 }
 
-func (c *current) onreducerList1(first, rest interface{}) (interface{}, error) {
-	result := []interface{}{first}
-	for _, r := range rest.([]interface{}) {
-		result = append(result, r.([]interface{})[3])
-	}
-	return result, nil
+func (c *current) onsample21(size, keys, seed interface{}) (interface{}, error) {
+	return makeSampleProc(size, nil, keys, seed), nil
 
 }
 
-func (p *parser) callonreducerList1() (interface{}, error) {
+func (p *parser) callonsample21() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onreducerList1(stack["first"], stack["rest"])
+	return p.cur.onsample21(stack["size"], stack["keys"], stack["seed"])
 }
 
-func (c *current) onsort8(l interface{}) (interface{}, error) {
-	return l, nil
+func (c *current) onsampleSeedArg1(seed interface{}) (interface{}, error) {
+	return seed, nil
 }
 
-func (p *parser) callonsort8() (interface{}, error) {
+func (p *parser) callonsampleSeedArg1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onsort8(stack["l"])
+	return p.cur.onsampleSeedArg1(stack["seed"])
 }
 
-func (c *current) onsort1(args, list interface{}) (interface{}, error) {
-	return makeSortProc(args, list)
-
+func (c *current) onhistogram15(k interface{}) (interface{}, error) {
+	return k, nil
 }
 
-func (p *parser) callonsort1() (interface{}, error) {
+func (p *parser) callonhistogram15() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onsort1(stack["args"], stack["list"])
+	return p.cur.onhistogram15(stack["k"])
 }
 
-func (c *current) onsortArgs2(a interface{}) (interface{}, error) {
-	return a, nil
+func (c *current) onhistogram2(field, bounds, keys interface{}) (interface{}, error) {
+	return makeHistogramProc(field, nil, false, bounds, keys), nil
+
 }
 
-func (p *parser) callonsortArgs2() (interface{}, error) {
+func (p *parser) callonhistogram2() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onsortArgs2(stack["a"])
+	return p.cur.onhistogram2(stack["field"], stack["bounds"], stack["keys"])
 }
 
-func (c *current) onsortArg2(limit interface{}) (interface{}, error) {
-	return makeArg("limit", limit), nil
+func (c *current) onhistogram30(k interface{}) (interface{}, error) {
+	return k, nil
 }
 
-func (p *parser) callonsortArg2() (interface{}, error) {
+func (p *parser) callonhistogram30() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onsortArg2(stack["limit"])
+	return p.cur.onhistogram30(stack["k"])
 }
 
-func (c *current) onsortArg8() (interface{}, error) {
-	return makeArg("r", nil), nil
+func (c *current) onhistogram20(field, keys interface{}) (interface{}, error) {
+	return makeHistogramProc(field, nil, true, nil, keys), nil
+
 }
 
-func (p *parser) callonsortArg8() (interface{}, error) {
+func (p *parser) callonhistogram20() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onsortArg8()
+	return p.cur.onhistogram20(stack["field"], stack["keys"])
 }
 
-func (c *current) onsortArg15() (interface{}, error) {
-	return string(c.text), nil
+func (c *current) onhistogram43(n interface{}) (interface{}, error) {
+	return n, nil
 }
 
-func (p *parser) callonsortArg15() (interface{}, error) {
+func (p *parser) callonhistogram43() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onsortArg15()
+	return p.cur.onhistogram43(stack["n"])
 }
 
-func (c *current) onsortArg10(where interface{}) (interface{}, error) {
-	return makeArg("nulls", where), nil
+func (c *current) onhistogram52(k interface{}) (interface{}, error) {
+	return k, nil
 }
 
-func (p *parser) callonsortArg10() (interface{}, error) {
+func (p *parser) callonhistogram52() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onsortArg10(stack["where"])
+	return p.cur.onhistogram52(stack["k"])
 }
 
-func (c *current) ontop6(n interface{}) (interface{}, error) {
-	return n, nil
+func (c *current) onhistogram35(field, bins, keys interface{}) (interface{}, error) {
+	return makeHistogramProc(field, bins, false, nil, keys), nil
+
 }
 
-func (p *parser) callontop6() (interface{}, error) {
+func (p *parser) callonhistogram35() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.ontop6(stack["n"])
+	return p.cur.onhistogram35(stack["field"], stack["bins"], stack["keys"])
 }
 
-func (c *current) ontop18(f interface{}) (interface{}, error) {
-	return f, nil
+func (c *current) onhistogramBounds7(b interface{}) (interface{}, error) {
+	return b, nil
 }
 
-func (p *parser) callontop18() (interface{}, error) {
+func (p *parser) callonhistogramBounds7() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.ontop18(stack["f"])
+	return p.cur.onhistogramBounds7(stack["b"])
 }
 
-func (c *current) ontop1(limit, flush, list interface{}) (interface{}, error) {
-	return makeTopProc(list, limit, flush), nil
+func (c *current) onhistogramBounds1(first, rest interface{}) (interface{}, error) {
+	return append([]interface{}{first}, (rest.([]interface{}))...), nil
 
 }
 
-func (p *parser) callontop1() (interface{}, error) {
+func (p *parser) callonhistogramBounds1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.ontop1(stack["limit"], stack["flush"], stack["list"])
+	return p.cur.onhistogramBounds1(stack["first"], stack["rest"])
 }
 
-func (c *current) onprocLimitArg1(limit interface{}) (interface{}, error) {
-	return limit, nil
+func (c *current) onlookup25(f interface{}) (interface{}, error) {
+	return f, nil
 }
 
-func (p *parser) callonprocLimitArg1() (interface{}, error) {
+func (p *parser) callonlookup25() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onprocLimitArg1(stack["limit"])
+	return p.cur.onlookup25(stack["f"])
 }
 
-func (c *current) oncut1(list interface{}) (interface{}, error) {
-	return makeCutProc(list), nil
+func (c *current) onlookup1(file, key, tableKey, fields interface{}) (interface{}, error) {
+	return makeLookupProc(file, key, tableKey, fields), nil
+
 }
 
-func (p *parser) calloncut1() (interface{}, error) {
+func (p *parser) callonlookup1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.oncut1(stack["list"])
+	return p.cur.onlookup1(stack["file"], stack["key"], stack["tableKey"], stack["fields"])
 }
 
-func (c *current) onhead2(count interface{}) (interface{}, error) {
-	return makeHeadProc(count), nil
+func (c *current) onintel13(f interface{}) (interface{}, error) {
+	return f, nil
 }
 
-func (p *parser) callonhead2() (interface{}, error) {
+func (p *parser) callonintel13() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onhead2(stack["count"])
+	return p.cur.onintel13(stack["f"])
 }
 
-func (c *current) onhead8() (interface{}, error) {
-	return makeHeadProc(1), nil
+func (c *current) onintel1(first, rest interface{}) (interface{}, error) {
+	return makeIntelProc(append([]interface{}{first}, (rest.([]interface{}))...)), nil
+
 }
 
-func (p *parser) callonhead8() (interface{}, error) {
+func (p *parser) callonintel1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onhead8()
+	return p.cur.onintel1(stack["first"], stack["rest"])
 }
 
-func (c *current) ontail2(count interface{}) (interface{}, error) {
-	return makeTailProc(count), nil
+func (c *current) onparse14(f interface{}) (interface{}, error) {
+	return f, nil
 }
 
-func (p *parser) callontail2() (interface{}, error) {
+func (p *parser) callonparse14() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.ontail2(stack["count"])
+	return p.cur.onparse14(stack["f"])
 }
 
-func (c *current) ontail8() (interface{}, error) {
-	return makeTailProc(1), nil
+func (c *current) onparse1(field, pattern, patternFile, warn interface{}) (interface{}, error) {
+	return makeParseProc(field, pattern, patternFile, warn), nil
+
 }
 
-func (p *parser) callontail8() (interface{}, error) {
+func (p *parser) callonparse1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.ontail8()
+	return p.cur.onparse1(stack["field"], stack["pattern"], stack["patternFile"], stack["warn"])
 }
 
-func (c *current) onfilter1(expr interface{}) (interface{}, error) {
-	return makeFilterProc(expr), nil
-
+func (c *current) onunnest1(field interface{}) (interface{}, error) {
+	return makeUnnestProc(field), nil
 }
 
-func (p *parser) callonfilter1() (interface{}, error) {
+func (p *parser) callonunnest1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onfilter1(stack["expr"])
+	return p.cur.onunnest1(stack["field"])
 }
 
-func (c *current) onuniq2() (interface{}, error) {
-	return makeUniqProc(true), nil
-
+func (c *current) onpass1() (interface{}, error) {
+	return makePassProc(), nil
 }
 
-func (p *parser) callonuniq2() (interface{}, error) {
+func (p *parser) callonpass1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onuniq2()
+	return p.cur.onpass1()
 }
 
-func (c *current) onuniq7() (interface{}, error) {
-	return makeUniqProc(false), nil
+func (c *current) onassignment1(f, e interface{}) (interface{}, error) {
+	return makeAssignment(f, e), nil
+}
 
+func (p *parser) callonassignment1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onassignment1(stack["f"], stack["e"])
+}
+
+func (c *current) onassignmentList7(a interface{}) (interface{}, error) {
+	return a, nil
 }
 
-func (p *parser) callonuniq7() (interface{}, error) {
+func (p *parser) callonassignmentList7() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onuniq7()
+	return p.cur.onassignmentList7(stack["a"])
 }
 
-func (c *current) onput1(f, e interface{}) (interface{}, error) {
-	return makePutProc(f, e), nil
+func (c *current) onassignmentList1(first, rest interface{}) (interface{}, error) {
+	return append([]interface{}{first}, (rest.([]interface{}))...), nil
 
 }
 
-func (p *parser) callonput1() (interface{}, error) {
+func (p *parser) callonassignmentList1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onput1(stack["f"], stack["e"])
+	return p.cur.onassignmentList1(stack["first"], stack["rest"])
 }
 
-func (c *current) onPrimaryExpression12(expr interface{}) (interface{}, error) {
+func (c *current) onPrimaryExpression13(expr interface{}) (interface{}, error) {
 	return expr, nil
 }
 
-func (p *parser) callonPrimaryExpression12() (interface{}, error) {
+func (p *parser) callonPrimaryExpression13() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPrimaryExpression12(stack["expr"])
+	return p.cur.onPrimaryExpression13(stack["expr"])
 }
 
 func (c *current) onFieldReference1(f interface{}) (interface{}, error) {
@@ -6983,6 +10042,39 @@ func (p *parser) callonDereferenceExpression1() (interface{}, error) {
 	return p.cur.onDereferenceExpression1(stack["base"], stack["derefs"])
 }
 
+func (c *current) onsubseconds2(num interface{}) (interface{}, error) {
+	return makeNanoDuration(num.(int) * 1000000), nil
+
+}
+
+func (p *parser) callonsubseconds2() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onsubseconds2(stack["num"])
+}
+
+func (c *current) onsubseconds13(num interface{}) (interface{}, error) {
+	return makeNanoDuration(num.(int) * 1000), nil
+
+}
+
+func (p *parser) callonsubseconds13() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onsubseconds13(stack["num"])
+}
+
+func (c *current) onsubseconds24(num interface{}) (interface{}, error) {
+	return makeNanoDuration(num), nil
+
+}
+
+func (p *parser) callonsubseconds24() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onsubseconds24(stack["num"])
+}
+
 func (c *current) onseconds2() (interface{}, error) {
 	return makeDuration(1), nil
 }
@@ -7073,6 +10165,86 @@ func (p *parser) callonweeks1() (interface{}, error) {
 	return p.cur.onweeks1(stack["num"])
 }
 
+func (c *current) oncalendarInterval2(num interface{}) (interface{}, error) {
+	return []interface{}{num, "month"}, nil
+}
+
+func (p *parser) calloncalendarInterval2() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.oncalendarInterval2(stack["num"])
+}
+
+func (c *current) oncalendarInterval9(num interface{}) (interface{}, error) {
+	return []interface{}{num, "year"}, nil
+}
+
+func (p *parser) calloncalendarInterval9() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.oncalendarInterval9(stack["num"])
+}
+
+func (c *current) oncalendarInterval16() (interface{}, error) {
+	return []interface{}{1, "month"}, nil
+}
+
+func (p *parser) calloncalendarInterval16() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.oncalendarInterval16()
+}
+
+func (c *current) oncalendarInterval18() (interface{}, error) {
+	return []interface{}{1, "year"}, nil
+}
+
+func (p *parser) calloncalendarInterval18() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.oncalendarInterval18()
+}
+
+func (c *current) ondayInterval2(num interface{}) (interface{}, error) {
+	return []interface{}{num, "day"}, nil
+}
+
+func (p *parser) callondayInterval2() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.ondayInterval2(stack["num"])
+}
+
+func (c *current) ondayInterval9(num interface{}) (interface{}, error) {
+	return []interface{}{num, "week"}, nil
+}
+
+func (p *parser) callondayInterval9() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.ondayInterval9(stack["num"])
+}
+
+func (c *current) ondayInterval16() (interface{}, error) {
+	return []interface{}{1, "day"}, nil
+}
+
+func (p *parser) callondayInterval16() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.ondayInterval16()
+}
+
+func (c *current) ondayInterval18() (interface{}, error) {
+	return []interface{}{1, "week"}, nil
+}
+
+func (p *parser) callondayInterval18() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.ondayInterval18()
+}
+
 func (c *current) onaddr1(a interface{}) (interface{}, error) {
 	return string(c.text), nil
 }
@@ -7583,18 +10755,17 @@ func Entrypoint(ruleName string) Option {
 //
 // Example usage:
 //
-//     input := "input"
-//     stats := Stats{}
-//     _, err := Parse("input-file", []byte(input), Statistics(&stats, "no match"))
-//     if err != nil {
-//         log.Panicln(err)
-//     }
-//     b, err := json.MarshalIndent(stats.ChoiceAltCnt, "", "  ")
-//     if err != nil {
-//         log.Panicln(err)
-//     }
-//     fmt.Println(string(b))
-//
+//	input := "input"
+//	stats := Stats{}
+//	_, err := Parse("input-file", []byte(input), Statistics(&stats, "no match"))
+//	if err != nil {
+//	    log.Panicln(err)
+//	}
+//	b, err := json.MarshalIndent(stats.ChoiceAltCnt, "", "  ")
+//	if err != nil {
+//	    log.Panicln(err)
+//	}
+//	fmt.Println(string(b))
 func Statistics(stats *Stats, choiceNoMatch string) Option {
 	return func(p *parser) Option {
 		oldStats := p.Stats
@@ -2,6 +2,8 @@ package zql
 
 import (
 	"bufio"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"testing"
@@ -63,3 +65,196 @@ func TestUnicode(t *testing.T) {
 	assert.NoError(t, err, "Parse of string succeeded")
 	assert.Equal(t, result, "I love 🌮s", "Unicode escape with brackets parsed correctly")
 }
+
+func TestMacros(t *testing.T) {
+	parse := func(query string, libs ...string) string {
+		p, err := ParseProc(query, libs...)
+		require.NoError(t, err, "zql: %q", query)
+		b, err := json.Marshal(p)
+		require.NoError(t, err)
+		return string(b)
+	}
+	// Macros that expand to sequences are spliced into the enclosing
+	// sequence.
+	expected := parse("* | sort -r ts | head 1 | count()")
+	assert.Equal(t, expected, parse("def last = sort -r ts | head 1; * | last | count()"))
+	assert.Equal(t, expected, parse("* | last | count()", "def last = sort -r ts | head 1;"))
+	// A macro may use macros defined before it and a query's definitions
+	// override those of a library.
+	assert.Equal(t, expected, parse("def last = latest | head 1; * | last | count()", "def latest = sort -r ts;\ndef last = tail;"))
+	// A macro may begin with a search and be used in the first position.
+	assert.Equal(t, parse("* | filter _path=conn | count()"), parse("def conns = _path=conn; conns | count()"))
+	assert.Equal(t, parse("(filter _path=conn | count(); head)"), parse("def conns = _path=conn; (conns | count(); head)"))
+
+	_, err := ParseProc("* | undefined")
+	assert.Error(t, err)
+	_, err = ParseProc("*", "count()")
+	assert.Error(t, err, "library with a query")
+}
+
+func TestParams(t *testing.T) {
+	parse := func(query string, params map[string]ast.Literal) string {
+		p, err := ParseProcWithParams(query, params)
+		require.NoError(t, err, "zql: %q", query)
+		b, err := json.Marshal(p)
+		require.NoError(t, err)
+		return string(b)
+	}
+	params := map[string]ast.Literal{
+		"host": {Type: "ip", Value: "10.0.0.1"},
+		"msg":  {Type: "string", Value: `a "quoted" | (string)`},
+		"n":    {Type: "int64", Value: "5"},
+	}
+	assert.Equal(t, parse("id.orig_h=10.0.0.1", nil), parse("id.orig_h=$host", params))
+	assert.Equal(t, parse(`msg="a \"quoted\" | (string)"`, nil), parse("msg=$msg", params))
+	assert.Equal(t, parse(`"a \"quoted\" | (string)"`, nil), parse("$msg", params))
+	assert.Equal(t, parse("10.0.0.1", nil), parse("$host", params))
+	assert.Equal(t, parse("* | put x = n + 5", nil), parse("* | put x = n + $n", params))
+
+	// Without parameters, $name is a search word, but with some, an
+	// unbound $name is an error.
+	assert.Equal(t, parse(`name="$MFT"`, nil), parse("name=$MFT", nil))
+	assert.Equal(t, parse(`"$MFT"`, nil), parse("$MFT", nil))
+	_, err := ParseProcWithParams("name=$MFT", params)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "parameter $MFT is not bound")
+	_, err = ParseProcWithParams("$MFT", params)
+	assert.Error(t, err)
+	_, err = ParseProcWithParams(`"$MFT"`, params)
+	assert.NoError(t, err)
+
+	_, err = ParseProcWithParams("*", map[string]ast.Literal{"n": {Type: "int64", Value: "x"}})
+	assert.Error(t, err, "value does not parse as its type")
+	_, err = ParseProcWithParams("*", map[string]ast.Literal{"n": {Type: "nosuchtype", Value: "x"}})
+	assert.Error(t, err, "unknown type")
+}
+
+func TestDurationJSON(t *testing.T) {
+	// Whole seconds are marshaled in seconds for older clients.
+	for query, expected := range map[string]string{
+		"every 1h count()":     `"duration":{"seconds":3600}`,
+		"every 1500ms count()": `"duration":{"nanoseconds":1500000000}`,
+	} {
+		p, err := ParseProc(query)
+		require.NoError(t, err)
+		b, err := json.Marshal(p)
+		require.NoError(t, err)
+		assert.Contains(t, string(b), expected, "zql: %q", query)
+		p2, err := ast.UnpackProc(nil, b)
+		require.NoError(t, err)
+		assert.Equal(t, p, p2, "zql: %q", query)
+	}
+}
+
+func TestParseError(t *testing.T) {
+	_, err := ParseProc("def a = head;\n\t* | sort -x")
+	var perr *ParseError
+	require.True(t, errors.As(err, &perr), "error is %T", err)
+	assert.Equal(t, 2, perr.Line)
+	assert.Equal(t, 11, perr.Column)
+	assert.Equal(t, 24, perr.Offset)
+	assert.Equal(t, "\t* | sort -x\n\t         ^", perr.Excerpt)
+
+	_, err = ParseProc("*", "def a = head;\ndef b = ;")
+	require.True(t, errors.As(err, &perr), "error is %T", err)
+	assert.Equal(t, 2, perr.Line)
+	assert.Equal(t, 9, perr.Column)
+}
+
+func TestCheck(t *testing.T) {
+	valid := []string{
+		"* | put x = Math.sqrt(y)",
+		"* | put x = parseJSON(s)",
+		"* | window x = lag(y, 2), z = count(), w = Math.max(y, 1)",
+		"* | count(), sum(x) by y",
+	}
+	for _, query := range valid {
+		_, err := ParseProc(query)
+		assert.NoError(t, err, "zql: %q", query)
+	}
+	invalid := []string{
+		"* | stdev(x)",
+		"* | every 1h entropy(x) by y",
+		"* | put x = nosuchfunction(y)",
+		"* | put x = Math.sqrt(y, z)",
+		"* | put x = 1 + parseJSON()",
+		"* | put x = lag(y)",
+		"* | window x = lag()",
+		"* | window x = movavg(y)",
+		"(count(); put x = Math.sqrt())",
+	}
+	for _, query := range invalid {
+		_, err := ParseProc(query)
+		var serr *SemanticError
+		assert.True(t, errors.As(err, &serr), "zql: %q: error is %v", query, err)
+	}
+}
+
+// testFormat checks that formatting the AST of query yields text that
+// parses back to the same AST.
+func testFormat(t *testing.T, query string) {
+	p1, err := ParseProc(query)
+	require.NoError(t, err, "zql: %q", query)
+	formatted, err := FormatProc(p1)
+	require.NoError(t, err, "zql: %q", query)
+	p2, err := ParseProc(formatted)
+	require.NoError(t, err, "zql: %q: formatted: %q", query, formatted)
+	b1, err := json.Marshal(p1)
+	require.NoError(t, err)
+	b2, err := json.Marshal(p2)
+	require.NoError(t, err)
+	assert.JSONEq(t, string(b1), string(b2), "zql: %q: formatted: %q", query, formatted)
+}
+
+func TestFormatValid(t *testing.T) {
+	file, err := os.Open("valid.zql")
+	require.NoError(t, err)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		testFormat(t, scanner.Text())
+	}
+}
+
+func TestFormat(t *testing.T) {
+	queries := []string{
+		`not a b or c`,
+		`a (not b) c`,
+		`a or (b or c)`,
+		`(a or b) (c d)`,
+		`"a \"quoted\"\n string" /a\/b/ 10.0.0.0/8 :53 1.5`,
+		`*=1 **!=foo x<=2 "a" in y 80 in *`,
+		`* | filter (a or b) x>1 | (head 1; filter c | tail 2 by x)`,
+		`* | switch (_path=conn => count(); default => head 1)`,
+		`* | put x = (a + b) * c - (d - e) / f`,
+		`* | put x = a.b[1] = (parseJSON(s)).c and y or not_z`,
+		`* | put x = Math.max(y, 2)`,
+		`* | every 90s slide 30s count()`,
+		`* | every 1month tz "America/New_York" count() by x`,
+		`* | every 1day tz "UTC" sum(x) as s`,
+		`* | session gap 5m count() by uid`,
+		`* | sample 0.5% by _path -seed 7`,
+		`* | sample 10`,
+		`* | histogram x bounds -1, 2.5, 10 by y`,
+		`* | histogram x log`,
+		`* | histogram x bins 20`,
+		`* | lookup file="t.tsv" on id.orig_h=ip fields a, b`,
+		`* | intel file="a.intel", "b.intel"`,
+		`* | parse msg with "%{WORD:w}" -patterns "p.txt" -warn`,
+		`* | unnest x`,
+		`* | window y = lag(x, 2), z = count() by k`,
+		`* | count() as n, countdistinct(x) by len(y)`,
+		`* | uniq -c | uniq | pass`,
+	}
+	for _, query := range queries {
+		testFormat(t, query)
+	}
+
+	p, err := ParseProc(`foo | every 1h count() by _path`)
+	require.NoError(t, err)
+	formatted, err := FormatProc(p)
+	require.NoError(t, err)
+	assert.Equal(t, `foo | every 1h count() by _path`, formatted)
+
+	_, err = FormatProc(&ast.SortProc{Node: ast.Node{"SortProc"}, Fields: []ast.FieldExpr{&ast.FieldCall{Fn: "Bogus"}}})
+	assert.Error(t, err)
+}